@@ -0,0 +1,60 @@
+// Command apikey issues an API key directly in the database, which is how the
+// first admin key is created before anyone can call POST /admin/api-keys.
+package main
+
+import (
+	"arise_tech_assessment/internal/config"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure"
+	"arise_tech_assessment/internal/infrastructure/logging"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+)
+
+func main() {
+	configPath := flag.String("config", "", "path to a YAML configuration file")
+	name := flag.String("name", "bootstrap", "name telling the key apart from the others")
+	subject := flag.String("subject", "admin", "principal the key authenticates as")
+	role := flag.String("role", string(domain.RoleAdmin), "role granted to the key")
+	expires := flag.Duration("expires", 0, "lifetime of the key, 0 for a key that never expires")
+	flag.Parse()
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		logging.Fatal("Invalid configuration", "error", err)
+	}
+	slog.SetDefault(logging.New(os.Stderr, cfg.Log))
+
+	initializer := infrastructure.CreateDbInitializer(cfg.Database)
+
+	pending, err := initializer.PendingMigrations(context.Background())
+	if err != nil {
+		logging.Fatal("Failed to check database migrations", "error", err)
+	}
+	if len(pending) > 0 {
+		logging.Fatal("Database migrations are pending, run `go run ./cmd/migrate up` first", "pending", len(pending))
+	}
+
+	var expiresAt *time.Time
+	if *expires > 0 {
+		at := time.Now().Add(*expires)
+		expiresAt = &at
+	}
+
+	apiKey, key, err := domain.NewAPIKey(*name, *subject, domain.Role(*role), expiresAt)
+	if err != nil {
+		logging.Fatal("Invalid API key", "error", err)
+	}
+	if err := repository.NewAPIKeyRepository(initializer.DB).Create(context.Background(), apiKey); err != nil {
+		logging.Fatal("Failed to store API key", "error", err)
+	}
+
+	slog.Info("Issued API key", "id", apiKey.ID, "subject", apiKey.Subject, "role", apiKey.Role)
+	// The key alone goes to stdout so that it can be captured by a script.
+	fmt.Println(key)
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"arise_tech_assessment/internal/config"
+	"arise_tech_assessment/internal/infrastructure"
+	"arise_tech_assessment/internal/infrastructure/logging"
+	"arise_tech_assessment/internal/infrastructure/migrations"
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+)
+
+const usage = `Usage: migrate <command> [flags]
+
+Commands:
+  up     [-steps N]        apply pending migrations (all by default)
+  down   [-steps N]        revert the latest applied migrations (one by default)
+  status                   list migrations and whether they are applied
+  create [-dir DIR] NAME   add empty up and down scripts for a new migration
+
+up, down and status accept -config FILE to read the database settings from a
+YAML configuration file.
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	command, args := os.Args[1], os.Args[2:]
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	steps := flags.Int("steps", 0, "number of migrations to apply or revert")
+	dir := flags.String("dir", "internal/infrastructure/migrations/sql", "directory of the migration scripts")
+	configPath := flags.String("config", "", "path to a YAML configuration file")
+	_ = flags.Parse(args)
+
+	if command == "create" {
+		if flags.NArg() != 1 {
+			logging.Fatal("create requires a migration name")
+		}
+		up, down, err := migrations.Create(*dir, flags.Arg(0))
+		if err != nil {
+			logging.Fatal("Failed to create migration", "error", err)
+		}
+		slog.Info("Created migration", "up", up, "down", down)
+		return
+	}
+
+	switch command {
+	case "up", "down", "status":
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		logging.Fatal("Invalid configuration", "error", err)
+	}
+	slog.SetDefault(logging.New(os.Stdout, cfg.Log))
+
+	// run returns instead of exiting so that the connection is closed first.
+	if err := run(context.Background(), command, *steps, cfg.Database); err != nil {
+		logging.Fatal("Migration command failed", "command", command, "error", err)
+	}
+}
+
+func run(ctx context.Context, command string, steps int, cfg config.DatabaseConfig) error {
+	db, err := infrastructure.NewGormDB(cfg).DB()
+	if err != nil {
+		return fmt.Errorf("get database connection: %w", err)
+	}
+	defer db.Close()
+
+	embedded, err := migrations.Embedded()
+	if err != nil {
+		return fmt.Errorf("load migrations: %w", err)
+	}
+
+	migrator := migrations.NewMigrator(db, embedded)
+
+	switch command {
+	case "up":
+		applied, err := migrator.Up(ctx, steps)
+		for _, migration := range applied {
+			slog.Info("Applied migration", "version", migration.Version, "name", migration.Name)
+		}
+		if err != nil {
+			return fmt.Errorf("apply migrations: %w", err)
+		}
+		if len(applied) == 0 {
+			slog.Info("No pending migrations")
+		}
+
+	case "down":
+		reverted, err := migrator.Down(ctx, steps)
+		for _, migration := range reverted {
+			slog.Info("Reverted migration", "version", migration.Version, "name", migration.Name)
+		}
+		if err != nil {
+			return fmt.Errorf("revert migrations: %w", err)
+		}
+		if len(reverted) == 0 {
+			slog.Info("No applied migrations")
+		}
+
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return fmt.Errorf("read migration status: %w", err)
+		}
+		for _, status := range statuses {
+			state := "pending"
+			if status.AppliedAt != nil {
+				state = "applied " + status.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			if status.Up == "" {
+				state += " (missing from this build)"
+			}
+			fmt.Printf("%04d_%-40s %s\n", status.Version, status.Name, state)
+		}
+	}
+
+	return nil
+}
@@ -1,28 +1,36 @@
 package main
 
 import (
+	"arise_tech_assessment/internal/config"
 	"arise_tech_assessment/internal/infrastructure"
-	"log"
+	"arise_tech_assessment/internal/infrastructure/logging"
+	"context"
+	"flag"
+	"log/slog"
 	"os"
 )
 
 func main() {
-	dsn := os.Getenv("CONNECTION_STRINGS_DEFAULT")
-	if dsn == "" {
-		log.Fatal("CONNECTION_STRINGS_DEFAULT environment variable is required")
+	configPath := flag.String("config", "", "path to a YAML configuration file")
+	flag.Parse()
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		logging.Fatal("Invalid configuration", "error", err)
 	}
+	slog.SetDefault(logging.New(os.Stdout, cfg.Log))
 
-	initializer := infrastructure.CreateDbInitializer(dsn)
+	initializer := infrastructure.CreateDbInitializer(cfg.Database)
 
-	log.Println("Running database migrations...")
-	if err := initializer.Init(); err != nil {
-		log.Fatalf("Failed to initialize database: %v", err)
+	pending, err := initializer.PendingMigrations(context.Background())
+	if err != nil {
+		logging.Fatal("Failed to check database migrations", "error", err)
+	}
+	if len(pending) > 0 {
+		logging.Fatal("Database migrations are pending, run `go run ./cmd/migrate up` first", "pending", len(pending))
 	}
 
-	log.Println("Seeding database...")
 	if err := initializer.Seed(); err != nil {
-		log.Fatalf("Failed to seed database: %v", err)
+		logging.Fatal("Failed to seed database", "error", err)
 	}
-
-	log.Println("Database seeding completed successfully!")
 }
\ No newline at end of file
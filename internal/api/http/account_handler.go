@@ -3,6 +3,7 @@ package http
 import (
 	"arise_tech_assessment/internal/application/commands"
 	"arise_tech_assessment/internal/application/queries"
+	"arise_tech_assessment/internal/infrastructure/repository"
 	"net/http"
 	"strconv"
 
@@ -26,19 +27,22 @@ func NewAccountHandler() *AccountHandler {
 // @Produce json
 // @Param account body commands.CreateAccountCommand true "Account creation data"
 // @Success 201 {object} commands.CreateAccountResponse
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
 // @Router /accounts [post]
 func (h *AccountHandler) CreateAccount(c *gin.Context) {
 	var cmd commands.CreateAccountCommand
 	if err := c.ShouldBindJSON(&cmd); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		validationError(c, err.Error())
 		return
 	}
 
 	result, err := mediatr.Send[*commands.CreateAccountCommand, *commands.CreateAccountResponse](c.Request.Context(), &cmd)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = c.Error(err)
 		return
 	}
 
@@ -52,22 +56,28 @@ func (h *AccountHandler) CreateAccount(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path string true "Account ID"
+// @Param include_deleted query bool false "Also return the account if it was deleted" default(false)
 // @Success 200 {object} queries.GetAccountResponse
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
 // @Router /accounts/{id} [get]
 func (h *AccountHandler) GetAccount(c *gin.Context) {
 	idParam := c.Param("id")
 	id, err := uuid.Parse(idParam)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid account ID"})
+		validationError(c, "Invalid account ID")
 		return
 	}
 
-	query := &queries.GetAccountQuery{ID: id}
+	includeDeleted, _ := strconv.ParseBool(c.DefaultQuery("include_deleted", "false"))
+
+	query := &queries.GetAccountQuery{ID: id, IncludeDeleted: includeDeleted}
 	result, err := mediatr.Send[*queries.GetAccountQuery, *queries.GetAccountResponse](c.Request.Context(), query)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = c.Error(err)
 		return
 	}
 
@@ -76,27 +86,64 @@ func (h *AccountHandler) GetAccount(c *gin.Context) {
 
 // GetAccounts godoc
 // @Summary Get all accounts
-// @Description Get a paginated list of all accounts
+// @Description Get a paginated list of accounts, optionally filtered and sorted
 // @Tags accounts
 // @Accept json
 // @Produce json
 // @Param page query int false "Page number" default(1)
-// @Param page_size query int false "Page size" default(10)
+// @Param page_size query int false "Page size" default(10) maximum(100)
+// @Param cursor query string false "Cursor from a previous next_cursor or prev_cursor; takes precedence over page"
+// @Param include_total query bool false "Count the total number of results" default(true)
+// @Param status query string false "Account status" Enums(active, inactive, blocked, closed)
+// @Param holder_name query string false "Case-insensitive substring of the holder name"
+// @Param owner_id query string false "Subject of the customer owning the accounts; customers only ever see their own"
+// @Param currency query string false "Balance currency"
+// @Param min_balance query int false "Minimum balance in minor units"
+// @Param max_balance query int false "Maximum balance in minor units"
+// @Param created_from query string false "Created at or after (RFC 3339)"
+// @Param created_to query string false "Created at or before (RFC 3339)"
+// @Param include_deleted query bool false "Include deleted accounts" default(false)
+// @Param sort query string false "Comma-separated field:asc|desc pairs; fields: created_at, updated_at, number, holder_name, balance, status" default(created_at:desc)
 // @Success 200 {object} queries.GetAccountsResponse
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
 // @Router /accounts [get]
 func (h *AccountHandler) GetAccounts(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
 
+	cursor, skipTotal, err := parseCursorParams(c)
+	if err != nil {
+		validationError(c, err.Error())
+		return
+	}
+
+	filter, err := parseAccountFilter(c)
+	if err != nil {
+		validationError(c, err.Error())
+		return
+	}
+
+	sort, err := parseSort(c.Query("sort"), repository.AccountSortFields)
+	if err != nil {
+		validationError(c, err.Error())
+		return
+	}
+
 	query := &queries.GetAccountsQuery{
-		Page:     page,
-		PageSize: pageSize,
+		Page:      page,
+		PageSize:  pageSize,
+		Cursor:    cursor,
+		SkipTotal: skipTotal,
+		Filter:    filter,
+		Sort:      sort,
 	}
 
 	result, err := mediatr.Send[*queries.GetAccountsQuery, *queries.GetAccountsResponse](c.Request.Context(), query)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = c.Error(err)
 		return
 	}
 
@@ -111,20 +158,23 @@ func (h *AccountHandler) GetAccounts(c *gin.Context) {
 // @Produce json
 // @Param number path string true "Account Number"
 // @Success 200 {object} queries.GetAccountByNumberResponse
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
 // @Router /accounts/number/{number} [get]
 func (h *AccountHandler) GetAccountByNumber(c *gin.Context) {
 	number := c.Param("number")
 	if number == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Account number is required"})
+		validationError(c, "Account number is required")
 		return
 	}
 
 	query := &queries.GetAccountByNumberQuery{Number: number}
 	result, err := mediatr.Send[*queries.GetAccountByNumberQuery, *queries.GetAccountByNumberResponse](c.Request.Context(), query)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = c.Error(err)
 		return
 	}
 
@@ -140,27 +190,31 @@ func (h *AccountHandler) GetAccountByNumber(c *gin.Context) {
 // @Param id path string true "Account ID"
 // @Param account body commands.UpdateAccountCommand true "Account update data"
 // @Success 200 {object} commands.UpdateAccountResponse
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
 // @Router /accounts/{id} [put]
 func (h *AccountHandler) UpdateAccount(c *gin.Context) {
 	idParam := c.Param("id")
 	id, err := uuid.Parse(idParam)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid account ID"})
+		validationError(c, "Invalid account ID")
 		return
 	}
 
 	var cmd commands.UpdateAccountCommand
 	if err := c.ShouldBindJSON(&cmd); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		validationError(c, err.Error())
 		return
 	}
 
 	cmd.ID = id
 	result, err := mediatr.Send[*commands.UpdateAccountCommand, *commands.UpdateAccountResponse](c.Request.Context(), &cmd)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = c.Error(err)
 		return
 	}
 
@@ -169,27 +223,227 @@ func (h *AccountHandler) UpdateAccount(c *gin.Context) {
 
 // DeleteAccount godoc
 // @Summary Delete an account
-// @Description Delete an existing account by ID
+// @Description Soft-delete an account. The balance must be zero and no transactions may be pending.
 // @Tags accounts
 // @Accept json
 // @Produce json
 // @Param id path string true "Account ID"
 // @Success 200 {object} commands.DeleteAccountResponse
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
 // @Router /accounts/{id} [delete]
 func (h *AccountHandler) DeleteAccount(c *gin.Context) {
 	idParam := c.Param("id")
 	id, err := uuid.Parse(idParam)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid account ID"})
+		validationError(c, "Invalid account ID")
 		return
 	}
 
 	cmd := &commands.DeleteAccountCommand{ID: id}
 	result, err := mediatr.Send[*commands.DeleteAccountCommand, *commands.DeleteAccountResponse](c.Request.Context(), cmd)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// BlockAccount godoc
+// @Summary Block an account
+// @Description Block an active or inactive account, stopping all debits and credits
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Param id path string true "Account ID"
+// @Param change body commands.BlockAccountCommand true "Reason and actor of the status change"
+// @Success 200 {object} commands.BlockAccountResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /accounts/{id}/block [post]
+func (h *AccountHandler) BlockAccount(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		validationError(c, "Invalid account ID")
+		return
+	}
+
+	var cmd commands.BlockAccountCommand
+	if err := c.ShouldBindJSON(&cmd); err != nil {
+		validationError(c, err.Error())
+		return
+	}
+
+	cmd.ID = id
+	cmd.ChangedBy = actor(c, cmd.ChangedBy)
+	result, err := mediatr.Send[*commands.BlockAccountCommand, *commands.BlockAccountResponse](c.Request.Context(), &cmd)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ActivateAccount godoc
+// @Summary Activate an account
+// @Description Reactivate an inactive or blocked account
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Param id path string true "Account ID"
+// @Param change body commands.ActivateAccountCommand true "Reason and actor of the status change"
+// @Success 200 {object} commands.ActivateAccountResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /accounts/{id}/activate [post]
+func (h *AccountHandler) ActivateAccount(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		validationError(c, "Invalid account ID")
+		return
+	}
+
+	var cmd commands.ActivateAccountCommand
+	if err := c.ShouldBindJSON(&cmd); err != nil {
+		validationError(c, err.Error())
+		return
+	}
+
+	cmd.ID = id
+	cmd.ChangedBy = actor(c, cmd.ChangedBy)
+	result, err := mediatr.Send[*commands.ActivateAccountCommand, *commands.ActivateAccountResponse](c.Request.Context(), &cmd)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// DeactivateAccount godoc
+// @Summary Deactivate an account
+// @Description Mark an active account as inactive
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Param id path string true "Account ID"
+// @Param change body commands.DeactivateAccountCommand true "Reason and actor of the status change"
+// @Success 200 {object} commands.DeactivateAccountResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /accounts/{id}/deactivate [post]
+func (h *AccountHandler) DeactivateAccount(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		validationError(c, "Invalid account ID")
+		return
+	}
+
+	var cmd commands.DeactivateAccountCommand
+	if err := c.ShouldBindJSON(&cmd); err != nil {
+		validationError(c, err.Error())
+		return
+	}
+
+	cmd.ID = id
+	cmd.ChangedBy = actor(c, cmd.ChangedBy)
+	result, err := mediatr.Send[*commands.DeactivateAccountCommand, *commands.DeactivateAccountResponse](c.Request.Context(), &cmd)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// CloseAccount godoc
+// @Summary Close an account
+// @Description Permanently close an account. The balance must be zero and no transactions may be pending.
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Param id path string true "Account ID"
+// @Param change body commands.CloseAccountCommand true "Reason and actor of the status change"
+// @Success 200 {object} commands.CloseAccountResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /accounts/{id}/close [post]
+func (h *AccountHandler) CloseAccount(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		validationError(c, "Invalid account ID")
+		return
+	}
+
+	var cmd commands.CloseAccountCommand
+	if err := c.ShouldBindJSON(&cmd); err != nil {
+		validationError(c, err.Error())
+		return
+	}
+
+	cmd.ID = id
+	cmd.ChangedBy = actor(c, cmd.ChangedBy)
+	result, err := mediatr.Send[*commands.CloseAccountCommand, *commands.CloseAccountResponse](c.Request.Context(), &cmd)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// RestoreAccount godoc
+// @Summary Restore a deleted account
+// @Description Undo the deletion of an account
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Param id path string true "Account ID"
+// @Success 200 {object} commands.RestoreAccountResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /accounts/{id}/restore [post]
+func (h *AccountHandler) RestoreAccount(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		validationError(c, "Invalid account ID")
+		return
+	}
+
+	cmd := &commands.RestoreAccountCommand{ID: id}
+	result, err := mediatr.Send[*commands.RestoreAccountCommand, *commands.RestoreAccountResponse](c.Request.Context(), cmd)
+	if err != nil {
+		_ = c.Error(err)
 		return
 	}
 
@@ -0,0 +1,117 @@
+package http
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/application/queries"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mehdihadeli/go-mediatr"
+)
+
+type APIKeyHandler struct {
+}
+
+func NewAPIKeyHandler() *APIKeyHandler {
+	return &APIKeyHandler{}
+}
+
+// IssueAPIKey godoc
+// @Summary Issue an API key
+// @Description Issue a key authenticating its holder as subject with role. The key is returned once and only its hash is stored; send it in the X-API-Key header. Requires the admin role.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param api_key body commands.IssueAPIKeyCommand true "API key data"
+// @Success 201 {object} commands.IssueAPIKeyResponse
+// @Failure 400 {object} Problem
+// @Failure 401 {object} Problem
+// @Failure 403 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /admin/api-keys [post]
+func (h *APIKeyHandler) IssueAPIKey(c *gin.Context) {
+	var cmd commands.IssueAPIKeyCommand
+	if err := c.ShouldBindJSON(&cmd); err != nil {
+		validationError(c, err.Error())
+		return
+	}
+
+	result, err := mediatr.Send[*commands.IssueAPIKeyCommand, *commands.IssueAPIKeyResponse](c.Request.Context(), &cmd)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// GetAPIKeys godoc
+// @Summary Get API keys
+// @Description Get a paginated list of the issued API keys, newest first. Keys and their hashes are never returned. Requires the admin role.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10) maximum(100)
+// @Success 200 {object} queries.GetAPIKeysResponse
+// @Failure 401 {object} Problem
+// @Failure 403 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /admin/api-keys [get]
+func (h *APIKeyHandler) GetAPIKeys(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	query := &queries.GetAPIKeysQuery{
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	result, err := mediatr.Send[*queries.GetAPIKeysQuery, *queries.GetAPIKeysResponse](c.Request.Context(), query)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// RevokeAPIKey godoc
+// @Summary Revoke an API key
+// @Description Revoke an API key. Requests made with it are rejected from then on. Requires the admin role.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path string true "API key ID"
+// @Success 200 {object} commands.RevokeAPIKeyResponse
+// @Failure 400 {object} Problem
+// @Failure 401 {object} Problem
+// @Failure 403 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /admin/api-keys/{id}/revoke [post]
+func (h *APIKeyHandler) RevokeAPIKey(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		validationError(c, "Invalid API key ID")
+		return
+	}
+
+	cmd := &commands.RevokeAPIKeyCommand{ID: id}
+	result, err := mediatr.Send[*commands.RevokeAPIKeyCommand, *commands.RevokeAPIKeyResponse](c.Request.Context(), cmd)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
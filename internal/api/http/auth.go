@@ -0,0 +1,81 @@
+package http
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"context"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// APIKeyHeader carries an API key issued by POST /admin/api-keys.
+const APIKeyHeader = "X-API-Key"
+
+// Authenticator resolves the credentials of a request to a principal. It
+// returns a domain.ErrorCodeUnauthenticated error for invalid credentials.
+type Authenticator interface {
+	AuthenticateAPIKey(ctx context.Context, key string) (*domain.Principal, error)
+	AuthenticateBearer(ctx context.Context, token string) (*domain.Principal, error)
+}
+
+// Authenticate rejects requests without valid credentials with 401 and puts
+// the principal of the others in the request context, where the mediator
+// handlers find it. Credentials are an X-API-Key header or an
+// "Authorization: Bearer" JWT.
+func Authenticate(authenticator Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		var principal *domain.Principal
+		var err error
+		if key := c.GetHeader(APIKeyHeader); key != "" {
+			principal, err = authenticator.AuthenticateAPIKey(ctx, key)
+		} else if token, ok := bearerToken(c.GetHeader("Authorization")); ok {
+			principal, err = authenticator.AuthenticateBearer(ctx, token)
+		} else {
+			err = domain.NewError(domain.ErrorCodeUnauthenticated, "an X-API-Key header or a bearer token is required")
+		}
+		if err != nil {
+			c.Header("WWW-Authenticate", `Bearer realm="api"`)
+			_ = c.Error(err)
+			c.Abort()
+			return
+		}
+
+		c.Request = c.Request.WithContext(domain.ContextWithPrincipal(ctx, principal))
+		c.Next()
+	}
+}
+
+// RequireRole rejects with 403 the principals holding none of the roles. It
+// must run after Authenticate.
+func RequireRole(roles ...domain.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if principal, ok := domain.PrincipalFromContext(c.Request.Context()); ok && principal.HasAnyRole(roles...) {
+			c.Next()
+			return
+		}
+
+		_ = c.Error(domain.Errorf(domain.ErrorCodeForbidden, "this operation requires one of the roles %v", roles))
+		c.Abort()
+	}
+}
+
+func bearerToken(header string) (string, bool) {
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// actor returns the subject of the authenticated caller, so that audit fields
+// such as changed_by cannot be forged, or fallback when the API runs without
+// authentication.
+func actor(c *gin.Context, fallback string) string {
+	if principal, ok := domain.PrincipalFromContext(c.Request.Context()); ok {
+		return principal.Subject
+	}
+	return fallback
+}
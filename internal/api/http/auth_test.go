@@ -0,0 +1,141 @@
+package http
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// stubAuthenticator accepts the key "valid-key" and the token "valid-token".
+type stubAuthenticator struct {
+	principal *domain.Principal
+}
+
+func (a stubAuthenticator) AuthenticateAPIKey(ctx context.Context, key string) (*domain.Principal, error) {
+	if key != "valid-key" {
+		return nil, domain.NewError(domain.ErrorCodeUnauthenticated, "invalid api key")
+	}
+	return a.principal, nil
+}
+
+func (a stubAuthenticator) AuthenticateBearer(ctx context.Context, token string) (*domain.Principal, error) {
+	if token != "valid-token" {
+		return nil, domain.NewError(domain.ErrorCodeUnauthenticated, "invalid bearer token")
+	}
+	return a.principal, nil
+}
+
+// newAuthEngine serves /whoami, which answers the subject of the caller, and
+// /admin, which only admins may call.
+func newAuthEngine(principal *domain.Principal) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.Use(ErrorHandler())
+	engine.Use(Authenticate(stubAuthenticator{principal: principal}))
+	engine.GET("/whoami", func(c *gin.Context) {
+		c.String(http.StatusOK, actor(c, "anonymous"))
+	})
+	engine.GET("/admin", RequireRole(domain.RoleAdmin), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+	return engine
+}
+
+func TestAuthenticate_ShouldRejectRequestWithoutCredentials(t *testing.T) {
+	// Arrange
+	engine := newAuthEngine(&domain.Principal{Subject: "alice", Roles: []domain.Role{domain.RoleTeller}})
+
+	// Act
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/whoami", nil))
+
+	// Assert
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("Expected status %d, got %d", http.StatusUnauthorized, w.Code)
+	}
+	if w.Header().Get("WWW-Authenticate") == "" {
+		t.Error("Expected a WWW-Authenticate header")
+	}
+}
+
+func TestAuthenticate_ShouldRejectInvalidAPIKey(t *testing.T) {
+	// Arrange
+	engine := newAuthEngine(&domain.Principal{Subject: "alice", Roles: []domain.Role{domain.RoleTeller}})
+	req := httptest.NewRequest(http.MethodGet, "/whoami", nil)
+	req.Header.Set(APIKeyHeader, "stolen-key")
+
+	// Act
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+
+	// Assert
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("Expected status %d, got %d", http.StatusUnauthorized, w.Code)
+	}
+}
+
+func TestAuthenticate_ShouldPutPrincipalInContext(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		value  string
+	}{
+		{name: "api key", header: APIKeyHeader, value: "valid-key"},
+		{name: "bearer token", header: "Authorization", value: "Bearer valid-token"},
+		{name: "lowercase scheme", header: "Authorization", value: "bearer valid-token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			engine := newAuthEngine(&domain.Principal{Subject: "alice", Roles: []domain.Role{domain.RoleTeller}})
+			req := httptest.NewRequest(http.MethodGet, "/whoami", nil)
+			req.Header.Set(tt.header, tt.value)
+
+			// Act
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			// Assert
+			if w.Code != http.StatusOK || w.Body.String() != "alice" {
+				t.Errorf("Expected alice to be authenticated, got %d %q", w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestRequireRole_ShouldForbidOtherRoles(t *testing.T) {
+	// Arrange
+	engine := newAuthEngine(&domain.Principal{Subject: "alice", Roles: []domain.Role{domain.RoleTeller}})
+	req := httptest.NewRequest(http.MethodGet, "/admin", nil)
+	req.Header.Set(APIKeyHeader, "valid-key")
+
+	// Act
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+
+	// Assert
+	if w.Code != http.StatusForbidden {
+		t.Errorf("Expected status %d, got %d", http.StatusForbidden, w.Code)
+	}
+}
+
+func TestRequireRole_ShouldAllowRole(t *testing.T) {
+	// Arrange
+	engine := newAuthEngine(&domain.Principal{Subject: "root", Roles: []domain.Role{domain.RoleAdmin}})
+	req := httptest.NewRequest(http.MethodGet, "/admin", nil)
+	req.Header.Set(APIKeyHeader, "valid-key")
+
+	// Act
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+
+	// Assert
+	if w.Code != http.StatusNoContent {
+		t.Errorf("Expected status %d, got %d", http.StatusNoContent, w.Code)
+	}
+}
@@ -0,0 +1,92 @@
+package http
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	pkgerrors "github.com/pkg/errors"
+)
+
+const (
+	ProblemContentType = "application/problem+json"
+	problemTypeDefault = "about:blank"
+	errorCodeInternal  = "internal_error"
+)
+
+// Problem is an RFC 7807 problem details body. Code is a stable,
+// machine-readable identifier clients can branch on.
+type Problem struct {
+	Type     string `json:"type" example:"about:blank"`
+	Title    string `json:"title" example:"Unprocessable Entity"`
+	Status   int    `json:"status" example:"422"`
+	Detail   string `json:"detail,omitempty" example:"insufficient funds"`
+	Instance string `json:"instance,omitempty" example:"/api/v1/transactions/3f1c.../process"`
+	Code     string `json:"code" example:"insufficient_funds"`
+}
+
+var statusByErrorCode = map[domain.ErrorCode]int{
+	domain.ErrorCodeNotFound:          http.StatusNotFound,
+	domain.ErrorCodeConflict:          http.StatusConflict,
+	domain.ErrorCodeInvalidState:      http.StatusConflict,
+	domain.ErrorCodeInsufficientFunds: http.StatusUnprocessableEntity,
+	domain.ErrorCodeAccountInactive:   http.StatusUnprocessableEntity,
+	domain.ErrorCodeCurrencyMismatch:  http.StatusUnprocessableEntity,
+	domain.ErrorCodeValidation:        http.StatusBadRequest,
+	domain.ErrorCodeUnauthenticated:   http.StatusUnauthorized,
+	domain.ErrorCodeForbidden:         http.StatusForbidden,
+}
+
+// ErrorHandler renders the last error attached with c.Error as a problem+json
+// response. Domain errors are mapped to their status code; anything else is
+// reported as a 500 without exposing its message.
+func ErrorHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+		renderError(c)
+	}
+}
+
+// renderError writes the problem response for the last recorded error, unless
+// a response has already been written.
+func renderError(c *gin.Context) {
+	if len(c.Errors) == 0 || c.Writer.Written() {
+		return
+	}
+
+	status, code, detail := describeError(c.Errors.Last().Err)
+	writeProblem(c, status, code, detail)
+}
+
+func describeError(err error) (int, string, string) {
+	var domainErr *domain.Error
+	if !errors.As(err, &domainErr) {
+		return http.StatusInternalServerError, errorCodeInternal, "An unexpected error occurred"
+	}
+
+	status, ok := statusByErrorCode[domainErr.Code]
+	if !ok {
+		status = http.StatusUnprocessableEntity
+	}
+
+	// Drop the wrapping added by the mediator but keep any context added by the handlers.
+	return status, string(domainErr.Code), pkgerrors.Cause(err).Error()
+}
+
+func writeProblem(c *gin.Context, status int, code, detail string) {
+	c.Header("Content-Type", ProblemContentType)
+	c.AbortWithStatusJSON(status, Problem{
+		Type:     problemTypeDefault,
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   detail,
+		Instance: c.Request.URL.Path,
+		Code:     code,
+	})
+}
+
+// validationError reports a malformed request as a 400 problem.
+func validationError(c *gin.Context, message string) {
+	_ = c.Error(domain.NewError(domain.ErrorCodeValidation, message))
+}
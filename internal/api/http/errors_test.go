@@ -0,0 +1,110 @@
+package http
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	pkgerrors "github.com/pkg/errors"
+)
+
+func newErrorEngine(err error) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.Use(ErrorHandler())
+	engine.GET("/resource", func(c *gin.Context) {
+		_ = c.Error(err)
+	})
+	return engine
+}
+
+func serveError(t *testing.T, err error) (*httptest.ResponseRecorder, Problem) {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	newErrorEngine(err).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/resource", nil))
+
+	var problem Problem
+	if err := json.Unmarshal(w.Body.Bytes(), &problem); err != nil {
+		t.Fatalf("Expected problem body, got %s", w.Body.String())
+	}
+	return w, problem
+}
+
+func TestErrorHandler_ShouldMapDomainErrorsToStatusCodes(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		code   string
+	}{
+		{"not found", domain.Errorf(domain.ErrorCodeNotFound, "account not found"), http.StatusNotFound, "not_found"},
+		{"insufficient funds", domain.ErrInsufficientFunds, http.StatusUnprocessableEntity, "insufficient_funds"},
+		{"account inactive", domain.ErrAccountInactive, http.StatusUnprocessableEntity, "account_inactive"},
+		{"currency mismatch", domain.ErrCurrencyMismatch, http.StatusUnprocessableEntity, "currency_mismatch"},
+		{"invalid state", domain.ErrInvalidState, http.StatusConflict, "invalid_state"},
+		{"concurrent update", repository.ErrConcurrentUpdate, http.StatusConflict, "conflict"},
+		{"validation", domain.ErrValidation, http.StatusBadRequest, "validation_failed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			w, problem := serveError(t, tt.err)
+
+			// Assert
+			if w.Code != tt.status {
+				t.Errorf("Expected status %d, got %d", tt.status, w.Code)
+			}
+			if problem.Status != tt.status {
+				t.Errorf("Expected problem status %d, got %d", tt.status, problem.Status)
+			}
+			if problem.Code != tt.code {
+				t.Errorf("Expected code %s, got %s", tt.code, problem.Code)
+			}
+			if got := w.Header().Get("Content-Type"); got != ProblemContentType {
+				t.Errorf("Expected content type %s, got %s", ProblemContentType, got)
+			}
+		})
+	}
+}
+
+func TestErrorHandler_ShouldStripMediatorWrapping(t *testing.T) {
+	// Arrange
+	err := pkgerrors.WithMessage(domain.ErrInsufficientFunds, "handler error")
+
+	// Act
+	w, problem := serveError(t, err)
+
+	// Assert
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected status %d, got %d", http.StatusUnprocessableEntity, w.Code)
+	}
+	if problem.Detail != "insufficient funds" {
+		t.Errorf("Expected detail 'insufficient funds', got %s", problem.Detail)
+	}
+	if problem.Instance != "/resource" {
+		t.Errorf("Expected instance /resource, got %s", problem.Instance)
+	}
+}
+
+func TestErrorHandler_ShouldHideUnknownErrors(t *testing.T) {
+	// Act
+	w, problem := serveError(t, errors.New("pq: connection refused"))
+
+	// Assert
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("Expected status %d, got %d", http.StatusInternalServerError, w.Code)
+	}
+	if problem.Code != "internal_error" {
+		t.Errorf("Expected code internal_error, got %s", problem.Code)
+	}
+	if problem.Detail == "pq: connection refused" {
+		t.Error("Expected internal error message not to be exposed")
+	}
+}
@@ -0,0 +1,119 @@
+package http
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/application/queries"
+	"arise_tech_assessment/internal/domain"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mehdihadeli/go-mediatr"
+)
+
+type ExchangeRateHandler struct {
+}
+
+func NewExchangeRateHandler() *ExchangeRateHandler {
+	return &ExchangeRateHandler{}
+}
+
+// PublishExchangeRate godoc
+// @Summary Publish an exchange rate
+// @Description Publish the rate for a currency pair. The latest rate whose effective time has passed is used for conversions.
+// @Tags exchange-rates
+// @Accept json
+// @Produce json
+// @Param rate body commands.PublishExchangeRateCommand true "Exchange rate data"
+// @Success 201 {object} commands.PublishExchangeRateResponse
+// @Failure 400 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /admin/exchange-rates [post]
+func (h *ExchangeRateHandler) PublishExchangeRate(c *gin.Context) {
+	var cmd commands.PublishExchangeRateCommand
+	if err := c.ShouldBindJSON(&cmd); err != nil {
+		validationError(c, err.Error())
+		return
+	}
+
+	result, err := mediatr.Send[*commands.PublishExchangeRateCommand, *commands.PublishExchangeRateResponse](c.Request.Context(), &cmd)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// GetExchangeRates godoc
+// @Summary Get published exchange rates
+// @Description Get a paginated list of all published exchange rates
+// @Tags exchange-rates
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10) maximum(100)
+// @Success 200 {object} queries.GetExchangeRatesResponse
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /admin/exchange-rates [get]
+func (h *ExchangeRateHandler) GetExchangeRates(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	query := &queries.GetExchangeRatesQuery{
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	result, err := mediatr.Send[*queries.GetExchangeRatesQuery, *queries.GetExchangeRatesResponse](c.Request.Context(), query)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetExchangeRate godoc
+// @Summary Get the current exchange rate
+// @Description Get the rate currently in effect for converting the base currency into the quote currency
+// @Tags exchange-rates
+// @Accept json
+// @Produce json
+// @Param base path string true "Base currency" example(USD)
+// @Param quote path string true "Quote currency" example(THB)
+// @Success 200 {object} queries.GetExchangeRateResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /exchange-rates/{base}/{quote} [get]
+func (h *ExchangeRateHandler) GetExchangeRate(c *gin.Context) {
+	base := domain.Currency(strings.ToUpper(c.Param("base")))
+	quote := domain.Currency(strings.ToUpper(c.Param("quote")))
+	for _, currency := range []domain.Currency{base, quote} {
+		if err := currency.Validate(); err != nil {
+			_ = c.Error(err)
+			return
+		}
+	}
+
+	query := &queries.GetExchangeRateQuery{
+		BaseCurrency:  base,
+		QuoteCurrency: quote,
+	}
+
+	result, err := mediatr.Send[*queries.GetExchangeRateQuery, *queries.GetExchangeRateResponse](c.Request.Context(), query)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,42 @@
+package http
+
+import (
+	"arise_tech_assessment/internal/infrastructure/health"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type HealthHandler struct {
+	readiness *health.Registry
+}
+
+func NewHealthHandler(readiness *health.Registry) *HealthHandler {
+	return &HealthHandler{
+		readiness: readiness,
+	}
+}
+
+// Live reports that the process is running. It checks no dependencies, so an
+// orchestrator only restarts the service when it is truly stuck.
+func (h *HealthHandler) Live(c *gin.Context) {
+	c.JSON(http.StatusOK, health.Report{Status: health.StatusUp, Checks: []health.CheckResult{}})
+}
+
+// Ready godoc
+// @Summary Readiness check
+// @Description Run the readiness checks (database, migrations, connection pool, shutdown) and report each one. Also served at /readyz.
+// @Tags health
+// @Produce json
+// @Success 200 {object} health.Report
+// @Failure 503 {object} health.Report
+// @Router /health [get]
+func (h *HealthHandler) Ready(c *gin.Context) {
+	report := h.readiness.Run(c.Request.Context())
+
+	status := http.StatusOK
+	if report.Status != health.StatusUp {
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, report)
+}
@@ -0,0 +1,78 @@
+package http
+
+import (
+	"arise_tech_assessment/internal/infrastructure/health"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func serveHealth(t *testing.T, path string, checkErr error) (*httptest.ResponseRecorder, health.Report) {
+	t.Helper()
+
+	readiness := health.NewRegistry(time.Second)
+	readiness.Register(health.NewCheck("database", func(ctx context.Context) error { return checkErr }))
+	handler := NewHealthHandler(readiness)
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.GET("/livez", handler.Live)
+	engine.GET("/readyz", handler.Ready)
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+	var report health.Report
+	if err := json.Unmarshal(w.Body.Bytes(), &report); err != nil {
+		t.Fatalf("Expected health report body, got %s", w.Body.String())
+	}
+	return w, report
+}
+
+func TestHealthHandler_Ready_ShouldReturnOKWhenDependenciesAreUp(t *testing.T) {
+	// Act
+	w, report := serveHealth(t, "/readyz", nil)
+
+	// Assert
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if report.Status != health.StatusUp || len(report.Checks) != 1 {
+		t.Errorf("Expected one passing check, got %+v", report)
+	}
+}
+
+func TestHealthHandler_Ready_ShouldReturnServiceUnavailableWhenDegraded(t *testing.T) {
+	// Act
+	w, report := serveHealth(t, "/readyz", errors.New("connection refused"))
+
+	// Assert
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("Expected status %d, got %d", http.StatusServiceUnavailable, w.Code)
+	}
+	if report.Status != health.StatusDown {
+		t.Errorf("Expected status %s, got %s", health.StatusDown, report.Status)
+	}
+	if report.Checks[0].Error != "connection refused" {
+		t.Errorf("Expected check error in the report, got %+v", report.Checks[0])
+	}
+}
+
+func TestHealthHandler_Live_ShouldIgnoreDependencies(t *testing.T) {
+	// Act
+	w, report := serveHealth(t, "/livez", errors.New("connection refused"))
+
+	// Assert
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if report.Status != health.StatusUp {
+		t.Errorf("Expected status %s, got %s", health.StatusUp, report.Status)
+	}
+}
@@ -0,0 +1,171 @@
+package http
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	DefaultIdempotencyKeyTTL = 24 * time.Hour
+	maxIdempotencyKeyLength  = 255
+
+	errorCodeIdempotencyKeyReused   = "idempotency_key_reused"
+	errorCodeIdempotencyKeyInFlight = "idempotency_key_in_flight"
+)
+
+// Idempotency makes the wrapped route safe to retry. Requests carrying an
+// Idempotency-Key header are executed once; later requests with the same key
+// and body receive the stored status and body, while a different body is
+// rejected with 422. Requests without the header pass through untouched.
+func Idempotency(repo repository.IdempotencyRepository, ttl time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" {
+			c.Next()
+			return
+		}
+
+		if len(key) > maxIdempotencyKeyLength {
+			writeProblem(c, http.StatusBadRequest, string(domain.ErrorCodeValidation), "Idempotency-Key must be at most 255 characters")
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			writeProblem(c, http.StatusBadRequest, string(domain.ErrorCodeValidation), "Failed to read request body")
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := c.Request.Context()
+		key = callerIdempotencyKey(ctx, key)
+		record := domain.NewIdempotencyRecord(key, hashRequest(c.Request, body), ttl)
+
+		reserved, err := repo.Reserve(ctx, record)
+		if err != nil {
+			_ = c.Error(err)
+			c.Abort()
+			return
+		}
+
+		if !reserved {
+			replayIdempotentResponse(c, repo, key, record.RequestHash)
+			return
+		}
+
+		// The outcome is stored even when the client has gone away, since that
+		// is when it retries.
+		storeCtx := context.WithoutCancel(ctx)
+
+		// Server errors, panics and responses that could not be stored are not
+		// cached so that the client can retry them.
+		stored := false
+		defer func() {
+			if stored {
+				return
+			}
+			if err := repo.Release(storeCtx, key); err != nil {
+				slog.ErrorContext(ctx, "Failed to release idempotency key", "key", key, "error", err)
+			}
+		}()
+
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+
+		c.Next()
+
+		// Render errors now so that the problem response is what gets stored.
+		renderError(c)
+
+		if recorder.Status() >= http.StatusInternalServerError {
+			return
+		}
+
+		if err := repo.Complete(storeCtx, key, recorder.Status(), recorder.Header().Get("Content-Type"), recorder.body.Bytes()); err != nil {
+			slog.ErrorContext(ctx, "Failed to store idempotent response", "key", key, "error", err)
+			return
+		}
+		stored = true
+	}
+}
+
+func replayIdempotentResponse(c *gin.Context, repo repository.IdempotencyRepository, key, requestHash string) {
+	existing, err := repo.FindByKey(c.Request.Context(), key)
+	if err != nil {
+		_ = c.Error(err)
+		c.Abort()
+		return
+	}
+
+	if existing.RequestHash != requestHash {
+		writeProblem(c, http.StatusUnprocessableEntity, errorCodeIdempotencyKeyReused, "Idempotency-Key was already used with a different request")
+		return
+	}
+
+	if !existing.IsCompleted() {
+		writeProblem(c, http.StatusConflict, errorCodeIdempotencyKeyInFlight, "A request with this Idempotency-Key is still being processed")
+		return
+	}
+
+	c.Header(IdempotentReplayedHeader, "true")
+	c.Data(existing.StatusCode, existing.ContentType, existing.ResponseBody)
+	c.Abort()
+}
+
+// callerIdempotencyKey scopes the key to the authenticated caller, so that
+// callers choosing the same key neither collide nor see each other's
+// responses. The scoped key is a hash, which fits the stored key whatever the
+// length of the subject.
+func callerIdempotencyKey(ctx context.Context, key string) string {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return key
+	}
+
+	h := sha256.New()
+	h.Write([]byte(strconv.Itoa(len(principal.Subject))))
+	h.Write([]byte{':'})
+	h.Write([]byte(principal.Subject))
+	h.Write([]byte(key))
+	return "caller:" + hex.EncodeToString(h.Sum(nil))
+}
+
+// hashRequest fingerprints the parts of a request that must match on replay.
+func hashRequest(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method))
+	h.Write([]byte{'\n'})
+	h.Write([]byte(r.URL.Path))
+	h.Write([]byte{'\n'})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// responseRecorder copies the response body while it is written to the client.
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
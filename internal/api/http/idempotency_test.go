@@ -0,0 +1,302 @@
+package http
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/mocks"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+)
+
+func newIdempotentEngine(repo *mocks.MockIdempotencyRepository, calls *int) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.POST("/transactions", Idempotency(repo, DefaultIdempotencyKeyTTL), func(c *gin.Context) {
+		*calls++
+		c.JSON(http.StatusCreated, gin.H{"id": "tx-1"})
+	})
+	return engine
+}
+
+func newIdempotentRequest(key, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/transactions", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	return req
+}
+
+func TestIdempotency_ShouldPassThroughWithoutKey(t *testing.T) {
+	// Arrange
+	repo := mocks.NewMockIdempotencyRepository(t)
+	calls := 0
+	engine := newIdempotentEngine(repo, &calls)
+
+	// Act
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, newIdempotentRequest("", `{"amount":1}`))
+
+	// Assert
+	if w.Code != http.StatusCreated {
+		t.Errorf("Expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+	if calls != 1 {
+		t.Errorf("Expected handler to run once, ran %d times", calls)
+	}
+}
+
+func TestIdempotency_ShouldStoreResponseOfFirstRequest(t *testing.T) {
+	// Arrange
+	repo := mocks.NewMockIdempotencyRepository(t)
+	calls := 0
+	engine := newIdempotentEngine(repo, &calls)
+
+	repo.EXPECT().Reserve(mock.Anything, mock.MatchedBy(func(r *domain.IdempotencyRecord) bool {
+		return r.Key == "key-1" && r.RequestHash != ""
+	})).Return(true, nil)
+	repo.EXPECT().Complete(mock.Anything, "key-1", http.StatusCreated, mock.Anything, []byte(`{"id":"tx-1"}`)).Return(nil)
+
+	// Act
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, newIdempotentRequest("key-1", `{"amount":1}`))
+
+	// Assert
+	if w.Code != http.StatusCreated {
+		t.Errorf("Expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+	if calls != 1 {
+		t.Errorf("Expected handler to run once, ran %d times", calls)
+	}
+}
+
+func TestIdempotency_ShouldReplayStoredResponse(t *testing.T) {
+	// Arrange
+	repo := mocks.NewMockIdempotencyRepository(t)
+	calls := 0
+	engine := newIdempotentEngine(repo, &calls)
+
+	var reserved *domain.IdempotencyRecord
+	repo.EXPECT().Reserve(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, r *domain.IdempotencyRecord) (bool, error) {
+		reserved = r
+		return false, nil
+	})
+	repo.EXPECT().FindByKey(mock.Anything, "key-1").RunAndReturn(func(_ context.Context, key string) (*domain.IdempotencyRecord, error) {
+		return &domain.IdempotencyRecord{
+			Key:          key,
+			RequestHash:  reserved.RequestHash,
+			StatusCode:   http.StatusCreated,
+			ContentType:  "application/json; charset=utf-8",
+			ResponseBody: []byte(`{"id":"tx-original"}`),
+		}, nil
+	})
+
+	// Act
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, newIdempotentRequest("key-1", `{"amount":1}`))
+
+	// Assert
+	if w.Code != http.StatusCreated {
+		t.Errorf("Expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+	if w.Body.String() != `{"id":"tx-original"}` {
+		t.Errorf("Expected original body, got %s", w.Body.String())
+	}
+	if w.Header().Get(IdempotentReplayedHeader) != "true" {
+		t.Error("Expected replay header to be set")
+	}
+	if calls != 0 {
+		t.Errorf("Expected handler not to run, ran %d times", calls)
+	}
+}
+
+func TestIdempotency_ShouldRejectDifferentBodyWithSameKey(t *testing.T) {
+	// Arrange
+	repo := mocks.NewMockIdempotencyRepository(t)
+	calls := 0
+	engine := newIdempotentEngine(repo, &calls)
+
+	repo.EXPECT().Reserve(mock.Anything, mock.Anything).Return(false, nil)
+	repo.EXPECT().FindByKey(mock.Anything, "key-1").Return(&domain.IdempotencyRecord{
+		Key:         "key-1",
+		RequestHash: "hash-of-another-request",
+		StatusCode:  http.StatusCreated,
+	}, nil)
+
+	// Act
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, newIdempotentRequest("key-1", `{"amount":2}`))
+
+	// Assert
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected status %d, got %d", http.StatusUnprocessableEntity, w.Code)
+	}
+	if calls != 0 {
+		t.Errorf("Expected handler not to run, ran %d times", calls)
+	}
+}
+
+func TestIdempotency_ShouldReturnConflictWhileOriginalIsInFlight(t *testing.T) {
+	// Arrange
+	repo := mocks.NewMockIdempotencyRepository(t)
+	calls := 0
+	engine := newIdempotentEngine(repo, &calls)
+
+	var reserved *domain.IdempotencyRecord
+	repo.EXPECT().Reserve(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, r *domain.IdempotencyRecord) (bool, error) {
+		reserved = r
+		return false, nil
+	})
+	repo.EXPECT().FindByKey(mock.Anything, "key-1").RunAndReturn(func(_ context.Context, key string) (*domain.IdempotencyRecord, error) {
+		return &domain.IdempotencyRecord{Key: key, RequestHash: reserved.RequestHash}, nil
+	})
+
+	// Act
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, newIdempotentRequest("key-1", `{"amount":1}`))
+
+	// Assert
+	if w.Code != http.StatusConflict {
+		t.Errorf("Expected status %d, got %d", http.StatusConflict, w.Code)
+	}
+}
+
+func TestIdempotency_ShouldReleaseKeyOnServerError(t *testing.T) {
+	// Arrange
+	repo := mocks.NewMockIdempotencyRepository(t)
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.POST("/transactions", Idempotency(repo, DefaultIdempotencyKeyTTL), func(c *gin.Context) {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "boom"})
+	})
+
+	repo.EXPECT().Reserve(mock.Anything, mock.Anything).Return(true, nil)
+	repo.EXPECT().Release(mock.Anything, "key-1").Return(nil)
+
+	// Act
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, newIdempotentRequest("key-1", `{"amount":1}`))
+
+	// Assert
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("Expected status %d, got %d", http.StatusInternalServerError, w.Code)
+	}
+}
+
+// notCancelled matches the contexts that can still be used for writes.
+var notCancelled = mock.MatchedBy(func(ctx context.Context) bool { return ctx.Err() == nil })
+
+func TestIdempotency_ShouldStoreResponseWhenClientHasGone(t *testing.T) {
+	// Arrange
+	repo := mocks.NewMockIdempotencyRepository(t)
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	ctx, cancel := context.WithCancel(context.Background())
+	engine.POST("/transactions", Idempotency(repo, DefaultIdempotencyKeyTTL), func(c *gin.Context) {
+		cancel()
+		c.JSON(http.StatusCreated, gin.H{"id": "tx-1"})
+	})
+
+	repo.EXPECT().Reserve(mock.Anything, mock.Anything).Return(true, nil)
+	repo.EXPECT().Complete(notCancelled, "key-1", http.StatusCreated, mock.Anything, []byte(`{"id":"tx-1"}`)).Return(nil)
+
+	// Act
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, newIdempotentRequest("key-1", `{"amount":1}`).WithContext(ctx))
+
+	// Assert
+	if w.Code != http.StatusCreated {
+		t.Errorf("Expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+}
+
+func TestIdempotency_ShouldReleaseKeyWhenStoringFails(t *testing.T) {
+	// Arrange
+	repo := mocks.NewMockIdempotencyRepository(t)
+	calls := 0
+	engine := newIdempotentEngine(repo, &calls)
+
+	repo.EXPECT().Reserve(mock.Anything, mock.Anything).Return(true, nil)
+	repo.EXPECT().Complete(mock.Anything, "key-1", http.StatusCreated, mock.Anything, mock.Anything).Return(errors.New("connection reset"))
+	repo.EXPECT().Release(notCancelled, "key-1").Return(nil)
+
+	// Act
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, newIdempotentRequest("key-1", `{"amount":1}`))
+
+	// Assert
+	if w.Code != http.StatusCreated {
+		t.Errorf("Expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+}
+
+func TestIdempotency_ShouldReleaseKeyWhenHandlerPanics(t *testing.T) {
+	// Arrange
+	repo := mocks.NewMockIdempotencyRepository(t)
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.Use(Recovery())
+	engine.POST("/transactions", Idempotency(repo, DefaultIdempotencyKeyTTL), func(c *gin.Context) {
+		panic("boom")
+	})
+
+	repo.EXPECT().Reserve(mock.Anything, mock.Anything).Return(true, nil)
+	repo.EXPECT().Release(notCancelled, "key-1").Return(nil)
+
+	// Act
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, newIdempotentRequest("key-1", `{"amount":1}`))
+
+	// Assert
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("Expected status %d, got %d", http.StatusInternalServerError, w.Code)
+	}
+}
+
+func TestIdempotency_ShouldScopeKeysToTheCaller(t *testing.T) {
+	// Arrange
+	repo := mocks.NewMockIdempotencyRepository(t)
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.POST("/transactions", func(c *gin.Context) {
+		principal := &domain.Principal{Subject: c.GetHeader("X-Subject"), Roles: []domain.Role{domain.RoleTeller}}
+		c.Request = c.Request.WithContext(domain.ContextWithPrincipal(c.Request.Context(), principal))
+	}, Idempotency(repo, DefaultIdempotencyKeyTTL), func(c *gin.Context) {
+		c.JSON(http.StatusCreated, gin.H{"id": "tx-1"})
+	})
+
+	reserved := map[string]bool{}
+	repo.EXPECT().Reserve(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, r *domain.IdempotencyRecord) (bool, error) {
+			if reserved[r.Key] {
+				return false, nil
+			}
+			reserved[r.Key] = true
+			return true, nil
+		})
+	repo.EXPECT().Complete(mock.Anything, mock.Anything, http.StatusCreated, mock.Anything, mock.Anything).Return(nil)
+
+	// Act
+	codes := []int{}
+	for _, subject := range []string{"alice", "bob"} {
+		req := newIdempotentRequest("key-1", `{"amount":1}`)
+		req.Header.Set("X-Subject", subject)
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+		codes = append(codes, w.Code)
+	}
+
+	// Assert
+	if codes[0] != http.StatusCreated || codes[1] != http.StatusCreated {
+		t.Errorf("Expected both callers to be served, got %v", codes)
+	}
+	if len(reserved) != 2 || reserved["key-1"] {
+		t.Errorf("Expected one scoped key per caller, got %v", reserved)
+	}
+}
@@ -0,0 +1,102 @@
+package http
+
+import (
+	"arise_tech_assessment/internal/application/queries"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mehdihadeli/go-mediatr"
+)
+
+type LedgerHandler struct {
+}
+
+func NewLedgerHandler() *LedgerHandler {
+	return &LedgerHandler{}
+}
+
+// GetAccountLedger godoc
+// @Summary Get ledger entries for an account
+// @Description Get a paginated list of double-entry ledger postings for a specific account
+// @Tags ledger
+// @Accept json
+// @Produce json
+// @Param id path string true "Account ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10) maximum(100)
+// @Param cursor query string false "Cursor from a previous next_cursor or prev_cursor; takes precedence over page"
+// @Param include_total query bool false "Count the total number of results" default(true)
+// @Success 200 {object} queries.GetAccountLedgerResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /accounts/{id}/ledger [get]
+func (h *LedgerHandler) GetAccountLedger(c *gin.Context) {
+	accountIDParam := c.Param("id")
+	accountID, err := uuid.Parse(accountIDParam)
+	if err != nil {
+		validationError(c, "Invalid account ID")
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	cursor, skipTotal, err := parseCursorParams(c)
+	if err != nil {
+		validationError(c, err.Error())
+		return
+	}
+
+	query := &queries.GetAccountLedgerQuery{
+		AccountID: accountID,
+		Page:      page,
+		PageSize:  pageSize,
+		Cursor:    cursor,
+		SkipTotal: skipTotal,
+	}
+
+	result, err := mediatr.Send[*queries.GetAccountLedgerQuery, *queries.GetAccountLedgerResponse](c.Request.Context(), query)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetLedgerBalance godoc
+// @Summary Rebuild an account balance from the ledger
+// @Description Sum the account's ledger entries and compare the result with the stored balance
+// @Tags ledger
+// @Accept json
+// @Produce json
+// @Param id path string true "Account ID"
+// @Success 200 {object} queries.GetLedgerBalanceResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /accounts/{id}/ledger/balance [get]
+func (h *LedgerHandler) GetLedgerBalance(c *gin.Context) {
+	accountIDParam := c.Param("id")
+	accountID, err := uuid.Parse(accountIDParam)
+	if err != nil {
+		validationError(c, "Invalid account ID")
+		return
+	}
+
+	query := &queries.GetLedgerBalanceQuery{AccountID: accountID}
+	result, err := mediatr.Send[*queries.GetLedgerBalanceQuery, *queries.GetLedgerBalanceResponse](c.Request.Context(), query)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,178 @@
+package http
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// parseSort reads a sort parameter of the form "field:asc,other:desc". The
+// direction defaults to ascending and every field must be in the whitelist.
+func parseSort(raw string, fields map[string]string) ([]repository.SortField, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	var sort []repository.SortField
+	for _, part := range strings.Split(raw, ",") {
+		field, direction, _ := strings.Cut(strings.TrimSpace(part), ":")
+		if _, ok := fields[field]; !ok {
+			return nil, fmt.Errorf("cannot sort by %q", field)
+		}
+
+		switch repository.SortDirection(strings.ToLower(direction)) {
+		case repository.SortAscending, "":
+			sort = append(sort, repository.SortField{Field: field, Direction: repository.SortAscending})
+		case repository.SortDescending:
+			sort = append(sort, repository.SortField{Field: field, Direction: repository.SortDescending})
+		default:
+			return nil, fmt.Errorf("invalid sort direction %q, expected asc or desc", direction)
+		}
+	}
+
+	return sort, nil
+}
+
+// parseCursorParams reads the keyset pagination parameters shared by list
+// endpoints. The total is counted unless include_total=false.
+func parseCursorParams(c *gin.Context) (string, bool, error) {
+	includeTotal := true
+	if raw := c.Query("include_total"); raw != "" {
+		value, err := strconv.ParseBool(raw)
+		if err != nil {
+			return "", false, errors.New("include_total must be true or false")
+		}
+		includeTotal = value
+	}
+	return c.Query("cursor"), !includeTotal, nil
+}
+
+func parseAccountFilter(c *gin.Context) (repository.AccountFilter, error) {
+	var err error
+	filter := repository.AccountFilter{
+		Status:     domain.AccountStatus(c.Query("status")),
+		HolderName: c.Query("holder_name"),
+		OwnerID:    c.Query("owner_id"),
+		Currency:   domain.Currency(c.Query("currency")),
+	}
+
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return filter, fmt.Errorf("invalid status %q", filter.Status)
+	}
+	if filter.Currency != "" {
+		if err := filter.Currency.Validate(); err != nil {
+			return filter, err
+		}
+	}
+	if filter.MinBalance, err = optionalInt64(c, "min_balance"); err != nil {
+		return filter, err
+	}
+	if filter.MaxBalance, err = optionalInt64(c, "max_balance"); err != nil {
+		return filter, err
+	}
+	if filter.CreatedFrom, filter.CreatedTo, err = createdRange(c); err != nil {
+		return filter, err
+	}
+	if filter.IncludeDeleted, err = optionalBool(c, "include_deleted"); err != nil {
+		return filter, err
+	}
+
+	return filter, nil
+}
+
+func parseTransactionFilter(c *gin.Context) (repository.TransactionFilter, error) {
+	var err error
+	filter := repository.TransactionFilter{
+		Status:   domain.TransactionStatus(c.Query("status")),
+		Type:     domain.TransactionType(c.Query("type")),
+		Currency: domain.Currency(c.Query("currency")),
+	}
+
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return filter, fmt.Errorf("invalid status %q", filter.Status)
+	}
+	if filter.Type != "" && !filter.Type.IsValid() {
+		return filter, fmt.Errorf("invalid type %q", filter.Type)
+	}
+	if filter.Currency != "" {
+		if err := filter.Currency.Validate(); err != nil {
+			return filter, err
+		}
+	}
+	if raw := c.Query("account_id"); raw != "" {
+		accountID, err := uuid.Parse(raw)
+		if err != nil {
+			return filter, fmt.Errorf("invalid account_id %q", raw)
+		}
+		filter.AccountID = &accountID
+	}
+	if filter.MinAmount, err = optionalInt64(c, "min_amount"); err != nil {
+		return filter, err
+	}
+	if filter.MaxAmount, err = optionalInt64(c, "max_amount"); err != nil {
+		return filter, err
+	}
+	if filter.CreatedFrom, filter.CreatedTo, err = createdRange(c); err != nil {
+		return filter, err
+	}
+
+	return filter, nil
+}
+
+func createdRange(c *gin.Context) (*time.Time, *time.Time, error) {
+	from, err := optionalTime(c, "created_from")
+	if err != nil {
+		return nil, nil, err
+	}
+	to, err := optionalTime(c, "created_to")
+	if err != nil {
+		return nil, nil, err
+	}
+	if from != nil && to != nil && from.After(*to) {
+		return nil, nil, errors.New("created_from must not be after created_to")
+	}
+	return from, to, nil
+}
+
+func optionalInt64(c *gin.Context, name string) (*int64, error) {
+	raw := c.Query(name)
+	if raw == "" {
+		return nil, nil
+	}
+	value, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be an integer amount in minor units", name)
+	}
+	return &value, nil
+}
+
+func optionalTime(c *gin.Context, name string) (*time.Time, error) {
+	raw := c.Query(name)
+	if raw == "" {
+		return nil, nil
+	}
+	value, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be an RFC 3339 timestamp", name)
+	}
+	return &value, nil
+}
+
+func optionalBool(c *gin.Context, name string) (bool, error) {
+	raw := c.Query(name)
+	if raw == "" {
+		return false, nil
+	}
+	value, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, fmt.Errorf("%s must be true or false", name)
+	}
+	return value, nil
+}
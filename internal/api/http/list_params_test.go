@@ -0,0 +1,144 @@
+package http
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func newQueryContext(rawQuery string) *gin.Context {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/resource?"+rawQuery, nil)
+	return c
+}
+
+func TestParseSort_ShouldParseFieldsAndDirections(t *testing.T) {
+	// Act
+	sort, err := parseSort("balance:desc,holder_name", repository.AccountSortFields)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []repository.SortField{
+		{Field: "balance", Direction: repository.SortDescending},
+		{Field: "holder_name", Direction: repository.SortAscending},
+	}
+	if len(sort) != len(expected) {
+		t.Fatalf("Expected %d sort fields, got %d", len(expected), len(sort))
+	}
+	for i := range expected {
+		if sort[i] != expected[i] {
+			t.Errorf("Expected sort field %v, got %v", expected[i], sort[i])
+		}
+	}
+}
+
+func TestParseSort_ShouldRejectFieldsOutsideWhitelist(t *testing.T) {
+	tests := []string{"password:asc", "amount", "created_at:sideways"}
+
+	for _, raw := range tests {
+		t.Run(raw, func(t *testing.T) {
+			// Act
+			_, err := parseSort(raw, repository.AccountSortFields)
+
+			// Assert
+			if err == nil {
+				t.Errorf("Expected error for sort %q, got nil", raw)
+			}
+		})
+	}
+}
+
+func TestParseAccountFilter_ShouldReadAllFilters(t *testing.T) {
+	// Arrange
+	c := newQueryContext("status=blocked&holder_name=doe&currency=USD&min_balance=100&max_balance=5000&created_from=2024-01-01T00:00:00Z&created_to=2024-12-31T23:59:59Z&include_deleted=true")
+
+	// Act
+	filter, err := parseAccountFilter(c)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if filter.Status != domain.AccountStatusBlocked {
+		t.Errorf("Expected status blocked, got %s", filter.Status)
+	}
+	if filter.HolderName != "doe" {
+		t.Errorf("Expected holder name doe, got %s", filter.HolderName)
+	}
+	if filter.Currency != domain.USD {
+		t.Errorf("Expected currency USD, got %s", filter.Currency)
+	}
+	if filter.MinBalance == nil || *filter.MinBalance != 100 {
+		t.Errorf("Expected min balance 100, got %v", filter.MinBalance)
+	}
+	if filter.MaxBalance == nil || *filter.MaxBalance != 5000 {
+		t.Errorf("Expected max balance 5000, got %v", filter.MaxBalance)
+	}
+	if filter.CreatedFrom == nil || filter.CreatedTo == nil {
+		t.Error("Expected created range to be set")
+	}
+	if !filter.IncludeDeleted {
+		t.Error("Expected include deleted to be true")
+	}
+}
+
+func TestParseTransactionFilter_ShouldRejectInvalidValues(t *testing.T) {
+	tests := []string{
+		"status=unknown",
+		"type=refund",
+		"currency=XYZ",
+		"account_id=not-a-uuid",
+		"min_amount=ten",
+		"created_from=yesterday",
+		"created_from=2024-02-01T00:00:00Z&created_to=2024-01-01T00:00:00Z",
+	}
+
+	for _, rawQuery := range tests {
+		t.Run(rawQuery, func(t *testing.T) {
+			// Act
+			_, err := parseTransactionFilter(newQueryContext(rawQuery))
+
+			// Assert
+			if err == nil {
+				t.Errorf("Expected error for %q, got nil", rawQuery)
+			}
+		})
+	}
+}
+
+func TestParseCursorParams_ShouldCountTotalByDefault(t *testing.T) {
+	// Act
+	cursor, skipTotal, err := parseCursorParams(newQueryContext("cursor=abc"))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if cursor != "abc" {
+		t.Errorf("Expected cursor abc, got %s", cursor)
+	}
+	if skipTotal {
+		t.Error("Expected total to be counted")
+	}
+}
+
+func TestParseCursorParams_ShouldSkipTotalWhenDisabled(t *testing.T) {
+	// Act
+	_, skipTotal, err := parseCursorParams(newQueryContext("include_total=false"))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !skipTotal {
+		t.Error("Expected total to be skipped")
+	}
+}
@@ -0,0 +1,93 @@
+package http
+
+import (
+	"arise_tech_assessment/internal/infrastructure/logging"
+	"io"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	RequestIDHeader = "X-Request-ID"
+
+	maxRequestIDLength = 128
+)
+
+// RequestID reuses the caller's X-Request-ID when it is well formed and
+// generates one otherwise. The ID is echoed in the response and stored in the
+// request context, so every log record written while serving the request, down
+// to the SQL statements, carries it.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if !validRequestID(id) {
+			id = uuid.NewString()
+		}
+
+		c.Header(RequestIDHeader, id)
+		c.Request = c.Request.WithContext(logging.WithRequestID(c.Request.Context(), id))
+		c.Next()
+	}
+}
+
+// validRequestID accepts short IDs of printable ASCII so that callers cannot
+// inject arbitrary content into the logs.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// AccessLog writes one record per request. Requests are logged under their
+// route template rather than the raw path, which can hold account numbers.
+// Server errors are logged at error level together with the error that caused
+// them, which the response hides.
+func AccessLog() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		started := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+
+		status := c.Writer.Status()
+		attrs := []any{
+			slog.String("method", c.Request.Method),
+			slog.String("route", route),
+			slog.Int("status", status),
+			slog.Duration("duration", time.Since(started)),
+			slog.String("client_ip", c.ClientIP()),
+		}
+
+		if status >= http.StatusInternalServerError {
+			if err := c.Errors.Last(); err != nil {
+				attrs = append(attrs, slog.String("error", err.Error()))
+			}
+			slog.ErrorContext(c.Request.Context(), "Request failed", attrs...)
+			return
+		}
+		slog.InfoContext(c.Request.Context(), "Request served", attrs...)
+	}
+}
+
+// Recovery turns a panic in a handler into a 500 problem response and logs it.
+func Recovery() gin.HandlerFunc {
+	return gin.CustomRecoveryWithWriter(io.Discard, func(c *gin.Context, recovered any) {
+		slog.ErrorContext(c.Request.Context(), "Recovered from panic",
+			slog.Any("panic", recovered), slog.String("stack", string(debug.Stack())))
+		writeProblem(c, http.StatusInternalServerError, errorCodeInternal, "An unexpected error occurred")
+	})
+}
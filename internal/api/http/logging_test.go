@@ -0,0 +1,94 @@
+package http
+
+import (
+	"arise_tech_assessment/internal/infrastructure/logging"
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func serveWithRequestID(header string) (*httptest.ResponseRecorder, string) {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.Use(RequestID())
+
+	var seen string
+	engine.GET("/", func(c *gin.Context) {
+		seen = logging.RequestID(c.Request.Context())
+		c.Status(http.StatusNoContent)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if header != "" {
+		req.Header.Set(RequestIDHeader, header)
+	}
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+	return w, seen
+}
+
+func TestRequestID_ShouldReuseCallerID(t *testing.T) {
+	// Act
+	w, seen := serveWithRequestID("trace-abc-123")
+
+	// Assert
+	if seen != "trace-abc-123" {
+		t.Errorf("Expected the caller's ID in the context, got %q", seen)
+	}
+	if got := w.Header().Get(RequestIDHeader); got != "trace-abc-123" {
+		t.Errorf("Expected the caller's ID in the response, got %q", got)
+	}
+}
+
+func TestRequestID_ShouldGenerateIDWhenMissingOrInvalid(t *testing.T) {
+	for name, header := range map[string]string{
+		"missing":  "",
+		"too long": strings.Repeat("a", maxRequestIDLength+1),
+		"newline":  "abc\ninjected",
+	} {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			w, seen := serveWithRequestID(header)
+
+			// Assert
+			if _, err := uuid.Parse(seen); err != nil {
+				t.Errorf("Expected a generated UUID, got %q", seen)
+			}
+			if got := w.Header().Get(RequestIDHeader); got != seen {
+				t.Errorf("Expected response header %q, got %q", seen, got)
+			}
+		})
+	}
+}
+
+func TestAccessLog_ShouldNotLogPathParameters(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&buf, nil)))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.Use(AccessLog())
+	engine.GET("/accounts/number/:number", func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	// Act
+	engine.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/accounts/number/ACC1234567890", nil))
+
+	// Assert
+	if strings.Contains(buf.String(), "ACC1234567890") {
+		t.Errorf("Expected the account number to stay out of the access log, got %s", buf.String())
+	}
+	if !strings.Contains(buf.String(), `"route":"/accounts/number/:number"`) {
+		t.Errorf("Expected the route template in the access log, got %s", buf.String())
+	}
+}
@@ -0,0 +1,27 @@
+package http
+
+import (
+	"arise_tech_assessment/internal/infrastructure/metrics"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// unmatchedRoute labels requests that matched no route, so that arbitrary
+// paths cannot grow the metric label set.
+const unmatchedRoute = "unmatched"
+
+// Metrics records the duration and status of every request under its route
+// template. It must run before ErrorHandler so that it sees the final status.
+func Metrics(m *metrics.Metrics) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		started := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		m.ObserveHTTPRequest(c.Request.Method, route, c.Writer.Status(), time.Since(started))
+	}
+}
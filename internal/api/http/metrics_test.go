@@ -0,0 +1,42 @@
+package http
+
+import (
+	"arise_tech_assessment/internal/infrastructure/metrics"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestMetrics_ShouldLabelRequestsByRouteTemplate(t *testing.T) {
+	// Arrange
+	m := metrics.New()
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.Use(Metrics(m))
+	engine.GET("/accounts/:id", func(c *gin.Context) { c.Status(http.StatusNoContent) })
+	engine.GET("/metrics", gin.WrapH(m.Handler()))
+
+	// Act
+	for _, path := range []string{"/accounts/1", "/accounts/2", "/unknown/path"} {
+		engine.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	// Assert
+	body := w.Body.String()
+	for _, expected := range []string{
+		`http_request_duration_seconds_count{method="GET",route="/accounts/:id",status="204"} 2`,
+		`http_request_duration_seconds_count{method="GET",route="unmatched",status="404"} 1`,
+	} {
+		if !strings.Contains(body, expected) {
+			t.Errorf("Expected metrics to contain %s", expected)
+		}
+	}
+	if strings.Contains(body, "/accounts/1") {
+		t.Error("Expected raw paths not to be used as labels")
+	}
+}
@@ -0,0 +1,110 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func serveTraced(t *testing.T, path string, header http.Header) tracetest.SpanStub {
+	t.Helper()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.Use(Tracing(provider), ErrorHandler())
+	engine.GET("/accounts/:id", func(c *gin.Context) {
+		if !trace.SpanContextFromContext(c.Request.Context()).IsValid() {
+			t.Error("Expected the span in the request context")
+		}
+		c.Status(http.StatusNoContent)
+	})
+	engine.GET("/broken", func(c *gin.Context) {
+		_ = c.Error(errors.New("connection reset"))
+	})
+
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	engine.ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(spans))
+	}
+	return spans[0]
+}
+
+func TestTracing_ShouldNameSpanAfterRoute(t *testing.T) {
+	// Act
+	span := serveTraced(t, "/accounts/42", nil)
+
+	// Assert
+	if span.Name != "GET /accounts/:id" {
+		t.Errorf("Expected span name GET /accounts/:id, got %s", span.Name)
+	}
+	if span.SpanKind != trace.SpanKindServer {
+		t.Errorf("Expected a server span, got %s", span.SpanKind)
+	}
+}
+
+func TestTracing_ShouldNotRecordPathParameters(t *testing.T) {
+	// Act
+	span := serveTraced(t, "/accounts/ACC1234567890", nil)
+
+	// Assert
+	route := ""
+	for _, attr := range span.Attributes {
+		if strings.Contains(attr.Value.Emit(), "ACC1234567890") {
+			t.Errorf("Expected the path parameter to stay out of the span, got %s=%s", attr.Key, attr.Value.Emit())
+		}
+		if attr.Key == semconv.HTTPRouteKey {
+			route = attr.Value.AsString()
+		}
+	}
+	if route != "/accounts/:id" {
+		t.Errorf("Expected http.route /accounts/:id, got %q", route)
+	}
+}
+
+func TestTracing_ShouldContinueCallerTrace(t *testing.T) {
+	// Arrange
+	header := http.Header{}
+	header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+	// Act
+	span := serveTraced(t, "/accounts/42", header)
+
+	// Assert
+	if got := span.SpanContext.TraceID().String(); got != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("Expected the caller's trace ID, got %s", got)
+	}
+	if got := span.Parent.SpanID().String(); got != "00f067aa0ba902b7" {
+		t.Errorf("Expected the caller's span as parent, got %s", got)
+	}
+}
+
+func TestTracing_ShouldMarkServerErrors(t *testing.T) {
+	// Act
+	span := serveTraced(t, "/broken", nil)
+
+	// Assert
+	if span.Status.Code != codes.Error {
+		t.Errorf("Expected error status, got %+v", span.Status)
+	}
+	if len(span.Events) != 1 || span.Events[0].Name != "exception" {
+		t.Errorf("Expected the error to be recorded, got %+v", span.Events)
+	}
+}
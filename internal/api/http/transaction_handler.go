@@ -3,6 +3,7 @@ package http
 import (
 	"arise_tech_assessment/internal/application/commands"
 	"arise_tech_assessment/internal/application/queries"
+	"arise_tech_assessment/internal/infrastructure/repository"
 	"net/http"
 	"strconv"
 
@@ -24,21 +25,25 @@ func NewTransactionHandler() *TransactionHandler {
 // @Tags transactions
 // @Accept json
 // @Produce json
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
 // @Param transaction body commands.CreateTransactionCommand true "Transaction creation data"
 // @Success 201 {object} commands.CreateTransactionResponse
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} Problem
+// @Failure 422 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
 // @Router /transactions [post]
 func (h *TransactionHandler) CreateTransaction(c *gin.Context) {
 	var cmd commands.CreateTransactionCommand
 	if err := c.ShouldBindJSON(&cmd); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		validationError(c, err.Error())
 		return
 	}
 
 	result, err := mediatr.Send[*commands.CreateTransactionCommand, *commands.CreateTransactionResponse](c.Request.Context(), &cmd)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = c.Error(err)
 		return
 	}
 
@@ -53,21 +58,24 @@ func (h *TransactionHandler) CreateTransaction(c *gin.Context) {
 // @Produce json
 // @Param id path string true "Transaction ID"
 // @Success 200 {object} queries.GetTransactionResponse
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
 // @Router /transactions/{id} [get]
 func (h *TransactionHandler) GetTransaction(c *gin.Context) {
 	idParam := c.Param("id")
 	id, err := uuid.Parse(idParam)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid transaction ID"})
+		validationError(c, "Invalid transaction ID")
 		return
 	}
 
 	query := &queries.GetTransactionQuery{ID: id}
 	result, err := mediatr.Send[*queries.GetTransactionQuery, *queries.GetTransactionResponse](c.Request.Context(), query)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = c.Error(err)
 		return
 	}
 
@@ -76,27 +84,63 @@ func (h *TransactionHandler) GetTransaction(c *gin.Context) {
 
 // GetTransactions godoc
 // @Summary Get all transactions
-// @Description Get a paginated list of all transactions
+// @Description Get a paginated list of transactions, optionally filtered and sorted
 // @Tags transactions
 // @Accept json
 // @Produce json
 // @Param page query int false "Page number" default(1)
-// @Param page_size query int false "Page size" default(10)
+// @Param page_size query int false "Page size" default(10) maximum(100)
+// @Param cursor query string false "Cursor from a previous next_cursor or prev_cursor; takes precedence over page"
+// @Param include_total query bool false "Count the total number of results" default(true)
+// @Param status query string false "Transaction status" Enums(pending, completed, failed, cancelled)
+// @Param type query string false "Transaction type" Enums(deposit, withdraw, transfer)
+// @Param account_id query string false "Source or destination account ID"
+// @Param currency query string false "Amount currency"
+// @Param min_amount query int false "Minimum amount in minor units"
+// @Param max_amount query int false "Maximum amount in minor units"
+// @Param created_from query string false "Created at or after (RFC 3339)"
+// @Param created_to query string false "Created at or before (RFC 3339)"
+// @Param sort query string false "Comma-separated field:asc|desc pairs; fields: created_at, updated_at, amount, type, status, reference" default(created_at:desc)
 // @Success 200 {object} queries.GetTransactionsResponse
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
 // @Router /transactions [get]
 func (h *TransactionHandler) GetTransactions(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
 
+	cursor, skipTotal, err := parseCursorParams(c)
+	if err != nil {
+		validationError(c, err.Error())
+		return
+	}
+
+	filter, err := parseTransactionFilter(c)
+	if err != nil {
+		validationError(c, err.Error())
+		return
+	}
+
+	sort, err := parseSort(c.Query("sort"), repository.TransactionSortFields)
+	if err != nil {
+		validationError(c, err.Error())
+		return
+	}
+
 	query := &queries.GetTransactionsQuery{
-		Page:     page,
-		PageSize: pageSize,
+		Page:      page,
+		PageSize:  pageSize,
+		Cursor:    cursor,
+		SkipTotal: skipTotal,
+		Filter:    filter,
+		Sort:      sort,
 	}
 
 	result, err := mediatr.Send[*queries.GetTransactionsQuery, *queries.GetTransactionsResponse](c.Request.Context(), query)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = c.Error(err)
 		return
 	}
 
@@ -111,31 +155,43 @@ func (h *TransactionHandler) GetTransactions(c *gin.Context) {
 // @Produce json
 // @Param id path string true "Account ID"
 // @Param page query int false "Page number" default(1)
-// @Param page_size query int false "Page size" default(10)
+// @Param page_size query int false "Page size" default(10) maximum(100)
+// @Param cursor query string false "Cursor from a previous next_cursor or prev_cursor; takes precedence over page"
+// @Param include_total query bool false "Count the total number of results" default(true)
 // @Success 200 {object} queries.GetAccountTransactionsResponse
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
 // @Router /accounts/{id}/transactions [get]
 func (h *TransactionHandler) GetAccountTransactions(c *gin.Context) {
 	accountIDParam := c.Param("id")
 	accountID, err := uuid.Parse(accountIDParam)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid account ID"})
+		validationError(c, "Invalid account ID")
 		return
 	}
 
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
 
+	cursor, skipTotal, err := parseCursorParams(c)
+	if err != nil {
+		validationError(c, err.Error())
+		return
+	}
+
 	query := &queries.GetAccountTransactionsQuery{
 		AccountID: accountID,
 		Page:      page,
 		PageSize:  pageSize,
+		Cursor:    cursor,
+		SkipTotal: skipTotal,
 	}
 
 	result, err := mediatr.Send[*queries.GetAccountTransactionsQuery, *queries.GetAccountTransactionsResponse](c.Request.Context(), query)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = c.Error(err)
 		return
 	}
 
@@ -149,22 +205,28 @@ func (h *TransactionHandler) GetAccountTransactions(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path string true "Transaction ID"
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
 // @Success 200 {object} commands.ProcessTransactionResponse
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 422 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
 // @Router /transactions/{id}/process [post]
 func (h *TransactionHandler) ProcessTransaction(c *gin.Context) {
 	idParam := c.Param("id")
 	id, err := uuid.Parse(idParam)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid transaction ID"})
+		validationError(c, "Invalid transaction ID")
 		return
 	}
 
 	cmd := &commands.ProcessTransactionCommand{ID: id}
 	result, err := mediatr.Send[*commands.ProcessTransactionCommand, *commands.ProcessTransactionResponse](c.Request.Context(), cmd)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = c.Error(err)
 		return
 	}
 
@@ -178,22 +240,27 @@ func (h *TransactionHandler) ProcessTransaction(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path string true "Transaction ID"
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
 // @Success 200 {object} commands.CancelTransactionResponse
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
 // @Router /transactions/{id}/cancel [post]
 func (h *TransactionHandler) CancelTransaction(c *gin.Context) {
 	idParam := c.Param("id")
 	id, err := uuid.Parse(idParam)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid transaction ID"})
+		validationError(c, "Invalid transaction ID")
 		return
 	}
 
 	cmd := &commands.CancelTransactionCommand{ID: id}
 	result, err := mediatr.Send[*commands.CancelTransactionCommand, *commands.CancelTransactionResponse](c.Request.Context(), cmd)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = c.Error(err)
 		return
 	}
 
@@ -0,0 +1,219 @@
+package http
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/application/queries"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mehdihadeli/go-mediatr"
+)
+
+type WebhookHandler struct {
+}
+
+func NewWebhookHandler() *WebhookHandler {
+	return &WebhookHandler{}
+}
+
+// CreateWebhook godoc
+// @Summary Subscribe to events
+// @Description Register a URL to receive the listed account and transaction events. Each delivery is a POST signed with HMAC-SHA256 of "<X-Webhook-Timestamp>.<body>" keyed with the secret, sent in X-Webhook-Signature as sha256=<hex>.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param webhook body commands.CreateWebhookCommand true "Webhook subscription data"
+// @Success 201 {object} commands.CreateWebhookResponse
+// @Failure 400 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /webhooks [post]
+func (h *WebhookHandler) CreateWebhook(c *gin.Context) {
+	var cmd commands.CreateWebhookCommand
+	if err := c.ShouldBindJSON(&cmd); err != nil {
+		validationError(c, err.Error())
+		return
+	}
+
+	result, err := mediatr.Send[*commands.CreateWebhookCommand, *commands.CreateWebhookResponse](c.Request.Context(), &cmd)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// GetWebhooks godoc
+// @Summary Get webhook subscriptions
+// @Description Get a paginated list of webhook subscriptions. Secrets are never returned.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10) maximum(100)
+// @Success 200 {object} queries.GetWebhooksResponse
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /webhooks [get]
+func (h *WebhookHandler) GetWebhooks(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	query := &queries.GetWebhooksQuery{
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	result, err := mediatr.Send[*queries.GetWebhooksQuery, *queries.GetWebhooksResponse](c.Request.Context(), query)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetWebhook godoc
+// @Summary Get webhook subscription by ID
+// @Description Get a single webhook subscription by its ID
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Success 200 {object} queries.GetWebhookResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /webhooks/{id} [get]
+func (h *WebhookHandler) GetWebhook(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		validationError(c, "Invalid webhook ID")
+		return
+	}
+
+	query := &queries.GetWebhookQuery{ID: id}
+	result, err := mediatr.Send[*queries.GetWebhookQuery, *queries.GetWebhookResponse](c.Request.Context(), query)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// DeleteWebhook godoc
+// @Summary Delete a webhook subscription
+// @Description Delete a webhook subscription and its delivery log. Pending deliveries are dropped.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Success 200 {object} commands.DeleteWebhookResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /webhooks/{id} [delete]
+func (h *WebhookHandler) DeleteWebhook(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		validationError(c, "Invalid webhook ID")
+		return
+	}
+
+	cmd := &commands.DeleteWebhookCommand{ID: id}
+	result, err := mediatr.Send[*commands.DeleteWebhookCommand, *commands.DeleteWebhookResponse](c.Request.Context(), cmd)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetWebhookDeliveries godoc
+// @Summary Get the delivery log of a webhook
+// @Description Get a paginated list of the deliveries made to a webhook, newest first, with the outcome of their latest attempt
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10) maximum(100)
+// @Success 200 {object} queries.GetWebhookDeliveriesResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) GetWebhookDeliveries(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		validationError(c, "Invalid webhook ID")
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	query := &queries.GetWebhookDeliveriesQuery{
+		WebhookID: id,
+		Page:      page,
+		PageSize:  pageSize,
+	}
+
+	result, err := mediatr.Send[*queries.GetWebhookDeliveriesQuery, *queries.GetWebhookDeliveriesResponse](c.Request.Context(), query)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ReplayWebhookDelivery godoc
+// @Summary Replay a webhook delivery
+// @Description Send a delivery again with its original payload, whatever the outcome of its previous attempts. It is retried as a new delivery would be.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Param delivery_id path string true "Delivery ID"
+// @Success 202 {object} commands.ReplayWebhookDeliveryResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /webhooks/{id}/deliveries/{delivery_id}/replay [post]
+func (h *WebhookHandler) ReplayWebhookDelivery(c *gin.Context) {
+	webhookID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		validationError(c, "Invalid webhook ID")
+		return
+	}
+	deliveryID, err := uuid.Parse(c.Param("delivery_id"))
+	if err != nil {
+		validationError(c, "Invalid delivery ID")
+		return
+	}
+
+	cmd := &commands.ReplayWebhookDeliveryCommand{WebhookID: webhookID, DeliveryID: deliveryID}
+	result, err := mediatr.Send[*commands.ReplayWebhookDeliveryCommand, *commands.ReplayWebhookDeliveryResponse](c.Request.Context(), cmd)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, result)
+}
@@ -0,0 +1,34 @@
+package behaviors
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"context"
+
+	"github.com/mehdihadeli/go-mediatr"
+)
+
+// Authorizer decides whether a principal may send a request.
+type Authorizer interface {
+	Authorize(ctx context.Context, principal *domain.Principal, request interface{}) error
+}
+
+// AuthorizationBehavior rejects the requests the authenticated caller is not
+// allowed to send. Requests without a principal in their context come from
+// the service itself or from an API running without authentication, and are
+// let through.
+type AuthorizationBehavior struct {
+	authorizer Authorizer
+}
+
+func NewAuthorizationBehavior(authorizer Authorizer) *AuthorizationBehavior {
+	return &AuthorizationBehavior{authorizer: authorizer}
+}
+
+func (b *AuthorizationBehavior) Handle(ctx context.Context, request interface{}, next mediatr.RequestHandlerFunc) (interface{}, error) {
+	if principal, ok := domain.PrincipalFromContext(ctx); ok {
+		if err := b.authorizer.Authorize(ctx, principal, request); err != nil {
+			return nil, err
+		}
+	}
+	return next(ctx)
+}
@@ -0,0 +1,14 @@
+package behaviors
+
+import (
+	"reflect"
+)
+
+// RequestName is the request's type name without the package, e.g. CreateAccountCommand.
+func RequestName(request interface{}) string {
+	t := reflect.TypeOf(request)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Name()
+}
@@ -0,0 +1,243 @@
+package behaviors
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/application/queries"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"arise_tech_assessment/mocks"
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type handlerCalls struct {
+	count int
+}
+
+func (h *handlerCalls) returning(response interface{}, err error) func(ctx context.Context) (interface{}, error) {
+	return func(ctx context.Context) (interface{}, error) {
+		h.count++
+		return response, err
+	}
+}
+
+func TestValidationBehavior_ShouldRejectInvalidRequests(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  interface{}
+		expected string
+	}{
+		{"missing id", &commands.BlockAccountCommand{Reason: domain.StatusReasonCompliance, ChangedBy: "ops"}, "id is required"},
+		{"unknown reason", &commands.BlockAccountCommand{ID: uuid.New(), Reason: "bored", ChangedBy: "ops"}, `invalid status reason "bored"`},
+		{"blank holder", &commands.CreateAccountCommand{Number: "1234567890", HolderName: " ", InitialBalance: domain.NewMoney(0, domain.USD)}, "holder_name is required"},
+		{"zero amount", &commands.CreateTransactionCommand{Type: domain.TransactionTypeDeposit, Amount: domain.NewMoney(0, domain.USD), Description: "Deposit"}, "amount must be positive"},
+		{"negative page", &queries.GetAccountsQuery{Page: -1}, "page cannot be negative"},
+		{"oversized page", &queries.GetTransactionsQuery{PageSize: queries.MaxPageSize + 1}, "page_size cannot exceed 100"},
+		{"unsupported currency", &queries.GetExchangeRateQuery{BaseCurrency: "XXX", QuoteCurrency: domain.THB}, `unsupported currency "XXX"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			handler := &handlerCalls{}
+
+			// Act
+			_, err := NewValidationBehavior().Handle(context.Background(), tt.request, handler.returning(nil, nil))
+
+			// Assert
+			if !errors.Is(err, domain.ErrValidation) || err.Error() != tt.expected {
+				t.Errorf("Expected validation error %q, got %v", tt.expected, err)
+			}
+			if handler.count != 0 {
+				t.Error("Expected the handler not to run")
+			}
+		})
+	}
+}
+
+func TestValidationBehavior_ShouldPassValidRequests(t *testing.T) {
+	// Arrange
+	handler := &handlerCalls{}
+	command := &commands.ProcessTransactionCommand{ID: uuid.New()}
+
+	// Act
+	response, err := NewValidationBehavior().Handle(context.Background(), command, handler.returning("done", nil))
+
+	// Assert
+	if err != nil || response != "done" || handler.count != 1 {
+		t.Errorf("Expected the handler response, got %v, %v", response, err)
+	}
+}
+
+func TestTransactionBehavior_ShouldWrapTransactionalCommands(t *testing.T) {
+	// Arrange
+	txManager := mocks.NewMockTxManager(t)
+	txManager.EXPECT().
+		WithinTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(ctx context.Context, uow repository.UnitOfWork) error) error {
+			return fn(ctx, nil)
+		}).
+		Once()
+	handler := &handlerCalls{}
+
+	// Act
+	response, err := NewTransactionBehavior(txManager).Handle(context.Background(), &commands.CancelTransactionCommand{ID: uuid.New()},
+		handler.returning("cancelled", nil))
+
+	// Assert
+	if err != nil || response != "cancelled" || handler.count != 1 {
+		t.Errorf("Expected the handler response, got %v, %v", response, err)
+	}
+}
+
+func TestTransactionBehavior_ShouldReturnHandlerErrorToRollBack(t *testing.T) {
+	// Arrange
+	var rolledBackWith error
+	txManager := mocks.NewMockTxManager(t)
+	txManager.EXPECT().
+		WithinTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(ctx context.Context, uow repository.UnitOfWork) error) error {
+			rolledBackWith = fn(ctx, nil)
+			return rolledBackWith
+		}).
+		Once()
+	handler := &handlerCalls{}
+
+	// Act
+	_, err := NewTransactionBehavior(txManager).Handle(context.Background(), &commands.DeleteAccountCommand{ID: uuid.New()},
+		handler.returning(nil, domain.ErrNotFound))
+
+	// Assert
+	if !errors.Is(rolledBackWith, domain.ErrNotFound) || !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Expected the handler error to roll back and be returned, got %v", err)
+	}
+}
+
+func TestTransactionBehavior_ShouldSkipQueriesAndSelfManagedCommands(t *testing.T) {
+	for name, request := range map[string]interface{}{
+		"query":               &queries.GetAccountQuery{ID: uuid.New()},
+		"process transaction": &commands.ProcessTransactionCommand{ID: uuid.New()},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			txManager := mocks.NewMockTxManager(t) // fails the test if called
+			handler := &handlerCalls{}
+
+			// Act
+			_, err := NewTransactionBehavior(txManager).Handle(context.Background(), request, handler.returning(nil, nil))
+
+			// Assert
+			if err != nil || handler.count != 1 {
+				t.Errorf("Expected the handler to run directly, got %v", err)
+			}
+		})
+	}
+}
+
+func TestRecoveryBehavior_ShouldTurnPanicIntoError(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	behavior := NewRecoveryBehavior(slog.New(slog.NewJSONHandler(&buf, nil)))
+
+	// Act
+	response, err := behavior.Handle(context.Background(), &commands.CreateAccountCommand{}, func(ctx context.Context) (interface{}, error) {
+		panic("nil map")
+	})
+
+	// Assert
+	if response != nil || err == nil || err.Error() != "panic while handling CreateAccountCommand: nil map" {
+		t.Errorf("Expected panic error, got %v, %v", response, err)
+	}
+	if !strings.Contains(buf.String(), `"msg":"Recovered from panic in request handler"`) || !strings.Contains(buf.String(), `"stack"`) {
+		t.Errorf("Expected the panic to be logged with its stack, got %s", buf.String())
+	}
+}
+
+func TestLoggingBehavior_ShouldLogOutcomeAtMatchingLevel(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{"handled", nil, `"level":"DEBUG","msg":"Handled request"`},
+		{"rejected", domain.ErrInsufficientFunds, `"level":"INFO","msg":"Request rejected"`},
+		{"failed", errors.New("connection reset"), `"level":"ERROR","msg":"Request failed"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var buf bytes.Buffer
+			logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+			handler := &handlerCalls{}
+
+			// Act
+			_, err := NewLoggingBehavior(logger).Handle(context.Background(), &queries.GetAccountQuery{}, handler.returning(nil, tt.err))
+
+			// Assert
+			if err != tt.err {
+				t.Errorf("Expected the handler error to pass through, got %v", err)
+			}
+			if !strings.Contains(buf.String(), tt.expected) || !strings.Contains(buf.String(), `"request":"GetAccountQuery"`) {
+				t.Errorf("Expected %s, got %s", tt.expected, buf.String())
+			}
+		})
+	}
+}
+
+// authorizerFunc adapts a function to the Authorizer interface.
+type authorizerFunc func(ctx context.Context, principal *domain.Principal, request interface{}) error
+
+func (f authorizerFunc) Authorize(ctx context.Context, principal *domain.Principal, request interface{}) error {
+	return f(ctx, principal, request)
+}
+
+func TestAuthorizationBehavior_ShouldRejectForbiddenRequests(t *testing.T) {
+	// Arrange
+	handler := &handlerCalls{}
+	var authorized *domain.Principal
+	behavior := NewAuthorizationBehavior(authorizerFunc(func(ctx context.Context, principal *domain.Principal, request interface{}) error {
+		authorized = principal
+		return domain.NewError(domain.ErrorCodeForbidden, "only admins can block accounts")
+	}))
+	principal := &domain.Principal{Subject: "alice", Roles: []domain.Role{domain.RoleTeller}}
+	ctx := domain.ContextWithPrincipal(context.Background(), principal)
+
+	// Act
+	_, err := behavior.Handle(ctx, &commands.BlockAccountCommand{ID: uuid.New()}, handler.returning(nil, nil))
+
+	// Assert
+	if !errors.Is(err, domain.ErrForbidden) {
+		t.Errorf("Expected forbidden error, got %v", err)
+	}
+	if authorized != principal {
+		t.Errorf("Expected the principal of the context to be authorized, got %v", authorized)
+	}
+	if handler.count != 0 {
+		t.Error("Expected the handler not to run")
+	}
+}
+
+func TestAuthorizationBehavior_ShouldPassRequestsWithoutPrincipal(t *testing.T) {
+	// Arrange
+	handler := &handlerCalls{}
+	behavior := NewAuthorizationBehavior(authorizerFunc(func(ctx context.Context, principal *domain.Principal, request interface{}) error {
+		t.Error("Expected requests without principal not to be authorized")
+		return nil
+	}))
+
+	// Act
+	response, err := behavior.Handle(context.Background(), &commands.BlockAccountCommand{ID: uuid.New()}, handler.returning("done", nil))
+
+	// Assert
+	if err != nil || response != "done" || handler.count != 1 {
+		t.Errorf("Expected the handler response, got %v, %v", response, err)
+	}
+}
@@ -0,0 +1,40 @@
+package behaviors
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/mehdihadeli/go-mediatr"
+)
+
+// LoggingBehavior logs every request with its duration. Handled requests are
+// logged at debug level, requests rejected with a domain error at info and
+// any other failure at error.
+type LoggingBehavior struct {
+	logger *slog.Logger
+}
+
+func NewLoggingBehavior(logger *slog.Logger) *LoggingBehavior {
+	return &LoggingBehavior{logger: logger}
+}
+
+func (b *LoggingBehavior) Handle(ctx context.Context, request interface{}, next mediatr.RequestHandlerFunc) (interface{}, error) {
+	started := time.Now()
+	response, err := next(ctx)
+
+	attrs := []any{slog.String("request", RequestName(request)), slog.Duration("duration", time.Since(started))}
+	var domainErr *domain.Error
+	switch {
+	case err == nil:
+		b.logger.DebugContext(ctx, "Handled request", attrs...)
+	case errors.As(err, &domainErr):
+		b.logger.InfoContext(ctx, "Request rejected", append(attrs, slog.String("code", string(domainErr.Code)), slog.String("error", err.Error()))...)
+	default:
+		b.logger.ErrorContext(ctx, "Request failed", append(attrs, slog.String("error", err.Error()))...)
+	}
+
+	return response, err
+}
@@ -0,0 +1,32 @@
+package behaviors
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+
+	"github.com/mehdihadeli/go-mediatr"
+)
+
+// RecoveryBehavior turns a panic in a later behavior or in the handler into an
+// error, so that one bad request cannot take the process down.
+type RecoveryBehavior struct {
+	logger *slog.Logger
+}
+
+func NewRecoveryBehavior(logger *slog.Logger) *RecoveryBehavior {
+	return &RecoveryBehavior{logger: logger}
+}
+
+func (b *RecoveryBehavior) Handle(ctx context.Context, request interface{}, next mediatr.RequestHandlerFunc) (response interface{}, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			name := RequestName(request)
+			b.logger.ErrorContext(ctx, "Recovered from panic in request handler",
+				slog.String("request", name), slog.Any("panic", recovered), slog.String("stack", string(debug.Stack())))
+			response, err = nil, fmt.Errorf("panic while handling %s: %v", name, recovered)
+		}
+	}()
+	return next(ctx)
+}
@@ -0,0 +1,36 @@
+package behaviors
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+
+	"github.com/mehdihadeli/go-mediatr"
+)
+
+// TransactionBehavior runs transactional commands in one database
+// transaction, committed only when the handler succeeds. Transactions the
+// handler opens through the TxManager join it as savepoints, and repositories
+// called with the handler's context use it too. Queries run as they are.
+type TransactionBehavior struct {
+	txManager repository.TxManager
+}
+
+func NewTransactionBehavior(txManager repository.TxManager) *TransactionBehavior {
+	return &TransactionBehavior{txManager: txManager}
+}
+
+func (b *TransactionBehavior) Handle(ctx context.Context, request interface{}, next mediatr.RequestHandlerFunc) (interface{}, error) {
+	command, ok := request.(commands.Command)
+	if !ok || !command.Transactional() {
+		return next(ctx)
+	}
+
+	var response interface{}
+	err := b.txManager.WithinTransaction(ctx, func(ctx context.Context, _ repository.UnitOfWork) error {
+		var err error
+		response, err = next(ctx)
+		return err
+	})
+	return response, err
+}
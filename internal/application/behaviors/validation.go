@@ -0,0 +1,29 @@
+package behaviors
+
+import (
+	"context"
+
+	"github.com/mehdihadeli/go-mediatr"
+)
+
+// Validator is implemented by requests that can check themselves.
+type Validator interface {
+	Validate() error
+}
+
+// ValidationBehavior rejects requests whose Validate method fails, before the
+// handler runs or a database transaction is opened.
+type ValidationBehavior struct{}
+
+func NewValidationBehavior() *ValidationBehavior {
+	return &ValidationBehavior{}
+}
+
+func (b *ValidationBehavior) Handle(ctx context.Context, request interface{}, next mediatr.RequestHandlerFunc) (interface{}, error) {
+	if validator, ok := request.(Validator); ok {
+		if err := validator.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return next(ctx)
+}
@@ -0,0 +1,28 @@
+package commands
+
+import (
+	"arise_tech_assessment/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type ActivateAccountCommand struct {
+	ID        uuid.UUID           `json:"-"`
+	Reason    domain.StatusReason `json:"reason" binding:"required" example:"customer_request"`
+	ChangedBy string              `json:"changed_by" example:"ops@example.com"` // taken from the authenticated caller when there is one
+}
+
+type ActivateAccountResponse struct {
+	Account *domain.Account `json:"account"`
+}
+
+func (c *ActivateAccountCommand) Validate() error {
+	if c.ID == uuid.Nil {
+		return errIDRequired
+	}
+	return domain.StatusChange{Reason: c.Reason, ChangedBy: c.ChangedBy}.Validate()
+}
+
+func (c *ActivateAccountCommand) Transactional() bool {
+	return true
+}
@@ -13,3 +13,14 @@ type CancelTransactionCommand struct {
 type CancelTransactionResponse struct {
 	Transaction *domain.Transaction `json:"transaction"`
 }
+
+func (c *CancelTransactionCommand) Validate() error {
+	if c.ID == uuid.Nil {
+		return errIDRequired
+	}
+	return nil
+}
+
+func (c *CancelTransactionCommand) Transactional() bool {
+	return true
+}
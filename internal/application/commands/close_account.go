@@ -0,0 +1,28 @@
+package commands
+
+import (
+	"arise_tech_assessment/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type CloseAccountCommand struct {
+	ID        uuid.UUID           `json:"-"`
+	Reason    domain.StatusReason `json:"reason" binding:"required" example:"customer_request"`
+	ChangedBy string              `json:"changed_by" example:"ops@example.com"` // taken from the authenticated caller when there is one
+}
+
+type CloseAccountResponse struct {
+	Account *domain.Account `json:"account"`
+}
+
+func (c *CloseAccountCommand) Validate() error {
+	if c.ID == uuid.Nil {
+		return errIDRequired
+	}
+	return domain.StatusChange{Reason: c.Reason, ChangedBy: c.ChangedBy}.Validate()
+}
+
+func (c *CloseAccountCommand) Transactional() bool {
+	return true
+}
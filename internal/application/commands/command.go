@@ -0,0 +1,18 @@
+package commands
+
+import (
+	"arise_tech_assessment/internal/domain"
+)
+
+// Command is implemented by every command. Pipeline behaviors use it to tell
+// commands, which change state, from queries.
+type Command interface {
+	// Validate rejects malformed commands before the handler runs.
+	Validate() error
+	// Transactional reports whether the pipeline should run the handler in a
+	// single database transaction. Handlers that must commit part of their
+	// work even when they fail manage their own transactions instead.
+	Transactional() bool
+}
+
+var errIDRequired = domain.NewError(domain.ErrorCodeValidation, "id is required")
@@ -2,14 +2,30 @@ package commands
 
 import (
 	"arise_tech_assessment/internal/domain"
+	"strings"
 )
 
 type CreateAccountCommand struct {
 	Number         string       `json:"number" binding:"required"`
 	HolderName     string       `json:"holder_name" binding:"required"`
 	InitialBalance domain.Money `json:"initial_balance" binding:"required"`
+	OwnerID        string       `json:"owner_id,omitempty" example:"customer-42"` // subject of the customer the account belongs to
 }
 
 type CreateAccountResponse struct {
 	Account *domain.Account `json:"account"`
 }
+
+func (c *CreateAccountCommand) Validate() error {
+	if strings.TrimSpace(c.Number) == "" {
+		return domain.NewError(domain.ErrorCodeValidation, "number is required")
+	}
+	if strings.TrimSpace(c.HolderName) == "" {
+		return domain.NewError(domain.ErrorCodeValidation, "holder_name is required")
+	}
+	return c.InitialBalance.Validate()
+}
+
+func (c *CreateAccountCommand) Transactional() bool {
+	return true
+}
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"arise_tech_assessment/internal/domain"
+	"strings"
 
 	"github.com/google/uuid"
 )
@@ -17,3 +18,23 @@ type CreateTransactionCommand struct {
 type CreateTransactionResponse struct {
 	Transaction *domain.Transaction `json:"transaction"`
 }
+
+func (c *CreateTransactionCommand) Validate() error {
+	if !c.Type.IsValid() {
+		return domain.NewError(domain.ErrorCodeValidation, "invalid transaction type")
+	}
+	if err := c.Amount.Validate(); err != nil {
+		return err
+	}
+	if !c.Amount.IsPositive() {
+		return domain.NewError(domain.ErrorCodeValidation, "amount must be positive")
+	}
+	if strings.TrimSpace(c.Description) == "" {
+		return domain.NewError(domain.ErrorCodeValidation, "description is required")
+	}
+	return nil
+}
+
+func (c *CreateTransactionCommand) Transactional() bool {
+	return true
+}
@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"strings"
+)
+
+type CreateWebhookCommand struct {
+	URL        string   `json:"url" binding:"required" example:"https://example.com/hooks/gocrud"`
+	EventTypes []string `json:"event_types" binding:"required" example:"transaction.completed,account.blocked"`
+	Secret     string   `json:"secret" binding:"required" example:"whsec-change-me-0123456789"` // signs the deliveries, at least 16 characters
+}
+
+type CreateWebhookResponse struct {
+	Webhook *domain.WebhookSubscription `json:"webhook"`
+}
+
+func (c *CreateWebhookCommand) Validate() error {
+	if strings.TrimSpace(c.URL) == "" {
+		return domain.NewError(domain.ErrorCodeValidation, "url is required")
+	}
+	if len(c.EventTypes) == 0 {
+		return domain.NewError(domain.ErrorCodeValidation, "at least one event type is required")
+	}
+	if c.Secret == "" {
+		return domain.NewError(domain.ErrorCodeValidation, "secret is required")
+	}
+	return nil
+}
+
+func (c *CreateWebhookCommand) Transactional() bool {
+	return true
+}
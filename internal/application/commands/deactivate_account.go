@@ -0,0 +1,28 @@
+package commands
+
+import (
+	"arise_tech_assessment/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type DeactivateAccountCommand struct {
+	ID        uuid.UUID           `json:"-"`
+	Reason    domain.StatusReason `json:"reason" binding:"required" example:"customer_request"`
+	ChangedBy string              `json:"changed_by" example:"ops@example.com"` // taken from the authenticated caller when there is one
+}
+
+type DeactivateAccountResponse struct {
+	Account *domain.Account `json:"account"`
+}
+
+func (c *DeactivateAccountCommand) Validate() error {
+	if c.ID == uuid.Nil {
+		return errIDRequired
+	}
+	return domain.StatusChange{Reason: c.Reason, ChangedBy: c.ChangedBy}.Validate()
+}
+
+func (c *DeactivateAccountCommand) Transactional() bool {
+	return true
+}
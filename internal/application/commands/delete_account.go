@@ -10,4 +10,15 @@ type DeleteAccountCommand struct {
 
 type DeleteAccountResponse struct {
 	Success bool `json:"success"`
-}
\ No newline at end of file
+}
+
+func (c *DeleteAccountCommand) Validate() error {
+	if c.ID == uuid.Nil {
+		return errIDRequired
+	}
+	return nil
+}
+
+func (c *DeleteAccountCommand) Transactional() bool {
+	return true
+}
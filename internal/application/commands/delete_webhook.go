@@ -0,0 +1,24 @@
+package commands
+
+import (
+	"github.com/google/uuid"
+)
+
+type DeleteWebhookCommand struct {
+	ID uuid.UUID `json:"id" binding:"required"`
+}
+
+type DeleteWebhookResponse struct {
+	Success bool `json:"success"`
+}
+
+func (c *DeleteWebhookCommand) Validate() error {
+	if c.ID == uuid.Nil {
+		return errIDRequired
+	}
+	return nil
+}
+
+func (c *DeleteWebhookCommand) Transactional() bool {
+	return true
+}
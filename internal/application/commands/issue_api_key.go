@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"strings"
+	"time"
+)
+
+type IssueAPIKeyCommand struct {
+	Name      string      `json:"name" binding:"required" example:"payments-service"`
+	Subject   string      `json:"subject" binding:"required" example:"payments-service"` // the principal the key authenticates as
+	Role      domain.Role `json:"role" binding:"required" example:"teller"`
+	ExpiresAt *time.Time  `json:"expires_at,omitempty"` // never expires when omitted
+}
+
+type IssueAPIKeyResponse struct {
+	APIKey *domain.APIKey `json:"api_key"`
+	Key    string         `json:"key" example:"gck_3q2v9X1b..."` // shown only once
+}
+
+func (c *IssueAPIKeyCommand) Validate() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return domain.NewError(domain.ErrorCodeValidation, "name is required")
+	}
+	if strings.TrimSpace(c.Subject) == "" {
+		return domain.NewError(domain.ErrorCodeValidation, "subject is required")
+	}
+	return c.Role.Validate()
+}
+
+func (c *IssueAPIKeyCommand) Transactional() bool {
+	return true
+}
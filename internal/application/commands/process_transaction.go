@@ -13,3 +13,16 @@ type ProcessTransactionCommand struct {
 type ProcessTransactionResponse struct {
 	Transaction *domain.Transaction `json:"transaction"`
 }
+
+func (c *ProcessTransactionCommand) Validate() error {
+	if c.ID == uuid.Nil {
+		return errIDRequired
+	}
+	return nil
+}
+
+// Transactional is false because a failed transaction is recorded as failed in
+// its own database transaction, which must survive the error.
+func (c *ProcessTransactionCommand) Transactional() bool {
+	return false
+}
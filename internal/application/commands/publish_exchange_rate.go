@@ -0,0 +1,35 @@
+package commands
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"strings"
+	"time"
+)
+
+type PublishExchangeRateCommand struct {
+	BaseCurrency  domain.Currency `json:"base_currency" binding:"required" example:"USD"`
+	QuoteCurrency domain.Currency `json:"quote_currency" binding:"required" example:"THB"`
+	Rate          string          `json:"rate" binding:"required" example:"35.5"`
+	EffectiveAt   *time.Time      `json:"effective_at,omitempty"` // defaults to now
+}
+
+type PublishExchangeRateResponse struct {
+	ExchangeRate *domain.ExchangeRate `json:"exchange_rate"`
+}
+
+func (c *PublishExchangeRateCommand) Validate() error {
+	if err := c.BaseCurrency.Validate(); err != nil {
+		return err
+	}
+	if err := c.QuoteCurrency.Validate(); err != nil {
+		return err
+	}
+	if strings.TrimSpace(c.Rate) == "" {
+		return domain.NewError(domain.ErrorCodeValidation, "rate is required")
+	}
+	return nil
+}
+
+func (c *PublishExchangeRateCommand) Transactional() bool {
+	return true
+}
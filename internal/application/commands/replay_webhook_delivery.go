@@ -0,0 +1,27 @@
+package commands
+
+import (
+	"arise_tech_assessment/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type ReplayWebhookDeliveryCommand struct {
+	WebhookID  uuid.UUID `json:"webhook_id" binding:"required"`
+	DeliveryID uuid.UUID `json:"delivery_id" binding:"required"`
+}
+
+type ReplayWebhookDeliveryResponse struct {
+	Delivery *domain.WebhookDelivery `json:"delivery"`
+}
+
+func (c *ReplayWebhookDeliveryCommand) Validate() error {
+	if c.WebhookID == uuid.Nil || c.DeliveryID == uuid.Nil {
+		return errIDRequired
+	}
+	return nil
+}
+
+func (c *ReplayWebhookDeliveryCommand) Transactional() bool {
+	return true
+}
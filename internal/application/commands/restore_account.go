@@ -0,0 +1,26 @@
+package commands
+
+import (
+	"arise_tech_assessment/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type RestoreAccountCommand struct {
+	ID uuid.UUID `json:"id" binding:"required"`
+}
+
+type RestoreAccountResponse struct {
+	Account *domain.Account `json:"account"`
+}
+
+func (c *RestoreAccountCommand) Validate() error {
+	if c.ID == uuid.Nil {
+		return errIDRequired
+	}
+	return nil
+}
+
+func (c *RestoreAccountCommand) Transactional() bool {
+	return true
+}
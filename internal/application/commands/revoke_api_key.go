@@ -0,0 +1,26 @@
+package commands
+
+import (
+	"arise_tech_assessment/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type RevokeAPIKeyCommand struct {
+	ID uuid.UUID `json:"id" binding:"required"`
+}
+
+type RevokeAPIKeyResponse struct {
+	APIKey *domain.APIKey `json:"api_key"`
+}
+
+func (c *RevokeAPIKeyCommand) Validate() error {
+	if c.ID == uuid.Nil {
+		return errIDRequired
+	}
+	return nil
+}
+
+func (c *RevokeAPIKeyCommand) Transactional() bool {
+	return true
+}
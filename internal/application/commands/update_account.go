@@ -9,8 +9,20 @@ import (
 type UpdateAccountCommand struct {
 	ID         uuid.UUID `json:"id" binding:"required"`
 	HolderName string    `json:"holder_name"`
+	OwnerID    string    `json:"owner_id,omitempty"` // links the account to a customer, unchanged when empty
 }
 
 type UpdateAccountResponse struct {
 	Account *domain.Account `json:"account"`
 }
+
+func (c *UpdateAccountCommand) Validate() error {
+	if c.ID == uuid.Nil {
+		return errIDRequired
+	}
+	return nil
+}
+
+func (c *UpdateAccountCommand) Transactional() bool {
+	return true
+}
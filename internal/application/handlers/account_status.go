@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// changeAccountStatus locks the account, applies the status transition and
+// saves it within a single unit of work.
+func changeAccountStatus(
+	ctx context.Context,
+	txManager repository.TxManager,
+	id uuid.UUID,
+	transition func(ctx context.Context, uow repository.UnitOfWork, account *domain.Account) error,
+) (*domain.Account, error) {
+	var account *domain.Account
+
+	err := txManager.WithinTransaction(ctx, func(ctx context.Context, uow repository.UnitOfWork) error {
+		var err error
+		account, err = uow.Accounts().GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := transition(ctx, uow, account); err != nil {
+			return err
+		}
+
+		return uow.Accounts().Update(ctx, account)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+)
+
+type ActivateAccountHandler struct {
+	txManager repository.TxManager
+}
+
+func NewActivateAccountHandler(txManager repository.TxManager) *ActivateAccountHandler {
+	return &ActivateAccountHandler{
+		txManager: txManager,
+	}
+}
+
+func (h *ActivateAccountHandler) Handle(
+	ctx context.Context,
+	command *commands.ActivateAccountCommand,
+) (*commands.ActivateAccountResponse, error) {
+	change := domain.StatusChange{Reason: command.Reason, ChangedBy: command.ChangedBy}
+
+	account, err := changeAccountStatus(ctx, h.txManager, command.ID, func(_ context.Context, _ repository.UnitOfWork, account *domain.Account) error {
+		return account.Activate(change)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &commands.ActivateAccountResponse{
+		Account: account,
+	}, nil
+}
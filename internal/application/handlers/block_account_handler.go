@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+)
+
+type BlockAccountHandler struct {
+	txManager repository.TxManager
+}
+
+func NewBlockAccountHandler(txManager repository.TxManager) *BlockAccountHandler {
+	return &BlockAccountHandler{
+		txManager: txManager,
+	}
+}
+
+func (h *BlockAccountHandler) Handle(
+	ctx context.Context,
+	command *commands.BlockAccountCommand,
+) (*commands.BlockAccountResponse, error) {
+	change := domain.StatusChange{Reason: command.Reason, ChangedBy: command.ChangedBy}
+
+	account, err := changeAccountStatus(ctx, h.txManager, command.ID, func(_ context.Context, _ repository.UnitOfWork, account *domain.Account) error {
+		return account.Block(change)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &commands.BlockAccountResponse{
+		Account: account,
+	}, nil
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/mocks"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBlockAccountHandler_Handle_ShouldBlockAccount(t *testing.T) {
+	// Arrange
+	mockAccRepo := mocks.NewMockAccountRepository(t)
+	handler := NewBlockAccountHandler(newMockTxManager(t, mockAccRepo))
+
+	account := newTestAccount(t, "12345", "John Doe", domain.NewMoney(1000, domain.USD))
+
+	mockAccRepo.EXPECT().GetByIDForUpdate(mock.Anything, account.ID).Return(account, nil)
+	mockAccRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(a *domain.Account) bool {
+		return a.Status == domain.AccountStatusBlocked && a.StatusReason == domain.StatusReasonFraudSuspected
+	})).Return(nil)
+
+	command := &commands.BlockAccountCommand{
+		ID:        account.ID,
+		Reason:    domain.StatusReasonFraudSuspected,
+		ChangedBy: "risk-team",
+	}
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, command)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if response.Account.Status != domain.AccountStatusBlocked {
+		t.Errorf("Expected status %s, got %s", domain.AccountStatusBlocked, response.Account.Status)
+	}
+}
+
+func TestBlockAccountHandler_Handle_ShouldRejectClosedAccount(t *testing.T) {
+	// Arrange
+	mockAccRepo := mocks.NewMockAccountRepository(t)
+	handler := NewBlockAccountHandler(newMockTxManager(t, mockAccRepo))
+
+	account := newTestAccount(t, "12345", "John Doe", domain.NewMoney(0, domain.USD))
+	account.Status = domain.AccountStatusClosed
+
+	mockAccRepo.EXPECT().GetByIDForUpdate(mock.Anything, account.ID).Return(account, nil)
+
+	command := &commands.BlockAccountCommand{
+		ID:        account.ID,
+		Reason:    domain.StatusReasonFraudSuspected,
+		ChangedBy: "risk-team",
+	}
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, command)
+
+	// Assert
+	if !errors.Is(err, domain.ErrInvalidState) {
+		t.Errorf("Expected invalid state error, got %v", err)
+	}
+	if response != nil {
+		t.Error("Expected nil response on error, got response")
+	}
+	mockAccRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestBlockAccountHandler_Handle_ShouldReturnErrorWhenAccountNotFound(t *testing.T) {
+	// Arrange
+	mockAccRepo := mocks.NewMockAccountRepository(t)
+	handler := NewBlockAccountHandler(newMockTxManager(t, mockAccRepo))
+
+	id := uuid.New()
+	mockAccRepo.EXPECT().GetByIDForUpdate(mock.Anything, id).
+		Return(nil, domain.Errorf(domain.ErrorCodeNotFound, "account not found"))
+
+	command := &commands.BlockAccountCommand{
+		ID:        id,
+		Reason:    domain.StatusReasonFraudSuspected,
+		ChangedBy: "risk-team",
+	}
+
+	// Act
+	ctx := context.Background()
+	_, err := handler.Handle(ctx, command)
+
+	// Assert
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+}
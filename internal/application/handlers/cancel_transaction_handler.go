@@ -5,16 +5,15 @@ import (
 	"arise_tech_assessment/internal/domain"
 	"arise_tech_assessment/internal/infrastructure/repository"
 	"context"
-	"errors"
 )
 
 type CancelTransactionHandler struct {
-	transactionRepo repository.TransactionRepository
+	txManager repository.TxManager
 }
 
-func NewCancelTransactionHandler(transactionRepo repository.TransactionRepository) *CancelTransactionHandler {
+func NewCancelTransactionHandler(txManager repository.TxManager) *CancelTransactionHandler {
 	return &CancelTransactionHandler{
-		transactionRepo: transactionRepo,
+		txManager: txManager,
 	}
 }
 
@@ -22,19 +21,26 @@ func (h *CancelTransactionHandler) Handle(
 	ctx context.Context,
 	command *commands.CancelTransactionCommand,
 ) (*commands.CancelTransactionResponse, error) {
-	transaction, err := h.transactionRepo.GetByID(ctx, command.ID)
-	if err != nil {
-		return nil, err
-	}
-
-	// Check if transaction can be cancelled (only pending transactions)
-	if transaction.Status != domain.TransactionStatusPending {
-		return nil, errors.New("only pending transactions can be cancelled")
-	}
-
-	transaction.Cancel()
-
-	err = h.transactionRepo.Update(ctx, transaction)
+	var transaction *domain.Transaction
+
+	err := h.txManager.WithinTransaction(ctx, func(ctx context.Context, uow repository.UnitOfWork) error {
+		// Lock the row so that a concurrent process cannot complete the
+		// transaction between the status check and the update.
+		var err error
+		transaction, err = uow.Transactions().GetByIDForUpdate(ctx, command.ID)
+		if err != nil {
+			return err
+		}
+
+		// Check if transaction can be cancelled (only pending transactions)
+		if transaction.Status != domain.TransactionStatusPending {
+			return domain.NewError(domain.ErrorCodeInvalidState, "only pending transactions can be cancelled")
+		}
+
+		transaction.Cancel()
+
+		return uow.Transactions().Update(ctx, transaction)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -15,7 +15,7 @@ import (
 func TestCancelTransactionHandler_Handle_ShouldSuccessfullyCancelPendingTransaction(t *testing.T) {
 	// Arrange
 	mockTxRepo := mocks.NewMockTransactionRepository(t)
-	handler := NewCancelTransactionHandler(mockTxRepo)
+	handler := NewCancelTransactionHandler(newMockTxManager(t, mockTxRepo))
 
 	txID := uuid.New()
 	transaction := domain.NewTransaction(domain.TransactionTypeDeposit, domain.NewMoney(5000, domain.USD), "Test transaction")
@@ -26,7 +26,7 @@ func TestCancelTransactionHandler_Handle_ShouldSuccessfullyCancelPendingTransact
 	}
 	ctx := context.Background()
 
-	mockTxRepo.EXPECT().GetByID(mock.Anything, txID).Return(transaction, nil)
+	mockTxRepo.EXPECT().GetByIDForUpdate(mock.Anything, txID).Return(transaction, nil)
 	mockTxRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(tx *domain.Transaction) bool {
 		return tx.ID == txID && tx.Status == domain.TransactionStatusCancelled
 	})).Return(nil)
@@ -65,7 +65,7 @@ func TestCancelTransactionHandler_Handle_ShouldSuccessfullyCancelPendingTransact
 func TestCancelTransactionHandler_Handle_ShouldReturnErrorWhenTransactionNotFound(t *testing.T) {
 	// Arrange
 	mockTxRepo := mocks.NewMockTransactionRepository(t)
-	handler := NewCancelTransactionHandler(mockTxRepo)
+	handler := NewCancelTransactionHandler(newMockTxManager(t, mockTxRepo))
 
 	nonExistentID := uuid.New()
 	command := &commands.CancelTransactionCommand{
@@ -73,7 +73,7 @@ func TestCancelTransactionHandler_Handle_ShouldReturnErrorWhenTransactionNotFoun
 	}
 	ctx := context.Background()
 
-	mockTxRepo.EXPECT().GetByID(mock.Anything, nonExistentID).Return(nil, errors.New("transaction not found"))
+	mockTxRepo.EXPECT().GetByIDForUpdate(mock.Anything, nonExistentID).Return(nil, errors.New("transaction not found"))
 
 	// Act
 	response, err := handler.Handle(ctx, command)
@@ -94,7 +94,7 @@ func TestCancelTransactionHandler_Handle_ShouldReturnErrorWhenTransactionNotFoun
 
 func TestCancelTransactionHandler_Handle_ShouldReturnErrorWhenTransactionIsNotPending(t *testing.T) {
 	mockTxRepo := mocks.NewMockTransactionRepository(t)
-	handler := NewCancelTransactionHandler(mockTxRepo)
+	handler := NewCancelTransactionHandler(newMockTxManager(t, mockTxRepo))
 
 	tests := []struct {
 		name   string
@@ -127,7 +127,7 @@ func TestCancelTransactionHandler_Handle_ShouldReturnErrorWhenTransactionIsNotPe
 			}
 			ctx := context.Background()
 
-			mockTxRepo.EXPECT().GetByID(mock.Anything, txID).Return(transaction, nil)
+			mockTxRepo.EXPECT().GetByIDForUpdate(mock.Anything, txID).Return(transaction, nil)
 
 			// Act
 			response, err := handler.Handle(ctx, command)
@@ -151,7 +151,7 @@ func TestCancelTransactionHandler_Handle_ShouldReturnErrorWhenTransactionIsNotPe
 func TestCancelTransactionHandler_Handle_ShouldReturnErrorWhenUpdateFails(t *testing.T) {
 	// Arrange
 	mockTxRepo := mocks.NewMockTransactionRepository(t)
-	handler := NewCancelTransactionHandler(mockTxRepo)
+	handler := NewCancelTransactionHandler(newMockTxManager(t, mockTxRepo))
 
 	txID := uuid.New()
 	transaction := domain.NewTransaction(domain.TransactionTypeTransfer, domain.NewMoney(2000, domain.USD), "Test transaction")
@@ -162,7 +162,7 @@ func TestCancelTransactionHandler_Handle_ShouldReturnErrorWhenUpdateFails(t *tes
 	}
 	ctx := context.Background()
 
-	mockTxRepo.EXPECT().GetByID(mock.Anything, txID).Return(transaction, nil)
+	mockTxRepo.EXPECT().GetByIDForUpdate(mock.Anything, txID).Return(transaction, nil)
 	mockTxRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(tx *domain.Transaction) bool {
 		return tx.ID == txID && tx.Status == domain.TransactionStatusCancelled
 	})).Return(errors.New("failed to update transaction"))
@@ -187,7 +187,7 @@ func TestCancelTransactionHandler_Handle_ShouldReturnErrorWhenUpdateFails(t *tes
 func TestCancelTransactionHandler_Handle_ShouldPreserveTransactionDataUponCancellation(t *testing.T) {
 	// Arrange
 	mockTxRepo := mocks.NewMockTransactionRepository(t)
-	handler := NewCancelTransactionHandler(mockTxRepo)
+	handler := NewCancelTransactionHandler(newMockTxManager(t, mockTxRepo))
 
 	txID := uuid.New()
 	fromAccountID := uuid.New()
@@ -195,7 +195,7 @@ func TestCancelTransactionHandler_Handle_ShouldPreserveTransactionDataUponCancel
 	originalAmount := domain.NewMoney(7500, domain.THB)
 	originalDescription := "Important transfer transaction"
 
-	transaction := domain.NewTransferTransaction(fromAccountID, toAccountID, originalAmount, originalDescription)
+	transaction := newTestTransfer(t, fromAccountID, toAccountID, originalAmount, originalDescription)
 	transaction.ID = txID
 	transaction.SetReference("REF123456")
 
@@ -204,7 +204,7 @@ func TestCancelTransactionHandler_Handle_ShouldPreserveTransactionDataUponCancel
 	}
 	ctx := context.Background()
 
-	mockTxRepo.EXPECT().GetByID(mock.Anything, txID).Return(transaction, nil)
+	mockTxRepo.EXPECT().GetByIDForUpdate(mock.Anything, txID).Return(transaction, nil)
 	mockTxRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(tx *domain.Transaction) bool {
 		return tx.ID == txID && tx.Status == domain.TransactionStatusCancelled
 	})).Return(nil)
@@ -240,8 +240,8 @@ func TestCancelTransactionHandler_Handle_ShouldPreserveTransactionDataUponCancel
 		t.Errorf("Expected description %s to be preserved, got %s", originalDescription, cancelledTx.Description)
 	}
 
-	if cancelledTx.Reference != "REF123456" {
-		t.Errorf("Expected reference to be preserved, got %s", cancelledTx.Reference)
+	if cancelledTx.Reference == nil || *cancelledTx.Reference != "REF123456" {
+		t.Errorf("Expected reference to be preserved, got %v", cancelledTx.Reference)
 	}
 
 	if cancelledTx.FromAccountID == nil {
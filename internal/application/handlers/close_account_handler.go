@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+)
+
+type CloseAccountHandler struct {
+	txManager repository.TxManager
+}
+
+func NewCloseAccountHandler(txManager repository.TxManager) *CloseAccountHandler {
+	return &CloseAccountHandler{
+		txManager: txManager,
+	}
+}
+
+func (h *CloseAccountHandler) Handle(
+	ctx context.Context,
+	command *commands.CloseAccountCommand,
+) (*commands.CloseAccountResponse, error) {
+	change := domain.StatusChange{Reason: command.Reason, ChangedBy: command.ChangedBy}
+
+	account, err := changeAccountStatus(ctx, h.txManager, command.ID, func(ctx context.Context, uow repository.UnitOfWork, account *domain.Account) error {
+		// Pending transactions would move funds after closing, so they must be settled first
+		pending, err := uow.Transactions().CountPendingByAccountID(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+		if pending > 0 {
+			return domain.Errorf(domain.ErrorCodeInvalidState, "account has %d pending transaction(s) and cannot be closed", pending)
+		}
+
+		return account.Close(change)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &commands.CloseAccountResponse{
+		Account: account,
+	}, nil
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/mocks"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCloseAccountHandler_Handle_ShouldCloseAccount(t *testing.T) {
+	// Arrange
+	mockAccRepo := mocks.NewMockAccountRepository(t)
+	mockTxRepo := mocks.NewMockTransactionRepository(t)
+	handler := NewCloseAccountHandler(newMockTxManager(t, mockAccRepo, mockTxRepo))
+
+	account := newTestAccount(t, "12345", "John Doe", domain.NewMoney(0, domain.USD))
+
+	mockAccRepo.EXPECT().GetByIDForUpdate(mock.Anything, account.ID).Return(account, nil)
+	mockTxRepo.EXPECT().CountPendingByAccountID(mock.Anything, account.ID).Return(0, nil)
+	mockAccRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(a *domain.Account) bool {
+		return a.Status == domain.AccountStatusClosed
+	})).Return(nil)
+
+	command := &commands.CloseAccountCommand{
+		ID:        account.ID,
+		Reason:    domain.StatusReasonCustomerRequest,
+		ChangedBy: "ops@example.com",
+	}
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, command)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if response.Account.Status != domain.AccountStatusClosed {
+		t.Errorf("Expected status %s, got %s", domain.AccountStatusClosed, response.Account.Status)
+	}
+	if response.Account.StatusChangedBy != "ops@example.com" {
+		t.Errorf("Expected changed by ops@example.com, got %s", response.Account.StatusChangedBy)
+	}
+}
+
+func TestCloseAccountHandler_Handle_ShouldRejectAccountWithPendingTransactions(t *testing.T) {
+	// Arrange
+	mockAccRepo := mocks.NewMockAccountRepository(t)
+	mockTxRepo := mocks.NewMockTransactionRepository(t)
+	handler := NewCloseAccountHandler(newMockTxManager(t, mockAccRepo, mockTxRepo))
+
+	account := newTestAccount(t, "12345", "John Doe", domain.NewMoney(0, domain.USD))
+
+	mockAccRepo.EXPECT().GetByIDForUpdate(mock.Anything, account.ID).Return(account, nil)
+	mockTxRepo.EXPECT().CountPendingByAccountID(mock.Anything, account.ID).Return(2, nil)
+
+	command := &commands.CloseAccountCommand{
+		ID:        account.ID,
+		Reason:    domain.StatusReasonCustomerRequest,
+		ChangedBy: "ops@example.com",
+	}
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, command)
+
+	// Assert
+	if !errors.Is(err, domain.ErrInvalidState) {
+		t.Errorf("Expected invalid state error, got %v", err)
+	}
+	if response != nil {
+		t.Error("Expected nil response on error, got response")
+	}
+	mockAccRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestCloseAccountHandler_Handle_ShouldRejectAccountWithBalance(t *testing.T) {
+	// Arrange
+	mockAccRepo := mocks.NewMockAccountRepository(t)
+	mockTxRepo := mocks.NewMockTransactionRepository(t)
+	handler := NewCloseAccountHandler(newMockTxManager(t, mockAccRepo, mockTxRepo))
+
+	account := newTestAccount(t, "12345", "John Doe", domain.NewMoney(500, domain.USD))
+
+	mockAccRepo.EXPECT().GetByIDForUpdate(mock.Anything, account.ID).Return(account, nil)
+	mockTxRepo.EXPECT().CountPendingByAccountID(mock.Anything, account.ID).Return(0, nil)
+
+	command := &commands.CloseAccountCommand{
+		ID:        account.ID,
+		Reason:    domain.StatusReasonCustomerRequest,
+		ChangedBy: "ops@example.com",
+	}
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, command)
+
+	// Assert
+	if !errors.Is(err, domain.ErrInvalidState) {
+		t.Errorf("Expected invalid state error, got %v", err)
+	}
+	if response != nil {
+		t.Error("Expected nil response on error, got response")
+	}
+}
@@ -8,19 +8,47 @@ import (
 )
 
 type CreateAccountHandler struct {
-	accountRepository repository.AccountRepository
+	txManager repository.TxManager
 }
 
-func NewCreateAccountHandler(accountRepository repository.AccountRepository) *CreateAccountHandler {
+func NewCreateAccountHandler(txManager repository.TxManager) *CreateAccountHandler {
 	return &CreateAccountHandler{
-		accountRepository: accountRepository,
+		txManager: txManager,
 	}
 }
 
 func (h *CreateAccountHandler) Handle(ctx context.Context, command *commands.CreateAccountCommand) (*commands.CreateAccountResponse, error) {
-	account := domain.NewAccount(command.Number, command.HolderName, command.InitialBalance)
+	account, err := domain.NewAccount(command.Number, command.HolderName, command.InitialBalance)
+	if err != nil {
+		return nil, err
+	}
+	account.SetOwner(command.OwnerID)
+
+	err = h.txManager.WithinTransaction(ctx, func(ctx context.Context, uow repository.UnitOfWork) error {
+		if err := uow.Accounts().Create(ctx, account); err != nil {
+			return err
+		}
+
+		if !account.Balance.IsPositive() {
+			return nil
+		}
+
+		// Post the initial balance as a completed deposit so the ledger can rebuild it
+		opening := domain.NewDepositTransaction(account.ID, account.Balance, "Opening balance")
+		opening.SetReference("OPEN-" + opening.ID.String())
+		opening.Complete()
+		if err := uow.Transactions().Create(ctx, opening); err != nil {
+			return err
+		}
+
+		entries, err := domain.NewLedgerEntries(opening, nil, account)
+		if err != nil {
+			return err
+		}
 
-	if err := h.accountRepository.Create(ctx, account); err != nil {
+		return uow.Ledger().CreateEntries(ctx, entries)
+	})
+	if err != nil {
 		return nil, err
 	}
 
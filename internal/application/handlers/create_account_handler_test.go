@@ -15,7 +15,9 @@ import (
 func TestCreateAccountHandler_Handle_ShouldSuccessfullyCreateAccount(t *testing.T) {
 	// Arrange
 	mockRepo := mocks.NewMockAccountRepository(t)
-	handler := NewCreateAccountHandler(mockRepo)
+	mockTxRepo := mocks.NewMockTransactionRepository(t)
+	mockLedgerRepo := mocks.NewMockLedgerRepository(t)
+	handler := NewCreateAccountHandler(newMockTxManager(t, mockRepo, mockTxRepo, mockLedgerRepo))
 
 	command := &commands.CreateAccountCommand{
 		Number:         "12345678",
@@ -24,6 +26,8 @@ func TestCreateAccountHandler_Handle_ShouldSuccessfullyCreateAccount(t *testing.
 	}
 
 	mockRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*domain.Account")).Return(nil)
+	mockTxRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*domain.Transaction")).Return(nil)
+	mockLedgerRepo.EXPECT().CreateEntries(mock.Anything, mock.AnythingOfType("[]domain.LedgerEntry")).Return(nil)
 
 	// Act
 	ctx := context.Background()
@@ -55,10 +59,62 @@ func TestCreateAccountHandler_Handle_ShouldSuccessfullyCreateAccount(t *testing.
 	}
 }
 
+func TestCreateAccountHandler_Handle_ShouldRejectUnsupportedCurrency(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockAccountRepository(t)
+	handler := NewCreateAccountHandler(newMockTxManager(t, mockRepo))
+
+	command := &commands.CreateAccountCommand{
+		Number:         "12345678",
+		HolderName:     "John Doe",
+		InitialBalance: domain.NewMoney(10000, "ABC"),
+	}
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, command)
+
+	// Assert
+	if !errors.Is(err, domain.ErrValidation) {
+		t.Errorf("Expected validation error, got %v", err)
+	}
+	if response != nil {
+		t.Error("Expected nil response on error, got response")
+	}
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestCreateAccountHandler_Handle_ShouldRejectNegativeInitialBalance(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockAccountRepository(t)
+	handler := NewCreateAccountHandler(newMockTxManager(t, mockRepo))
+
+	command := &commands.CreateAccountCommand{
+		Number:         "12345678",
+		HolderName:     "John Doe",
+		InitialBalance: domain.NewMoney(-10000, domain.USD),
+	}
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, command)
+
+	// Assert
+	if !errors.Is(err, domain.ErrValidation) {
+		t.Errorf("Expected validation error, got %v", err)
+	}
+	if response != nil {
+		t.Error("Expected nil response on error, got response")
+	}
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
 func TestCreateAccountHandler_Handle_ShouldReturnErrorWhenRepositoryFails(t *testing.T) {
 	// Arrange
 	mockRepo := mocks.NewMockAccountRepository(t)
-	handler := NewCreateAccountHandler(mockRepo)
+	mockTxRepo := mocks.NewMockTransactionRepository(t)
+	mockLedgerRepo := mocks.NewMockLedgerRepository(t)
+	handler := NewCreateAccountHandler(newMockTxManager(t, mockRepo, mockTxRepo, mockLedgerRepo))
 
 	command := &commands.CreateAccountCommand{
 		Number:         "12345678",
@@ -87,7 +143,9 @@ func TestCreateAccountHandler_Handle_ShouldReturnErrorWhenRepositoryFails(t *tes
 func TestCreateAccountHandler_Handle_ShouldApplyDomainValidationsAndSetDefaults(t *testing.T) {
 	// Arrange
 	mockRepo := mocks.NewMockAccountRepository(t)
-	handler := NewCreateAccountHandler(mockRepo)
+	mockTxRepo := mocks.NewMockTransactionRepository(t)
+	mockLedgerRepo := mocks.NewMockLedgerRepository(t)
+	handler := NewCreateAccountHandler(newMockTxManager(t, mockRepo, mockTxRepo, mockLedgerRepo))
 
 	command := &commands.CreateAccountCommand{
 		Number:         "12345678",
@@ -96,6 +154,8 @@ func TestCreateAccountHandler_Handle_ShouldApplyDomainValidationsAndSetDefaults(
 	}
 
 	mockRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*domain.Account")).Return(nil)
+	mockTxRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*domain.Transaction")).Return(nil)
+	mockLedgerRepo.EXPECT().CreateEntries(mock.Anything, mock.AnythingOfType("[]domain.LedgerEntry")).Return(nil)
 
 	// Act
 	ctx := context.Background()
@@ -123,3 +183,109 @@ func TestCreateAccountHandler_Handle_ShouldApplyDomainValidationsAndSetDefaults(
 		t.Error("Expected UpdatedAt to be set")
 	}
 }
+
+func TestCreateAccountHandler_Handle_ShouldPostOpeningBalanceToLedger(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockAccountRepository(t)
+	mockTxRepo := mocks.NewMockTransactionRepository(t)
+	mockLedgerRepo := mocks.NewMockLedgerRepository(t)
+	handler := NewCreateAccountHandler(newMockTxManager(t, mockRepo, mockTxRepo, mockLedgerRepo))
+
+	command := &commands.CreateAccountCommand{
+		Number:         "12345678",
+		HolderName:     "John Doe",
+		InitialBalance: domain.NewMoney(10000, domain.USD),
+	}
+
+	var opening *domain.Transaction
+	mockRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*domain.Account")).Return(nil)
+	mockTxRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(tx *domain.Transaction) bool {
+		opening = tx
+		return tx.Type == domain.TransactionTypeDeposit && tx.Status == domain.TransactionStatusCompleted
+	})).Return(nil)
+	mockLedgerRepo.EXPECT().CreateEntries(mock.Anything, mock.MatchedBy(func(entries []domain.LedgerEntry) bool {
+		return len(entries) == 2 && entries[0].TransactionID == opening.ID && domain.ValidateLedgerEntries(entries) == nil
+	})).Return(nil)
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, command)
+
+	// Assert
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if response == nil {
+		t.Fatal("Expected response, got nil")
+	}
+	if opening.ToAccountID == nil || *opening.ToAccountID != response.Account.ID {
+		t.Error("Expected opening deposit to target the new account")
+	}
+}
+
+func TestCreateAccountHandler_Handle_ShouldGiveOpeningDepositsUniqueReferences(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockAccountRepository(t)
+	mockTxRepo := mocks.NewMockTransactionRepository(t)
+	mockLedgerRepo := mocks.NewMockLedgerRepository(t)
+	handler := NewCreateAccountHandler(newMockTxManager(t, mockRepo, mockTxRepo, mockLedgerRepo))
+
+	references := map[string]bool{}
+	mockRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*domain.Account")).Return(nil)
+	mockTxRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*domain.Transaction")).
+		RunAndReturn(func(ctx context.Context, tx *domain.Transaction) error {
+			if tx.Reference == nil || references[*tx.Reference] {
+				return domain.NewError(domain.ErrorCodeConflict, "transaction reference already exists")
+			}
+			references[*tx.Reference] = true
+			return nil
+		})
+	mockLedgerRepo.EXPECT().CreateEntries(mock.Anything, mock.AnythingOfType("[]domain.LedgerEntry")).Return(nil)
+
+	// Act
+	ctx := context.Background()
+	_, firstErr := handler.Handle(ctx, &commands.CreateAccountCommand{
+		Number:         "12345678",
+		HolderName:     "John Doe",
+		InitialBalance: domain.NewMoney(10000, domain.USD),
+	})
+	_, secondErr := handler.Handle(ctx, &commands.CreateAccountCommand{
+		Number:         "87654321",
+		HolderName:     "Jane Doe",
+		InitialBalance: domain.NewMoney(5000, domain.USD),
+	})
+
+	// Assert
+	if firstErr != nil || secondErr != nil {
+		t.Errorf("Expected both funded accounts to be created, got %v and %v", firstErr, secondErr)
+	}
+	if len(references) != 2 {
+		t.Errorf("Expected two distinct opening references, got %v", references)
+	}
+}
+
+func TestCreateAccountHandler_Handle_ShouldNotPostLedgerEntriesForZeroBalance(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockAccountRepository(t)
+	handler := NewCreateAccountHandler(newMockTxManager(t, mockRepo))
+
+	command := &commands.CreateAccountCommand{
+		Number:         "12345678",
+		HolderName:     "John Doe",
+		InitialBalance: domain.NewMoney(0, domain.USD),
+	}
+
+	mockRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*domain.Account")).Return(nil)
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, command)
+
+	// Assert
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if response == nil {
+		t.Fatal("Expected response, got nil")
+	}
+}
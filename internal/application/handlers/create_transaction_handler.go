@@ -5,48 +5,57 @@ import (
 	"arise_tech_assessment/internal/domain"
 	"arise_tech_assessment/internal/infrastructure/repository"
 	"context"
-	"errors"
 )
 
 type CreateTransactionHandler struct {
-	transactionRepository repository.TransactionRepository
-	accountRepository     repository.AccountRepository
+	txManager repository.TxManager
 }
 
-func NewCreateTransactionHandler(transactionRepository repository.TransactionRepository, accountRepository repository.AccountRepository) *CreateTransactionHandler {
+func NewCreateTransactionHandler(txManager repository.TxManager) *CreateTransactionHandler {
 	return &CreateTransactionHandler{
-		transactionRepository: transactionRepository,
-		accountRepository:     accountRepository,
+		txManager: txManager,
 	}
 }
 
 func (h *CreateTransactionHandler) Handle(ctx context.Context, command *commands.CreateTransactionCommand) (*commands.CreateTransactionResponse, error) {
 	var transaction *domain.Transaction
+	var err error
 
 	switch command.Type {
 	case domain.TransactionTypeDeposit:
 		if command.ToAccountID == nil {
-			return nil, errors.New("to_account_id is required for deposit")
+			return nil, domain.NewError(domain.ErrorCodeValidation, "to_account_id is required for deposit")
 		}
 		transaction = domain.NewDepositTransaction(*command.ToAccountID, command.Amount, command.Description)
 
 	case domain.TransactionTypeWithdraw:
 		if command.FromAccountID == nil {
-			return nil, errors.New("from_account_id is required for withdrawal")
+			return nil, domain.NewError(domain.ErrorCodeValidation, "from_account_id is required for withdrawal")
 		}
 		transaction = domain.NewWithdrawTransaction(*command.FromAccountID, command.Amount, command.Description)
 
 	case domain.TransactionTypeTransfer:
 		if command.FromAccountID == nil || command.ToAccountID == nil {
-			return nil, errors.New("both from_account_id and to_account_id are required for transfer")
+			return nil, domain.NewError(domain.ErrorCodeValidation, "both from_account_id and to_account_id are required for transfer")
+		}
+		transaction, err = domain.NewTransferTransaction(*command.FromAccountID, *command.ToAccountID, command.Amount, command.Description)
+		if err != nil {
+			return nil, err
 		}
-		transaction = domain.NewTransferTransaction(*command.FromAccountID, *command.ToAccountID, command.Amount, command.Description)
 
 	default:
-		return nil, errors.New("invalid transaction type")
+		return nil, domain.NewError(domain.ErrorCodeValidation, "invalid transaction type")
+	}
+
+	// Reject rows the database constraints would refuse before opening a transaction
+	if err := transaction.Validate(); err != nil {
+		return nil, err
 	}
 
-	if err := h.transactionRepository.Create(ctx, transaction); err != nil {
+	err = h.txManager.WithinTransaction(ctx, func(ctx context.Context, uow repository.UnitOfWork) error {
+		return uow.Transactions().Create(ctx, transaction)
+	})
+	if err != nil {
 		return nil, err
 	}
 
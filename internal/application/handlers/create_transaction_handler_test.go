@@ -16,7 +16,7 @@ func TestCreateTransactionHandler_Handle_ShouldSuccessfullyCreateDepositTransact
 	// Arrange
 	mockTxRepo := mocks.NewMockTransactionRepository(t)
 	mockAccRepo := mocks.NewMockAccountRepository(t)
-	handler := NewCreateTransactionHandler(mockTxRepo, mockAccRepo)
+	handler := NewCreateTransactionHandler(newMockTxManager(t, mockTxRepo, mockAccRepo))
 
 	toAccountID := uuid.New()
 	command := &commands.CreateTransactionCommand{
@@ -73,7 +73,7 @@ func TestCreateTransactionHandler_Handle_ShouldSuccessfullyCreateWithdrawTransac
 	// Arrange
 	mockTxRepo := mocks.NewMockTransactionRepository(t)
 	mockAccRepo := mocks.NewMockAccountRepository(t)
-	handler := NewCreateTransactionHandler(mockTxRepo, mockAccRepo)
+	handler := NewCreateTransactionHandler(newMockTxManager(t, mockTxRepo, mockAccRepo))
 
 	fromAccountID := uuid.New()
 	command := &commands.CreateTransactionCommand{
@@ -117,7 +117,7 @@ func TestCreateTransactionHandler_Handle_ShouldSuccessfullyCreateWithdrawTransac
 func TestCreateTransactionHandler_Handle_ShouldSuccessfullyCreateTransferTransaction(t *testing.T) {
 	mockTxRepo := mocks.NewMockTransactionRepository(t)
 	mockAccRepo := mocks.NewMockAccountRepository(t)
-	handler := NewCreateTransactionHandler(mockTxRepo, mockAccRepo)
+	handler := NewCreateTransactionHandler(newMockTxManager(t, mockTxRepo, mockAccRepo))
 
 	fromAccountID := uuid.New()
 	toAccountID := uuid.New()
@@ -160,10 +160,111 @@ func TestCreateTransactionHandler_Handle_ShouldSuccessfullyCreateTransferTransac
 	}
 }
 
+func TestCreateTransactionHandler_Handle_ShouldReturnErrorForUnsupportedCurrency(t *testing.T) {
+	mockTxRepo := mocks.NewMockTransactionRepository(t)
+	handler := NewCreateTransactionHandler(newMockTxManager(t, mockTxRepo))
+
+	toAccountID := uuid.New()
+	command := &commands.CreateTransactionCommand{
+		Type:        domain.TransactionTypeDeposit,
+		Amount:      domain.NewMoney(5000, "XYZ"),
+		ToAccountID: &toAccountID,
+		Description: "Test deposit",
+	}
+
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, command)
+
+	if !errors.Is(err, domain.ErrValidation) {
+		t.Errorf("Expected validation error, got %v", err)
+	}
+
+	if response != nil {
+		t.Error("Expected nil response on error, got response")
+	}
+
+	if err.Error() != `unsupported currency "XYZ"` {
+		t.Errorf("Expected specific error message, got %s", err.Error())
+	}
+}
+
+func TestCreateTransactionHandler_Handle_ShouldRejectInvalidTransactionsWithoutPersisting(t *testing.T) {
+	accountID := uuid.New()
+	otherAccountID := uuid.New()
+
+	tests := []struct {
+		name    string
+		command *commands.CreateTransactionCommand
+		message string
+	}{
+		{
+			name: "transfer to the same account",
+			command: &commands.CreateTransactionCommand{
+				Type:          domain.TransactionTypeTransfer,
+				Amount:        domain.NewMoney(5000, domain.USD),
+				FromAccountID: &accountID,
+				ToAccountID:   &accountID,
+			},
+			message: "cannot transfer to the same account",
+		},
+		{
+			name: "transfer with zero amount",
+			command: &commands.CreateTransactionCommand{
+				Type:          domain.TransactionTypeTransfer,
+				Amount:        domain.NewMoney(0, domain.USD),
+				FromAccountID: &accountID,
+				ToAccountID:   &otherAccountID,
+			},
+			message: "amount must be positive",
+		},
+		{
+			name: "deposit with negative amount",
+			command: &commands.CreateTransactionCommand{
+				Type:        domain.TransactionTypeDeposit,
+				Amount:      domain.NewMoney(-5000, domain.USD),
+				ToAccountID: &accountID,
+			},
+			message: "amount must be positive",
+		},
+		{
+			name: "withdrawal with zero amount",
+			command: &commands.CreateTransactionCommand{
+				Type:          domain.TransactionTypeWithdraw,
+				Amount:        domain.NewMoney(0, domain.USD),
+				FromAccountID: &accountID,
+			},
+			message: "amount must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockTxRepo := mocks.NewMockTransactionRepository(t)
+			handler := NewCreateTransactionHandler(newMockTxManager(t, mockTxRepo))
+
+			// Act
+			response, err := handler.Handle(context.Background(), tt.command)
+
+			// Assert
+			if !errors.Is(err, domain.ErrValidation) {
+				t.Fatalf("Expected validation error, got %v", err)
+			}
+			if err.Error() != tt.message {
+				t.Errorf("Expected error %q, got %q", tt.message, err.Error())
+			}
+			if response != nil {
+				t.Error("Expected nil response on error, got response")
+			}
+			mockTxRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
 func TestCreateTransactionHandler_Handle_ShouldReturnErrorWhenDepositMissingToAccount(t *testing.T) {
 	mockTxRepo := mocks.NewMockTransactionRepository(t)
 	mockAccRepo := mocks.NewMockAccountRepository(t)
-	handler := NewCreateTransactionHandler(mockTxRepo, mockAccRepo)
+	handler := NewCreateTransactionHandler(newMockTxManager(t, mockTxRepo, mockAccRepo))
 
 	command := &commands.CreateTransactionCommand{
 		Type:        domain.TransactionTypeDeposit,
@@ -191,7 +292,7 @@ func TestCreateTransactionHandler_Handle_ShouldReturnErrorWhenDepositMissingToAc
 func TestCreateTransactionHandler_Handle_ShouldReturnErrorWhenWithdrawMissingFromAccount(t *testing.T) {
 	mockTxRepo := mocks.NewMockTransactionRepository(t)
 	mockAccRepo := mocks.NewMockAccountRepository(t)
-	handler := NewCreateTransactionHandler(mockTxRepo, mockAccRepo)
+	handler := NewCreateTransactionHandler(newMockTxManager(t, mockTxRepo, mockAccRepo))
 
 	command := &commands.CreateTransactionCommand{
 		Type:          domain.TransactionTypeWithdraw,
@@ -219,7 +320,7 @@ func TestCreateTransactionHandler_Handle_ShouldReturnErrorWhenWithdrawMissingFro
 func TestCreateTransactionHandler_Handle_ShouldReturnErrorWhenTransferMissingAccounts(t *testing.T) {
 	mockTxRepo := mocks.NewMockTransactionRepository(t)
 	mockAccRepo := mocks.NewMockAccountRepository(t)
-	handler := NewCreateTransactionHandler(mockTxRepo, mockAccRepo)
+	handler := NewCreateTransactionHandler(newMockTxManager(t, mockTxRepo, mockAccRepo))
 
 	tests := []struct {
 		name          string
@@ -265,7 +366,7 @@ func TestCreateTransactionHandler_Handle_ShouldReturnErrorWhenTransferMissingAcc
 func TestCreateTransactionHandler_Handle_ShouldReturnErrorForInvalidTransactionType(t *testing.T) {
 	mockTxRepo := mocks.NewMockTransactionRepository(t)
 	mockAccRepo := mocks.NewMockAccountRepository(t)
-	handler := NewCreateTransactionHandler(mockTxRepo, mockAccRepo)
+	handler := NewCreateTransactionHandler(newMockTxManager(t, mockTxRepo, mockAccRepo))
 
 	command := &commands.CreateTransactionCommand{
 		Type:        "invalid_type",
@@ -293,7 +394,7 @@ func TestCreateTransactionHandler_Handle_ShouldReturnErrorWhenRepositoryFails(t
 	mockTxRepo := mocks.NewMockTransactionRepository(t)
 	mockTxRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*domain.Transaction")).Return(errors.New("failed to create transaction"))
 	mockAccRepo := mocks.NewMockAccountRepository(t)
-	handler := NewCreateTransactionHandler(mockTxRepo, mockAccRepo)
+	handler := NewCreateTransactionHandler(newMockTxManager(t, mockTxRepo, mockAccRepo))
 
 	toAccountID := uuid.New()
 	command := &commands.CreateTransactionCommand{
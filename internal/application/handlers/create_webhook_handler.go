@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+)
+
+type CreateWebhookHandler struct {
+	webhookRepo repository.WebhookSubscriptionRepository
+}
+
+func NewCreateWebhookHandler(webhookRepo repository.WebhookSubscriptionRepository) *CreateWebhookHandler {
+	return &CreateWebhookHandler{
+		webhookRepo: webhookRepo,
+	}
+}
+
+func (h *CreateWebhookHandler) Handle(
+	ctx context.Context,
+	command *commands.CreateWebhookCommand,
+) (*commands.CreateWebhookResponse, error) {
+	webhook, err := domain.NewWebhookSubscription(command.URL, command.EventTypes, command.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.webhookRepo.Create(ctx, webhook); err != nil {
+		return nil, err
+	}
+
+	return &commands.CreateWebhookResponse{
+		Webhook: webhook,
+	}, nil
+}
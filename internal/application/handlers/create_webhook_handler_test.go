@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/mocks"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateWebhookHandler_Handle_ShouldCreateSubscription(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockWebhookSubscriptionRepository(t)
+	handler := NewCreateWebhookHandler(mockRepo)
+
+	mockRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*domain.WebhookSubscription")).Return(nil)
+
+	command := &commands.CreateWebhookCommand{
+		URL:        "https://example.com/hooks",
+		EventTypes: []string{"transaction.completed", "account.blocked", "transaction.completed"},
+		Secret:     "0123456789abcdef",
+	}
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, command)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	webhook := response.Webhook
+	if webhook.URL != command.URL || webhook.Secret != command.Secret {
+		t.Errorf("Expected the webhook to keep its URL and secret, got %+v", webhook)
+	}
+	if len(webhook.EventTypes) != 2 || !webhook.Subscribes("account.blocked") || !webhook.Subscribes("transaction.completed") {
+		t.Errorf("Expected the two distinct event types, got %v", webhook.EventTypes)
+	}
+}
+
+func TestCreateWebhookHandler_Handle_ShouldRejectUnknownEventType(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockWebhookSubscriptionRepository(t)
+	handler := NewCreateWebhookHandler(mockRepo)
+
+	command := &commands.CreateWebhookCommand{
+		URL:        "https://example.com/hooks",
+		EventTypes: []string{"account.renamed"},
+		Secret:     "0123456789abcdef",
+	}
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, command)
+
+	// Assert
+	if !errors.Is(err, domain.ErrValidation) {
+		t.Errorf("Expected validation error, got %v", err)
+	}
+	if response != nil {
+		t.Errorf("Expected nil response, got %v", response)
+	}
+}
+
+func TestCreateWebhookHandler_Handle_ShouldRejectRelativeURL(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockWebhookSubscriptionRepository(t)
+	handler := NewCreateWebhookHandler(mockRepo)
+
+	command := &commands.CreateWebhookCommand{
+		URL:        "/hooks",
+		EventTypes: []string{"account.blocked"},
+		Secret:     "0123456789abcdef",
+	}
+
+	// Act
+	ctx := context.Background()
+	_, err := handler.Handle(ctx, command)
+
+	// Assert
+	if !errors.Is(err, domain.ErrValidation) {
+		t.Errorf("Expected validation error, got %v", err)
+	}
+}
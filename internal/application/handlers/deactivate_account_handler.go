@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+)
+
+type DeactivateAccountHandler struct {
+	txManager repository.TxManager
+}
+
+func NewDeactivateAccountHandler(txManager repository.TxManager) *DeactivateAccountHandler {
+	return &DeactivateAccountHandler{
+		txManager: txManager,
+	}
+}
+
+func (h *DeactivateAccountHandler) Handle(
+	ctx context.Context,
+	command *commands.DeactivateAccountCommand,
+) (*commands.DeactivateAccountResponse, error) {
+	change := domain.StatusChange{Reason: command.Reason, ChangedBy: command.ChangedBy}
+
+	account, err := changeAccountStatus(ctx, h.txManager, command.ID, func(_ context.Context, _ repository.UnitOfWork, account *domain.Account) error {
+		return account.Deactivate(change)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &commands.DeactivateAccountResponse{
+		Account: account,
+	}, nil
+}
@@ -2,25 +2,47 @@ package handlers
 
 import (
 	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/domain"
 	"arise_tech_assessment/internal/infrastructure/repository"
 	"context"
 )
 
 type DeleteAccountHandler struct {
-	accountRepo repository.AccountRepository
+	txManager repository.TxManager
 }
 
-func NewDeleteAccountHandler(accountRepo repository.AccountRepository) *DeleteAccountHandler {
+func NewDeleteAccountHandler(txManager repository.TxManager) *DeleteAccountHandler {
 	return &DeleteAccountHandler{
-		accountRepo: accountRepo,
+		txManager: txManager,
 	}
 }
 
+// Handle soft-deletes the account. Its row is kept so that the transactions
+// and ledger entries referencing it stay intact, and it can be restored.
 func (h *DeleteAccountHandler) Handle(
 	ctx context.Context,
 	command *commands.DeleteAccountCommand,
 ) (*commands.DeleteAccountResponse, error) {
-	err := h.accountRepo.Delete(ctx, command.ID)
+	err := h.txManager.WithinTransaction(ctx, func(ctx context.Context, uow repository.UnitOfWork) error {
+		account, err := uow.Accounts().GetByIDForUpdate(ctx, command.ID)
+		if err != nil {
+			return err
+		}
+
+		if !account.Balance.IsZero() {
+			return domain.Errorf(domain.ErrorCodeInvalidState, "account with a balance of %s cannot be deleted", account.Balance)
+		}
+
+		pending, err := uow.Transactions().CountPendingByAccountID(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+		if pending > 0 {
+			return domain.Errorf(domain.ErrorCodeInvalidState, "account has %d pending transaction(s) and cannot be deleted", pending)
+		}
+
+		return uow.Accounts().Delete(ctx, account.ID)
+	})
 	if err != nil {
 		return &commands.DeleteAccountResponse{Success: false}, err
 	}
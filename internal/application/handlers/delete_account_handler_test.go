@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/domain"
 	"arise_tech_assessment/mocks"
 	"context"
 	"errors"
@@ -14,14 +15,18 @@ import (
 func TestDeleteAccountHandler_Handle_ShouldSuccessfullyDeleteAccount(t *testing.T) {
 	// Arrange
 	mockRepo := mocks.NewMockAccountRepository(t)
-	handler := NewDeleteAccountHandler(mockRepo)
+	mockTxRepo := mocks.NewMockTransactionRepository(t)
+	handler := NewDeleteAccountHandler(newMockTxManager(t, mockRepo, mockTxRepo))
 
-	accountID := uuid.New()
+	account := newTestAccount(t, "12345", "John Doe", domain.NewMoney(0, domain.USD))
+	accountID := account.ID
 	command := &commands.DeleteAccountCommand{
 		ID: accountID,
 	}
 	ctx := context.Background()
 
+	mockRepo.EXPECT().GetByIDForUpdate(mock.Anything, accountID).Return(account, nil)
+	mockTxRepo.EXPECT().CountPendingByAccountID(mock.Anything, accountID).Return(0, nil)
 	mockRepo.EXPECT().Delete(mock.Anything, accountID).Return(nil)
 
 	// Act
@@ -44,7 +49,7 @@ func TestDeleteAccountHandler_Handle_ShouldSuccessfullyDeleteAccount(t *testing.
 func TestDeleteAccountHandler_Handle_ShouldReturnErrorWhenAccountNotFound(t *testing.T) {
 	// Arrange
 	mockRepo := mocks.NewMockAccountRepository(t)
-	handler := NewDeleteAccountHandler(mockRepo)
+	handler := NewDeleteAccountHandler(newMockTxManager(t, mockRepo))
 
 	nonExistentID := uuid.New()
 	command := &commands.DeleteAccountCommand{
@@ -52,7 +57,7 @@ func TestDeleteAccountHandler_Handle_ShouldReturnErrorWhenAccountNotFound(t *tes
 	}
 	ctx := context.Background()
 
-	mockRepo.EXPECT().Delete(mock.Anything, nonExistentID).Return(errors.New("account not found"))
+	mockRepo.EXPECT().GetByIDForUpdate(mock.Anything, nonExistentID).Return(nil, errors.New("account not found"))
 
 	// Act
 	response, err := handler.Handle(ctx, command)
@@ -78,14 +83,18 @@ func TestDeleteAccountHandler_Handle_ShouldReturnErrorWhenAccountNotFound(t *tes
 func TestDeleteAccountHandler_Handle_ShouldReturnErrorWhenDatabaseFails(t *testing.T) {
 	// Arrange
 	mockRepo := mocks.NewMockAccountRepository(t)
-	handler := NewDeleteAccountHandler(mockRepo)
+	mockTxRepo := mocks.NewMockTransactionRepository(t)
+	handler := NewDeleteAccountHandler(newMockTxManager(t, mockRepo, mockTxRepo))
 
-	accountID := uuid.New()
+	account := newTestAccount(t, "12345", "John Doe", domain.NewMoney(0, domain.USD))
+	accountID := account.ID
 	command := &commands.DeleteAccountCommand{
 		ID: accountID,
 	}
 	ctx := context.Background()
 
+	mockRepo.EXPECT().GetByIDForUpdate(mock.Anything, accountID).Return(account, nil)
+	mockTxRepo.EXPECT().CountPendingByAccountID(mock.Anything, accountID).Return(0, nil)
 	mockRepo.EXPECT().Delete(mock.Anything, accountID).Return(errors.New("failed to delete account"))
 
 	// Act
@@ -109,28 +118,60 @@ func TestDeleteAccountHandler_Handle_ShouldReturnErrorWhenDatabaseFails(t *testi
 	}
 }
 
-func TestDeleteAccountHandler_Handle_ShouldHandleMultipleAccountsCorrectly(t *testing.T) {
+func TestDeleteAccountHandler_Handle_ShouldRefuseAccountWithBalance(t *testing.T) {
 	// Arrange
 	mockRepo := mocks.NewMockAccountRepository(t)
-	handler := NewDeleteAccountHandler(mockRepo)
+	handler := NewDeleteAccountHandler(newMockTxManager(t, mockRepo))
 
-	account2ID := uuid.New()
+	account := newTestAccount(t, "12345", "John Doe", domain.NewMoney(2500, domain.USD))
 	command := &commands.DeleteAccountCommand{
-		ID: account2ID,
+		ID: account.ID,
 	}
 	ctx := context.Background()
 
-	mockRepo.EXPECT().Delete(mock.Anything, account2ID).Return(nil)
+	mockRepo.EXPECT().GetByIDForUpdate(mock.Anything, account.ID).Return(account, nil)
 
 	// Act
 	response, err := handler.Handle(ctx, command)
 
 	// Assert
-	if err != nil {
-		t.Errorf("Expected no error, got %v", err)
+	if !errors.Is(err, domain.ErrInvalidState) {
+		t.Errorf("Expected invalid state error, got %v", err)
 	}
 
-	if !response.Success {
-		t.Error("Expected success to be true")
+	if response.Success {
+		t.Error("Expected success to be false")
+	}
+
+	mockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestDeleteAccountHandler_Handle_ShouldRefuseAccountWithPendingTransactions(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockAccountRepository(t)
+	mockTxRepo := mocks.NewMockTransactionRepository(t)
+	handler := NewDeleteAccountHandler(newMockTxManager(t, mockRepo, mockTxRepo))
+
+	account := newTestAccount(t, "12345", "John Doe", domain.NewMoney(0, domain.USD))
+	command := &commands.DeleteAccountCommand{
+		ID: account.ID,
 	}
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetByIDForUpdate(mock.Anything, account.ID).Return(account, nil)
+	mockTxRepo.EXPECT().CountPendingByAccountID(mock.Anything, account.ID).Return(1, nil)
+
+	// Act
+	response, err := handler.Handle(ctx, command)
+
+	// Assert
+	if !errors.Is(err, domain.ErrInvalidState) {
+		t.Errorf("Expected invalid state error, got %v", err)
+	}
+
+	if response.Success {
+		t.Error("Expected success to be false")
+	}
+
+	mockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
 }
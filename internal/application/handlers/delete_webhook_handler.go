@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+)
+
+type DeleteWebhookHandler struct {
+	webhookRepo repository.WebhookSubscriptionRepository
+}
+
+func NewDeleteWebhookHandler(webhookRepo repository.WebhookSubscriptionRepository) *DeleteWebhookHandler {
+	return &DeleteWebhookHandler{
+		webhookRepo: webhookRepo,
+	}
+}
+
+// Handle removes the subscription together with its delivery log. Pending
+// deliveries are not sent.
+func (h *DeleteWebhookHandler) Handle(
+	ctx context.Context,
+	command *commands.DeleteWebhookCommand,
+) (*commands.DeleteWebhookResponse, error) {
+	if _, err := h.webhookRepo.GetByID(ctx, command.ID); err != nil {
+		return &commands.DeleteWebhookResponse{Success: false}, err
+	}
+
+	if err := h.webhookRepo.Delete(ctx, command.ID); err != nil {
+		return &commands.DeleteWebhookResponse{Success: false}, err
+	}
+
+	return &commands.DeleteWebhookResponse{Success: true}, nil
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/mocks"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDeleteWebhookHandler_Handle_ShouldDeleteSubscription(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockWebhookSubscriptionRepository(t)
+	handler := NewDeleteWebhookHandler(mockRepo)
+
+	webhook, _ := domain.NewWebhookSubscription("https://example.com/hooks", []string{"account.blocked"}, "0123456789abcdef")
+	mockRepo.EXPECT().GetByID(mock.Anything, webhook.ID).Return(webhook, nil)
+	mockRepo.EXPECT().Delete(mock.Anything, webhook.ID).Return(nil)
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, &commands.DeleteWebhookCommand{ID: webhook.ID})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !response.Success {
+		t.Error("Expected success to be true")
+	}
+}
+
+func TestDeleteWebhookHandler_Handle_ShouldReturnNotFound(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockWebhookSubscriptionRepository(t)
+	handler := NewDeleteWebhookHandler(mockRepo)
+
+	id := uuid.New()
+	mockRepo.EXPECT().GetByID(mock.Anything, id).Return(nil, domain.NewError(domain.ErrorCodeNotFound, "webhook subscription not found"))
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, &commands.DeleteWebhookCommand{ID: id})
+
+	// Assert
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+	if response.Success {
+		t.Error("Expected success to be false")
+	}
+}
@@ -19,7 +19,7 @@ func TestGetAccountByNumberHandler_Handle_ShouldSuccessfullyRetrieveAccountByNum
 
 	accountID := uuid.New()
 	accountNumber := "ACC-12345"
-	testAccount := domain.NewAccount(accountNumber, "Jane Smith", domain.NewMoney(25000, domain.THB))
+	testAccount := newTestAccount(t, accountNumber, "Jane Smith", domain.NewMoney(25000, domain.THB))
 	testAccount.ID = accountID
 
 	query := &queries.GetAccountByNumberQuery{
@@ -98,7 +98,7 @@ func TestGetAccountByNumberHandler_Handle_ShouldReturnCorrectAccountWhenMultiple
 	handler := NewGetAccountByNumberHandler(mockRepo)
 
 	targetNumber := "ACC-002"
-	targetAccount := domain.NewAccount(targetNumber, "Bob Wilson", domain.NewMoney(10000, domain.USD))
+	targetAccount := newTestAccount(t, targetNumber, "Bob Wilson", domain.NewMoney(10000, domain.USD))
 	targetAccount.ID = uuid.New()
 
 	query := &queries.GetAccountByNumberQuery{
@@ -155,7 +155,7 @@ func TestGetAccountByNumberHandler_Handle_ShouldHandleCaseSensitiveSearch(t *tes
 	handler := NewGetAccountByNumberHandler(mockRepo)
 
 	accountNumber := "ACC-MixedCase123"
-	testAccount := domain.NewAccount(accountNumber, "Case Tester", domain.NewMoney(15000, domain.USD))
+	testAccount := newTestAccount(t, accountNumber, "Case Tester", domain.NewMoney(15000, domain.USD))
 	testAccount.ID = uuid.New()
 
 	query := &queries.GetAccountByNumberQuery{
@@ -17,7 +17,12 @@ func NewGetAccountHandler(accountRepository repository.AccountRepository) *GetAc
 }
 
 func (h *GetAccountHandler) Handle(ctx context.Context, query *queries.GetAccountQuery) (*queries.GetAccountResponse, error) {
-	account, err := h.accountRepository.GetByID(ctx, query.ID)
+	getByID := h.accountRepository.GetByID
+	if query.IncludeDeleted {
+		getByID = h.accountRepository.GetByIDWithDeleted
+	}
+
+	account, err := getByID(ctx, query.ID)
 	if err != nil {
 		return nil, err
 	}
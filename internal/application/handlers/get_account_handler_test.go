@@ -18,7 +18,7 @@ func TestGetAccountHandler_Handle_ShouldSuccessfullyRetrieveAccountByID(t *testi
 	handler := NewGetAccountHandler(mockRepo)
 
 	accountID := uuid.New()
-	testAccount := domain.NewAccount("12345", "John Doe", domain.NewMoney(10000, domain.USD))
+	testAccount := newTestAccount(t, "12345", "John Doe", domain.NewMoney(10000, domain.USD))
 	testAccount.ID = accountID
 
 	query := &queries.GetAccountQuery{
@@ -102,7 +102,7 @@ func TestGetAccountHandler_Handle_ShouldRetrieveCorrectAccountWhenMultipleExist(
 	handler := NewGetAccountHandler(mockRepo)
 
 	account1ID := uuid.New()
-	account1 := domain.NewAccount("11111", "Alice", domain.NewMoney(5000, domain.USD))
+	account1 := newTestAccount(t, "11111", "Alice", domain.NewMoney(5000, domain.USD))
 	account1.ID = account1ID
 
 	query := &queries.GetAccountQuery{
@@ -154,13 +154,13 @@ func TestGetAccountHandler_Handle_ShouldRetrieveAccountWithDifferentStates(t *te
 			handler := NewGetAccountHandler(mockRepo)
 
 			accountID := uuid.New()
-			testAccount := domain.NewAccount("98765", "Test User", domain.NewMoney(tt.amount, tt.currency))
+			testAccount := newTestAccount(t, "98765", "Test User", domain.NewMoney(tt.amount, tt.currency))
 			testAccount.ID = accountID
 
 			// Set account status
 			switch tt.status {
 			case domain.AccountStatusBlocked:
-				testAccount.Block()
+				_ = testAccount.Block(domain.StatusChange{Reason: domain.StatusReasonFraudSuspected, ChangedBy: "test"})
 			case domain.AccountStatusInactive:
 				testAccount.Status = domain.AccountStatusInactive
 			}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/queries"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+)
+
+type GetAccountLedgerHandler struct {
+	ledgerRepo repository.LedgerRepository
+}
+
+func NewGetAccountLedgerHandler(ledgerRepo repository.LedgerRepository) *GetAccountLedgerHandler {
+	return &GetAccountLedgerHandler{
+		ledgerRepo: ledgerRepo,
+	}
+}
+
+func (h *GetAccountLedgerHandler) Handle(
+	ctx context.Context,
+	query *queries.GetAccountLedgerQuery,
+) (*queries.GetAccountLedgerResponse, error) {
+	req := repository.PaginationRequest{
+		Page:      query.Page,
+		PageSize:  query.PageSize,
+		Cursor:    query.Cursor,
+		SkipTotal: query.SkipTotal,
+	}
+
+	pagination, err := h.ledgerRepo.FindByAccountIDPaginated(ctx, query.AccountID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queries.GetAccountLedgerResponse{
+		Pagination: pagination,
+	}, nil
+}
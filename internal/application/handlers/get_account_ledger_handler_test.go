@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/queries"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"arise_tech_assessment/mocks"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetAccountLedgerHandler_Handle_ShouldSuccessfullyRetrieveLedgerEntries(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockLedgerRepository(t)
+	handler := NewGetAccountLedgerHandler(mockRepo)
+
+	accountID := uuid.New()
+	transactionID := uuid.New()
+	entries := []domain.LedgerEntry{
+		domain.NewLedgerEntry(accountID, transactionID, domain.EntryDirectionCredit, domain.NewMoney(1000, domain.USD), domain.NewMoney(1000, domain.USD)),
+		domain.NewLedgerEntry(accountID, uuid.New(), domain.EntryDirectionDebit, domain.NewMoney(400, domain.USD), domain.NewMoney(600, domain.USD)),
+	}
+
+	query := &queries.GetAccountLedgerQuery{
+		AccountID: accountID,
+		Page:      1,
+		PageSize:  10,
+	}
+
+	expectedResponse := &repository.PaginationResponse[domain.LedgerEntry]{
+		Data:       entries,
+		Page:       1,
+		PageSize:   10,
+		Total:      2,
+		TotalPages: 1,
+	}
+	mockRepo.EXPECT().FindByAccountIDPaginated(mock.Anything, accountID, mock.MatchedBy(func(req repository.PaginationRequest) bool {
+		return req.Page == 1 && req.PageSize == 10
+	})).Return(expectedResponse, nil)
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, query)
+
+	// Assert
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if response == nil {
+		t.Fatal("Expected response, got nil")
+	}
+	if response.Pagination == nil {
+		t.Fatal("Expected pagination in response, got nil")
+	}
+	if len(response.Pagination.Data) != 2 {
+		t.Errorf("Expected 2 entries, got %d", len(response.Pagination.Data))
+	}
+}
+
+func TestGetAccountLedgerHandler_Handle_ShouldReturnErrorWhenRepositoryFails(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockLedgerRepository(t)
+	handler := NewGetAccountLedgerHandler(mockRepo)
+
+	accountID := uuid.New()
+	query := &queries.GetAccountLedgerQuery{
+		AccountID: accountID,
+		Page:      1,
+		PageSize:  10,
+	}
+
+	mockRepo.EXPECT().FindByAccountIDPaginated(mock.Anything, accountID, mock.Anything).Return(nil, errors.New("database error"))
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, query)
+
+	// Assert
+	if err == nil {
+		t.Error("Expected error, got nil")
+	}
+	if response != nil {
+		t.Error("Expected nil response on error, got response")
+	}
+}
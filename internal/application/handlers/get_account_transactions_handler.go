@@ -21,8 +21,10 @@ func (h *GetAccountTransactionsHandler) Handle(
 	query *queries.GetAccountTransactionsQuery,
 ) (*queries.GetAccountTransactionsResponse, error) {
 	req := repository.PaginationRequest{
-		Page:     query.Page,
-		PageSize: query.PageSize,
+		Page:      query.Page,
+		PageSize:  query.PageSize,
+		Cursor:    query.Cursor,
+		SkipTotal: query.SkipTotal,
 	}
 
 	pagination, err := h.transactionRepo.FindByAccountIDPaginated(ctx, query.AccountID, req)
@@ -219,7 +219,7 @@ func TestGetAccountTransactionsHandler_Handle_ShouldApplyDefaultPaginationWhenNo
 	testTransactions := make([]domain.Transaction, 5)
 	for i := 0; i < 5; i++ {
 		fromAccount := uuid.New()
-		transaction := domain.NewTransferTransaction(fromAccount, accountID, domain.NewMoney(int64((i+1)*1000), domain.USD), "Transfer to account")
+		transaction := newTestTransfer(t, fromAccount, accountID, domain.NewMoney(int64((i+1)*1000), domain.USD), "Transfer to account")
 		transaction.ID = uuid.New()
 		testTransactions[i] = *transaction
 	}
@@ -276,7 +276,7 @@ func TestGetAccountTransactionsHandler_Handle_ShouldRetrieveMixedTransactionType
 	withdraw := domain.NewWithdrawTransaction(accountID, domain.NewMoney(1000, domain.USD), "Withdraw")
 	withdraw.ID = uuid.New()
 
-	transfer := domain.NewTransferTransaction(otherAccountID, accountID, domain.NewMoney(1500, domain.USD), "Transfer")
+	transfer := newTestTransfer(t, otherAccountID, accountID, domain.NewMoney(1500, domain.USD), "Transfer")
 	transfer.ID = uuid.New()
 
 	testTransactions := []domain.Transaction{*deposit, *withdraw, *transfer}
@@ -330,3 +330,39 @@ func TestGetAccountTransactionsHandler_Handle_ShouldRetrieveMixedTransactionType
 		}
 	}
 }
+
+func TestGetAccountTransactionsHandler_Handle_ShouldPageByCursor(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockTransactionRepository(t)
+	handler := NewGetAccountTransactionsHandler(mockRepo)
+
+	accountID := uuid.New()
+	query := &queries.GetAccountTransactionsQuery{
+		AccountID: accountID,
+		PageSize:  20,
+		Cursor:    "opaque-cursor",
+		SkipTotal: true,
+	}
+
+	expectedResponse := &repository.PaginationResponse[domain.Transaction]{
+		PageSize:   20,
+		Total:      -1,
+		TotalPages: -1,
+		NextCursor: "next-cursor",
+	}
+	mockRepo.EXPECT().FindByAccountIDPaginated(mock.Anything, accountID, mock.MatchedBy(func(req repository.PaginationRequest) bool {
+		return req.Cursor == "opaque-cursor" && req.SkipTotal && req.PageSize == 20
+	})).Return(expectedResponse, nil)
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, query)
+
+	// Assert
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if response.Pagination.NextCursor != "next-cursor" {
+		t.Errorf("Expected next cursor 'next-cursor', got %s", response.Pagination.NextCursor)
+	}
+}
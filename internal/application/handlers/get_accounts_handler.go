@@ -18,11 +18,18 @@ func NewGetAccountsHandler(accountRepository repository.AccountRepository) *GetA
 
 func (h *GetAccountsHandler) Handle(ctx context.Context, query *queries.GetAccountsQuery) (*queries.GetAccountsResponse, error) {
 	req := repository.PaginationRequest{
-		Page:     query.Page,
-		PageSize: query.PageSize,
+		Page:      query.Page,
+		PageSize:  query.PageSize,
+		Cursor:    query.Cursor,
+		SkipTotal: query.SkipTotal,
 	}
 
-	pagination, err := h.accountRepository.GetPaginated(ctx, req)
+	spec, err := query.Filter.Spec(query.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := h.accountRepository.FindPaginated(ctx, spec, req)
 	if err != nil {
 		return nil, err
 	}
@@ -19,7 +19,7 @@ func TestGetAccountsHandler_Handle_ShouldSuccessfullyRetrieveAccounts(t *testing
 
 	testAccounts := make([]domain.Account, 5)
 	for i := 0; i < 5; i++ {
-		account := domain.NewAccount("ACC-"+string(rune('1'+i)), "User "+string(rune('A'+i)), domain.NewMoney(int64((i+1)*1000), domain.USD))
+		account := newTestAccount(t, "ACC-"+string(rune('1'+i)), "User "+string(rune('A'+i)), domain.NewMoney(int64((i+1)*1000), domain.USD))
 		account.ID = uuid.New()
 		testAccounts[i] = *account
 	}
@@ -37,7 +37,7 @@ func TestGetAccountsHandler_Handle_ShouldSuccessfullyRetrieveAccounts(t *testing
 		Total:      5,
 		TotalPages: 2,
 	}
-	mockRepo.EXPECT().GetPaginated(mock.Anything, mock.MatchedBy(func(req repository.PaginationRequest) bool {
+	mockRepo.EXPECT().FindPaginated(mock.Anything, mock.Anything, mock.MatchedBy(func(req repository.PaginationRequest) bool {
 		return req.Page == 1 && req.PageSize == 3
 	})).Return(expectedResponse, nil)
 
@@ -86,7 +86,7 @@ func TestGetAccountsHandler_Handle_ShouldRetrieveSecondPageOfAccounts(t *testing
 	// Create 7 test accounts
 	testAccounts := make([]domain.Account, 7)
 	for i := 0; i < 7; i++ {
-		account := domain.NewAccount("ACC-"+string(rune('1'+i)), "User "+string(rune('A'+i)), domain.NewMoney(int64((i+1)*1000), domain.USD))
+		account := newTestAccount(t, "ACC-"+string(rune('1'+i)), "User "+string(rune('A'+i)), domain.NewMoney(int64((i+1)*1000), domain.USD))
 		account.ID = uuid.New()
 		testAccounts[i] = *account
 	}
@@ -96,7 +96,7 @@ func TestGetAccountsHandler_Handle_ShouldRetrieveSecondPageOfAccounts(t *testing
 		PageSize: 3,
 	}
 
-	// Mock the GetPaginated call for second page
+	// Mock the FindPaginated call for second page
 	expectedResponse := &repository.PaginationResponse[domain.Account]{
 		Data:       testAccounts[3:6],
 		Page:       2,
@@ -104,7 +104,7 @@ func TestGetAccountsHandler_Handle_ShouldRetrieveSecondPageOfAccounts(t *testing
 		Total:      7,
 		TotalPages: 3,
 	}
-	mockRepo.EXPECT().GetPaginated(mock.Anything, mock.MatchedBy(func(req repository.PaginationRequest) bool {
+	mockRepo.EXPECT().FindPaginated(mock.Anything, mock.Anything, mock.MatchedBy(func(req repository.PaginationRequest) bool {
 		return req.Page == 2 && req.PageSize == 3
 	})).Return(expectedResponse, nil)
 
@@ -140,7 +140,7 @@ func TestGetAccountsHandler_Handle_ShouldRetrieveLastPageOfAccounts(t *testing.T
 	// Create 8 test accounts
 	testAccounts := make([]domain.Account, 8)
 	for i := 0; i < 8; i++ {
-		account := domain.NewAccount("ACC-"+string(rune('1'+i)), "User "+string(rune('A'+i)), domain.NewMoney(int64((i+1)*1000), domain.USD))
+		account := newTestAccount(t, "ACC-"+string(rune('1'+i)), "User "+string(rune('A'+i)), domain.NewMoney(int64((i+1)*1000), domain.USD))
 		account.ID = uuid.New()
 		testAccounts[i] = *account
 	}
@@ -150,7 +150,7 @@ func TestGetAccountsHandler_Handle_ShouldRetrieveLastPageOfAccounts(t *testing.T
 		PageSize: 3,
 	}
 
-	// Mock the GetPaginated call for last page
+	// Mock the FindPaginated call for last page
 	expectedResponse := &repository.PaginationResponse[domain.Account]{
 		Data:       testAccounts[6:8], // Last 2 accounts
 		Page:       3,
@@ -158,7 +158,7 @@ func TestGetAccountsHandler_Handle_ShouldRetrieveLastPageOfAccounts(t *testing.T
 		Total:      8,
 		TotalPages: 3,
 	}
-	mockRepo.EXPECT().GetPaginated(mock.Anything, mock.MatchedBy(func(req repository.PaginationRequest) bool {
+	mockRepo.EXPECT().FindPaginated(mock.Anything, mock.Anything, mock.MatchedBy(func(req repository.PaginationRequest) bool {
 		return req.Page == 3 && req.PageSize == 3
 	})).Return(expectedResponse, nil)
 
@@ -205,7 +205,7 @@ func TestGetAccountsHandler_Handle_ShouldReturnEmptyResultWhenNoAccountsFound(t
 		Total:      0,
 		TotalPages: 0,
 	}
-	mockRepo.EXPECT().GetPaginated(mock.Anything, mock.MatchedBy(func(req repository.PaginationRequest) bool {
+	mockRepo.EXPECT().FindPaginated(mock.Anything, mock.Anything, mock.MatchedBy(func(req repository.PaginationRequest) bool {
 		return req.Page == 1 && req.PageSize == 10
 	})).Return(expectedResponse, nil)
 
@@ -237,7 +237,7 @@ func TestGetAccountsHandler_Handle_ShouldApplyDefaultPaginationWhenNotProvided(t
 	// Create 5 test accounts
 	testAccounts := make([]domain.Account, 5)
 	for i := 0; i < 5; i++ {
-		account := domain.NewAccount("ACC-"+string(rune('1'+i)), "User "+string(rune('A'+i)), domain.NewMoney(int64((i+1)*1000), domain.USD))
+		account := newTestAccount(t, "ACC-"+string(rune('1'+i)), "User "+string(rune('A'+i)), domain.NewMoney(int64((i+1)*1000), domain.USD))
 		account.ID = uuid.New()
 		testAccounts[i] = *account
 	}
@@ -248,7 +248,7 @@ func TestGetAccountsHandler_Handle_ShouldApplyDefaultPaginationWhenNotProvided(t
 		PageSize: 0, // Should default to 10
 	}
 
-	// Mock the GetPaginated call with defaults
+	// Mock the FindPaginated call with defaults
 	expectedResponse := &repository.PaginationResponse[domain.Account]{
 		Data:       testAccounts,
 		Page:       1,
@@ -256,7 +256,7 @@ func TestGetAccountsHandler_Handle_ShouldApplyDefaultPaginationWhenNotProvided(t
 		Total:      5,
 		TotalPages: 1,
 	}
-	mockRepo.EXPECT().GetPaginated(mock.Anything, mock.MatchedBy(func(req repository.PaginationRequest) bool {
+	mockRepo.EXPECT().FindPaginated(mock.Anything, mock.Anything, mock.MatchedBy(func(req repository.PaginationRequest) bool {
 		return req.Page == 0 && req.PageSize == 0
 	})).Return(expectedResponse, nil)
 
@@ -292,7 +292,7 @@ func TestGetAccountsHandler_Handle_ShouldReturnEmptyResultWhenPageBeyondRange(t
 		PageSize: 2,
 	}
 
-	// Mock the GetPaginated call for page beyond range
+	// Mock the FindPaginated call for page beyond range
 	expectedResponse := &repository.PaginationResponse[domain.Account]{
 		Data:       []domain.Account{}, // Empty data for page beyond range
 		Page:       5,
@@ -300,7 +300,7 @@ func TestGetAccountsHandler_Handle_ShouldReturnEmptyResultWhenPageBeyondRange(t
 		Total:      3,
 		TotalPages: 2,
 	}
-	mockRepo.EXPECT().GetPaginated(mock.Anything, mock.MatchedBy(func(req repository.PaginationRequest) bool {
+	mockRepo.EXPECT().FindPaginated(mock.Anything, mock.Anything, mock.MatchedBy(func(req repository.PaginationRequest) bool {
 		return req.Page == 5 && req.PageSize == 2
 	})).Return(expectedResponse, nil)
 
@@ -335,7 +335,7 @@ func TestGetAccountsHandler_Handle_ShouldRetrieveSingleAccountCorrectly(t *testi
 	handler := NewGetAccountsHandler(mockRepo)
 
 	// Create single test account
-	account := domain.NewAccount("SINGLE-001", "Single User", domain.NewMoney(50000, domain.THB))
+	account := newTestAccount(t, "SINGLE-001", "Single User", domain.NewMoney(50000, domain.THB))
 	account.ID = uuid.New()
 
 	query := &queries.GetAccountsQuery{
@@ -343,7 +343,7 @@ func TestGetAccountsHandler_Handle_ShouldRetrieveSingleAccountCorrectly(t *testi
 		PageSize: 5,
 	}
 
-	// Mock the GetPaginated call for single account
+	// Mock the FindPaginated call for single account
 	expectedResponse := &repository.PaginationResponse[domain.Account]{
 		Data:       []domain.Account{*account},
 		Page:       1,
@@ -351,7 +351,7 @@ func TestGetAccountsHandler_Handle_ShouldRetrieveSingleAccountCorrectly(t *testi
 		Total:      1,
 		TotalPages: 1,
 	}
-	mockRepo.EXPECT().GetPaginated(mock.Anything, mock.MatchedBy(func(req repository.PaginationRequest) bool {
+	mockRepo.EXPECT().FindPaginated(mock.Anything, mock.Anything, mock.MatchedBy(func(req repository.PaginationRequest) bool {
 		return req.Page == 1 && req.PageSize == 5
 	})).Return(expectedResponse, nil)
 
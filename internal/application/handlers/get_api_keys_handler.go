@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/queries"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+)
+
+type GetAPIKeysHandler struct {
+	apiKeyRepo repository.APIKeyRepository
+}
+
+func NewGetAPIKeysHandler(apiKeyRepo repository.APIKeyRepository) *GetAPIKeysHandler {
+	return &GetAPIKeysHandler{
+		apiKeyRepo: apiKeyRepo,
+	}
+}
+
+func (h *GetAPIKeysHandler) Handle(
+	ctx context.Context,
+	query *queries.GetAPIKeysQuery,
+) (*queries.GetAPIKeysResponse, error) {
+	req := repository.PaginationRequest{
+		Page:     query.Page,
+		PageSize: query.PageSize,
+	}
+
+	pagination, err := h.apiKeyRepo.GetPaginated(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queries.GetAPIKeysResponse{
+		Pagination: pagination,
+	}, nil
+}
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/queries"
+	"arise_tech_assessment/internal/domain"
+	"context"
+)
+
+type GetExchangeRateHandler struct {
+	rateProvider domain.ExchangeRateProvider
+}
+
+func NewGetExchangeRateHandler(rateProvider domain.ExchangeRateProvider) *GetExchangeRateHandler {
+	return &GetExchangeRateHandler{
+		rateProvider: rateProvider,
+	}
+}
+
+func (h *GetExchangeRateHandler) Handle(
+	ctx context.Context,
+	query *queries.GetExchangeRateQuery,
+) (*queries.GetExchangeRateResponse, error) {
+	exchangeRate, err := h.rateProvider.GetRate(ctx, query.BaseCurrency, query.QuoteCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queries.GetExchangeRateResponse{
+		ExchangeRate: exchangeRate,
+	}, nil
+}
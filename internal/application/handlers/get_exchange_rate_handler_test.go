@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/queries"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/mocks"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetExchangeRateHandler_Handle_ShouldReturnCurrentRate(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockExchangeRateRepository(t)
+	handler := NewGetExchangeRateHandler(mockRepo)
+
+	rate, _ := domain.NewExchangeRate(domain.USD, domain.THB, domain.MustParseRate("35.5"), time.Now())
+	mockRepo.EXPECT().GetRate(mock.Anything, domain.USD, domain.THB).Return(rate, nil)
+
+	query := &queries.GetExchangeRateQuery{
+		BaseCurrency:  domain.USD,
+		QuoteCurrency: domain.THB,
+	}
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, query)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if response.ExchangeRate != rate {
+		t.Errorf("Expected rate %v, got %v", rate, response.ExchangeRate)
+	}
+}
+
+func TestGetExchangeRateHandler_Handle_ShouldReturnErrorWhenNoRateExists(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockExchangeRateRepository(t)
+	handler := NewGetExchangeRateHandler(mockRepo)
+
+	mockRepo.EXPECT().GetRate(mock.Anything, domain.USD, domain.JPY).
+		Return(nil, domain.Errorf(domain.ErrorCodeNotFound, "no exchange rate for USD/JPY"))
+
+	query := &queries.GetExchangeRateQuery{
+		BaseCurrency:  domain.USD,
+		QuoteCurrency: domain.JPY,
+	}
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, query)
+
+	// Assert
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+	if response != nil {
+		t.Error("Expected nil response on error, got response")
+	}
+}
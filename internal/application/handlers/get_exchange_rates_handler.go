@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/queries"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+)
+
+type GetExchangeRatesHandler struct {
+	exchangeRateRepo repository.ExchangeRateRepository
+}
+
+func NewGetExchangeRatesHandler(exchangeRateRepo repository.ExchangeRateRepository) *GetExchangeRatesHandler {
+	return &GetExchangeRatesHandler{
+		exchangeRateRepo: exchangeRateRepo,
+	}
+}
+
+func (h *GetExchangeRatesHandler) Handle(
+	ctx context.Context,
+	query *queries.GetExchangeRatesQuery,
+) (*queries.GetExchangeRatesResponse, error) {
+	req := repository.PaginationRequest{
+		Page:     query.Page,
+		PageSize: query.PageSize,
+	}
+
+	pagination, err := h.exchangeRateRepo.GetPaginated(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queries.GetExchangeRatesResponse{
+		Pagination: pagination,
+	}, nil
+}
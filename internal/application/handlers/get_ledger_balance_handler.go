@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/queries"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+)
+
+type GetLedgerBalanceHandler struct {
+	accountRepo repository.AccountRepository
+	ledgerRepo  repository.LedgerRepository
+}
+
+func NewGetLedgerBalanceHandler(accountRepo repository.AccountRepository, ledgerRepo repository.LedgerRepository) *GetLedgerBalanceHandler {
+	return &GetLedgerBalanceHandler{
+		accountRepo: accountRepo,
+		ledgerRepo:  ledgerRepo,
+	}
+}
+
+// Handle rebuilds the account balance from its ledger entries and compares it
+// with the balance stored on the account.
+func (h *GetLedgerBalanceHandler) Handle(
+	ctx context.Context,
+	query *queries.GetLedgerBalanceQuery,
+) (*queries.GetLedgerBalanceResponse, error) {
+	account, err := h.accountRepo.GetByID(ctx, query.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	ledgerBalance, err := h.ledgerRepo.SumByAccountID(ctx, account.ID, account.Balance.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queries.GetLedgerBalanceResponse{
+		AccountID:      account.ID,
+		LedgerBalance:  ledgerBalance,
+		AccountBalance: account.Balance,
+		Reconciled:     ledgerBalance == account.Balance,
+	}, nil
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/queries"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/mocks"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetLedgerBalanceHandler_Handle_ShouldReportReconciledBalance(t *testing.T) {
+	// Arrange
+	mockAccRepo := mocks.NewMockAccountRepository(t)
+	mockLedgerRepo := mocks.NewMockLedgerRepository(t)
+	handler := NewGetLedgerBalanceHandler(mockAccRepo, mockLedgerRepo)
+
+	account := newTestAccount(t, "12345", "John Doe", domain.NewMoney(5000, domain.USD))
+
+	mockAccRepo.EXPECT().GetByID(mock.Anything, account.ID).Return(account, nil)
+	mockLedgerRepo.EXPECT().SumByAccountID(mock.Anything, account.ID, domain.USD).Return(domain.NewMoney(5000, domain.USD), nil)
+
+	query := &queries.GetLedgerBalanceQuery{AccountID: account.ID}
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, query)
+
+	// Assert
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if response == nil {
+		t.Fatal("Expected response, got nil")
+	}
+	if !response.Reconciled {
+		t.Error("Expected balances to be reconciled")
+	}
+	if response.LedgerBalance.Amount != 5000 {
+		t.Errorf("Expected ledger balance 5000, got %d", response.LedgerBalance.Amount)
+	}
+}
+
+func TestGetLedgerBalanceHandler_Handle_ShouldReportDrift(t *testing.T) {
+	// Arrange
+	mockAccRepo := mocks.NewMockAccountRepository(t)
+	mockLedgerRepo := mocks.NewMockLedgerRepository(t)
+	handler := NewGetLedgerBalanceHandler(mockAccRepo, mockLedgerRepo)
+
+	account := newTestAccount(t, "12345", "John Doe", domain.NewMoney(5000, domain.USD))
+
+	mockAccRepo.EXPECT().GetByID(mock.Anything, account.ID).Return(account, nil)
+	mockLedgerRepo.EXPECT().SumByAccountID(mock.Anything, account.ID, domain.USD).Return(domain.NewMoney(4000, domain.USD), nil)
+
+	query := &queries.GetLedgerBalanceQuery{AccountID: account.ID}
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, query)
+
+	// Assert
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if response.Reconciled {
+		t.Error("Expected balances not to be reconciled")
+	}
+}
+
+func TestGetLedgerBalanceHandler_Handle_ShouldReturnErrorWhenAccountNotFound(t *testing.T) {
+	// Arrange
+	mockAccRepo := mocks.NewMockAccountRepository(t)
+	mockLedgerRepo := mocks.NewMockLedgerRepository(t)
+	handler := NewGetLedgerBalanceHandler(mockAccRepo, mockLedgerRepo)
+
+	accountID := uuid.New()
+	mockAccRepo.EXPECT().GetByID(mock.Anything, accountID).Return(nil, errors.New("account not found"))
+
+	query := &queries.GetLedgerBalanceQuery{AccountID: accountID}
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, query)
+
+	// Assert
+	if err == nil {
+		t.Error("Expected error, got nil")
+	}
+	if response != nil {
+		t.Error("Expected nil response on error, got response")
+	}
+}
@@ -70,8 +70,8 @@ func TestGetTransactionHandler_Handle_ShouldSuccessfullyRetrieveTransactionByID(
 		t.Errorf("Expected description 'Test withdrawal', got %s", transaction.Description)
 	}
 
-	if transaction.Reference != "REF-12345" {
-		t.Errorf("Expected reference 'REF-12345', got %s", transaction.Reference)
+	if transaction.Reference == nil || *transaction.Reference != "REF-12345" {
+		t.Errorf("Expected reference 'REF-12345', got %v", transaction.Reference)
 	}
 
 	if transaction.FromAccountID == nil {
@@ -133,7 +133,7 @@ func TestGetTransactionHandler_Handle_ShouldRetrieveDifferentTransactionTypes(t
 		},
 		{
 			"transfer transaction",
-			domain.NewTransferTransaction(fromAccountID, toAccountID, domain.NewMoney(2500, domain.USD), "Transfer to friend"),
+			newTestTransfer(t, fromAccountID, toAccountID, domain.NewMoney(2500, domain.USD), "Transfer to friend"),
 		},
 	}
 
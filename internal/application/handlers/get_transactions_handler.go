@@ -21,11 +21,18 @@ func (h *GetTransactionsHandler) Handle(
 	query *queries.GetTransactionsQuery,
 ) (*queries.GetTransactionsResponse, error) {
 	req := repository.PaginationRequest{
-		Page:     query.Page,
-		PageSize: query.PageSize,
+		Page:      query.Page,
+		PageSize:  query.PageSize,
+		Cursor:    query.Cursor,
+		SkipTotal: query.SkipTotal,
 	}
 
-	pagination, err := h.transactionRepo.GetPaginated(ctx, req)
+	spec, err := query.Filter.Spec(query.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := h.transactionRepo.FindPaginated(ctx, spec, req)
 	if err != nil {
 		return nil, err
 	}
@@ -6,6 +6,7 @@ import (
 	"arise_tech_assessment/internal/infrastructure/repository"
 	"arise_tech_assessment/mocks"
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/google/uuid"
@@ -37,7 +38,7 @@ func TestGetTransactionsHandler_Handle_ShouldSuccessfullyRetrieveTransactions(t
 		Total:      3,
 		TotalPages: 1,
 	}
-	mockRepo.EXPECT().GetPaginated(mock.Anything, mock.MatchedBy(func(req repository.PaginationRequest) bool {
+	mockRepo.EXPECT().FindPaginated(mock.Anything, mock.Anything, mock.MatchedBy(func(req repository.PaginationRequest) bool {
 		return req.Page == 1 && req.PageSize == 3
 	})).Return(expectedResponse, nil)
 
@@ -96,7 +97,7 @@ func TestGetTransactionsHandler_Handle_ShouldRetrieveSecondPageOfTransactions(t
 		Total:      5,
 		TotalPages: 2,
 	}
-	mockRepo.EXPECT().GetPaginated(mock.Anything, mock.MatchedBy(func(req repository.PaginationRequest) bool {
+	mockRepo.EXPECT().FindPaginated(mock.Anything, mock.Anything, mock.MatchedBy(func(req repository.PaginationRequest) bool {
 		return req.Page == 2 && req.PageSize == 3
 	})).Return(expectedResponse, nil)
 
@@ -141,7 +142,7 @@ func TestGetTransactionsHandler_Handle_ShouldReturnEmptyResultWhenNoTransactions
 		Total:      0,
 		TotalPages: 0,
 	}
-	mockRepo.EXPECT().GetPaginated(mock.Anything, mock.MatchedBy(func(req repository.PaginationRequest) bool {
+	mockRepo.EXPECT().FindPaginated(mock.Anything, mock.Anything, mock.MatchedBy(func(req repository.PaginationRequest) bool {
 		return req.Page == 1 && req.PageSize == 10
 	})).Return(expectedResponse, nil)
 
@@ -175,7 +176,7 @@ func TestGetTransactionsHandler_Handle_ShouldApplyDefaultPaginationWhenNotProvid
 	for i := 0; i < 5; i++ {
 		fromAccountID := uuid.New()
 		toAccountID := uuid.New()
-		transaction := domain.NewTransferTransaction(fromAccountID, toAccountID, domain.NewMoney(int64((i+1)*1000), domain.USD), "Test transfer")
+		transaction := newTestTransfer(t, fromAccountID, toAccountID, domain.NewMoney(int64((i+1)*1000), domain.USD), "Test transfer")
 		transaction.ID = uuid.New()
 		testTransactions[i] = *transaction
 	}
@@ -192,7 +193,7 @@ func TestGetTransactionsHandler_Handle_ShouldApplyDefaultPaginationWhenNotProvid
 		Total:      5,
 		TotalPages: 1,
 	}
-	mockRepo.EXPECT().GetPaginated(mock.Anything, mock.MatchedBy(func(req repository.PaginationRequest) bool {
+	mockRepo.EXPECT().FindPaginated(mock.Anything, mock.Anything, mock.MatchedBy(func(req repository.PaginationRequest) bool {
 		return req.Page == 1 && req.PageSize == 10
 	})).Return(expectedResponse, nil)
 
@@ -238,7 +239,7 @@ func TestGetTransactionsHandler_Handle_ShouldRetrieveSingleTransactionCorrectly(
 		Total:      1,
 		TotalPages: 1,
 	}
-	mockRepo.EXPECT().GetPaginated(mock.Anything, mock.MatchedBy(func(req repository.PaginationRequest) bool {
+	mockRepo.EXPECT().FindPaginated(mock.Anything, mock.Anything, mock.MatchedBy(func(req repository.PaginationRequest) bool {
 		return req.Page == 1 && req.PageSize == 5
 	})).Return(expectedResponse, nil)
 
@@ -270,3 +271,57 @@ func TestGetTransactionsHandler_Handle_ShouldRetrieveSingleTransactionCorrectly(
 		t.Errorf("Expected description 'Single transaction', got %s", returnedTransaction.Description)
 	}
 }
+
+func TestGetTransactionsHandler_Handle_ShouldQueryBySpecificationFromFilterAndSort(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockTransactionRepository(t)
+	handler := NewGetTransactionsHandler(mockRepo)
+
+	accountID := uuid.New()
+	query := &queries.GetTransactionsQuery{
+		Page:     1,
+		PageSize: 10,
+		Filter: repository.TransactionFilter{
+			Type:      domain.TransactionTypeTransfer,
+			AccountID: &accountID,
+		},
+		Sort: []repository.SortField{{Field: "amount", Direction: repository.SortDescending}},
+	}
+
+	expectedSpec := repository.TransactionTypeIs(domain.TransactionTypeTransfer).
+		And(repository.InvolvingAccount(accountID)).
+		OrderBy("amount", true)
+	expectedResponse := &repository.PaginationResponse[domain.Transaction]{Page: 1, PageSize: 10}
+	mockRepo.EXPECT().FindPaginated(mock.Anything, expectedSpec, repository.PaginationRequest{Page: 1, PageSize: 10}).Return(expectedResponse, nil)
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, query)
+
+	// Assert
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if response.Pagination != expectedResponse {
+		t.Errorf("Expected pagination %v, got %v", expectedResponse, response.Pagination)
+	}
+}
+
+func TestGetTransactionsHandler_Handle_ShouldRejectUnknownSortField(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockTransactionRepository(t)
+	handler := NewGetTransactionsHandler(mockRepo)
+
+	query := &queries.GetTransactionsQuery{
+		Sort: []repository.SortField{{Field: "description", Direction: repository.SortAscending}},
+	}
+
+	// Act
+	ctx := context.Background()
+	_, err := handler.Handle(ctx, query)
+
+	// Assert
+	if !errors.Is(err, domain.ErrValidation) {
+		t.Errorf("Expected validation error, got %v", err)
+	}
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/queries"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+)
+
+type GetWebhookDeliveriesHandler struct {
+	webhookRepo  repository.WebhookSubscriptionRepository
+	deliveryRepo repository.WebhookDeliveryRepository
+}
+
+func NewGetWebhookDeliveriesHandler(
+	webhookRepo repository.WebhookSubscriptionRepository,
+	deliveryRepo repository.WebhookDeliveryRepository,
+) *GetWebhookDeliveriesHandler {
+	return &GetWebhookDeliveriesHandler{
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+	}
+}
+
+// Handle returns the delivery log of the webhook, newest first.
+func (h *GetWebhookDeliveriesHandler) Handle(
+	ctx context.Context,
+	query *queries.GetWebhookDeliveriesQuery,
+) (*queries.GetWebhookDeliveriesResponse, error) {
+	// Answer 404 rather than an empty log for unknown webhooks
+	if _, err := h.webhookRepo.GetByID(ctx, query.WebhookID); err != nil {
+		return nil, err
+	}
+
+	req := repository.PaginationRequest{
+		Page:     query.Page,
+		PageSize: query.PageSize,
+	}
+
+	pagination, err := h.deliveryRepo.FindBySubscriptionIDPaginated(ctx, query.WebhookID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queries.GetWebhookDeliveriesResponse{
+		Pagination: pagination,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/queries"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"arise_tech_assessment/mocks"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetWebhookDeliveriesHandler_Handle_ShouldReturnDeliveryLog(t *testing.T) {
+	// Arrange
+	webhookRepo := mocks.NewMockWebhookSubscriptionRepository(t)
+	deliveryRepo := mocks.NewMockWebhookDeliveryRepository(t)
+	handler := NewGetWebhookDeliveriesHandler(webhookRepo, deliveryRepo)
+
+	webhook, _ := domain.NewWebhookSubscription("https://example.com/hooks", []string{"account.blocked"}, "0123456789abcdef")
+	expected := &repository.PaginationResponse[domain.WebhookDelivery]{
+		Data:     []domain.WebhookDelivery{*newTestWebhookDelivery(t, webhook.ID)},
+		Page:     2,
+		PageSize: 5,
+		Total:    6,
+	}
+	webhookRepo.EXPECT().GetByID(mock.Anything, webhook.ID).Return(webhook, nil)
+	deliveryRepo.EXPECT().FindBySubscriptionIDPaginated(mock.Anything, webhook.ID, repository.PaginationRequest{Page: 2, PageSize: 5}).Return(expected, nil)
+
+	query := &queries.GetWebhookDeliveriesQuery{WebhookID: webhook.ID, Page: 2, PageSize: 5}
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, query)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if response.Pagination != expected {
+		t.Errorf("Expected pagination %v, got %v", expected, response.Pagination)
+	}
+}
+
+func TestGetWebhookDeliveriesHandler_Handle_ShouldReturnNotFoundForUnknownWebhook(t *testing.T) {
+	// Arrange
+	webhookRepo := mocks.NewMockWebhookSubscriptionRepository(t)
+	deliveryRepo := mocks.NewMockWebhookDeliveryRepository(t)
+	handler := NewGetWebhookDeliveriesHandler(webhookRepo, deliveryRepo)
+
+	id := uuid.New()
+	webhookRepo.EXPECT().GetByID(mock.Anything, id).Return(nil, domain.NewError(domain.ErrorCodeNotFound, "webhook subscription not found"))
+
+	// Act
+	ctx := context.Background()
+	_, err := handler.Handle(ctx, &queries.GetWebhookDeliveriesQuery{WebhookID: id})
+
+	// Assert
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+}
@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/queries"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+)
+
+type GetWebhookHandler struct {
+	webhookRepo repository.WebhookSubscriptionRepository
+}
+
+func NewGetWebhookHandler(webhookRepo repository.WebhookSubscriptionRepository) *GetWebhookHandler {
+	return &GetWebhookHandler{
+		webhookRepo: webhookRepo,
+	}
+}
+
+func (h *GetWebhookHandler) Handle(ctx context.Context, query *queries.GetWebhookQuery) (*queries.GetWebhookResponse, error) {
+	webhook, err := h.webhookRepo.GetByID(ctx, query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queries.GetWebhookResponse{
+		Webhook: webhook,
+	}, nil
+}
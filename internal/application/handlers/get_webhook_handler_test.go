@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/queries"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/mocks"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetWebhookHandler_Handle_ShouldReturnSubscription(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockWebhookSubscriptionRepository(t)
+	handler := NewGetWebhookHandler(mockRepo)
+
+	webhook, _ := domain.NewWebhookSubscription("https://example.com/hooks", []string{"account.blocked"}, "0123456789abcdef")
+	mockRepo.EXPECT().GetByID(mock.Anything, webhook.ID).Return(webhook, nil)
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, &queries.GetWebhookQuery{ID: webhook.ID})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if response.Webhook != webhook {
+		t.Errorf("Expected webhook %v, got %v", webhook, response.Webhook)
+	}
+}
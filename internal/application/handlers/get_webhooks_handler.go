@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/queries"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+)
+
+type GetWebhooksHandler struct {
+	webhookRepo repository.WebhookSubscriptionRepository
+}
+
+func NewGetWebhooksHandler(webhookRepo repository.WebhookSubscriptionRepository) *GetWebhooksHandler {
+	return &GetWebhooksHandler{
+		webhookRepo: webhookRepo,
+	}
+}
+
+func (h *GetWebhooksHandler) Handle(
+	ctx context.Context,
+	query *queries.GetWebhooksQuery,
+) (*queries.GetWebhooksResponse, error) {
+	req := repository.PaginationRequest{
+		Page:     query.Page,
+		PageSize: query.PageSize,
+	}
+
+	pagination, err := h.webhookRepo.GetPaginated(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queries.GetWebhooksResponse{
+		Pagination: pagination,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/queries"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"arise_tech_assessment/mocks"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetWebhooksHandler_Handle_ShouldReturnPage(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockWebhookSubscriptionRepository(t)
+	handler := NewGetWebhooksHandler(mockRepo)
+
+	expected := &repository.PaginationResponse[domain.WebhookSubscription]{Page: 1, PageSize: 10}
+	mockRepo.EXPECT().GetPaginated(mock.Anything, repository.PaginationRequest{Page: 1, PageSize: 10}).Return(expected, nil)
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, &queries.GetWebhooksQuery{Page: 1, PageSize: 10})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if response.Pagination != expected {
+		t.Errorf("Expected pagination %v, got %v", expected, response.Pagination)
+	}
+}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"arise_tech_assessment/mocks"
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+// newMockTxManager returns a TxManager that runs the unit of work immediately
+// against the given repository mocks.
+func newMockTxManager(t *testing.T, repos ...any) *mocks.MockTxManager {
+	uow := mocks.NewMockUnitOfWork(t)
+	for _, repo := range repos {
+		switch r := repo.(type) {
+		case *mocks.MockAccountRepository:
+			uow.EXPECT().Accounts().Return(r).Maybe()
+		case *mocks.MockTransactionRepository:
+			uow.EXPECT().Transactions().Return(r).Maybe()
+		case *mocks.MockLedgerRepository:
+			uow.EXPECT().Ledger().Return(r).Maybe()
+		case *mocks.MockExchangeRateRepository:
+			uow.EXPECT().ExchangeRates().Return(r).Maybe()
+		default:
+			t.Fatalf("unsupported repository mock %T", repo)
+		}
+	}
+
+	txManager := mocks.NewMockTxManager(t)
+	txManager.EXPECT().
+		WithinTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(ctx context.Context, uow repository.UnitOfWork) error) error {
+			return fn(ctx, uow)
+		}).
+		Maybe()
+
+	return txManager
+}
+
+func newTestAccount(t *testing.T, number, holderName string, balance domain.Money) *domain.Account {
+	t.Helper()
+	account, err := domain.NewAccount(number, holderName, balance)
+	if err != nil {
+		t.Fatalf("Failed to create test account: %v", err)
+	}
+	return account
+}
+
+func newTestTransfer(t *testing.T, fromAccountID, toAccountID uuid.UUID, amount domain.Money, description string) *domain.Transaction {
+	t.Helper()
+	transaction, err := domain.NewTransferTransaction(fromAccountID, toAccountID, amount, description)
+	if err != nil {
+		t.Fatalf("Failed to create test transfer: %v", err)
+	}
+	return transaction
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+)
+
+type IssueAPIKeyHandler struct {
+	apiKeyRepo repository.APIKeyRepository
+}
+
+func NewIssueAPIKeyHandler(apiKeyRepo repository.APIKeyRepository) *IssueAPIKeyHandler {
+	return &IssueAPIKeyHandler{
+		apiKeyRepo: apiKeyRepo,
+	}
+}
+
+// Handle stores the hash of a new key and returns the plain key, which is not
+// kept anywhere.
+func (h *IssueAPIKeyHandler) Handle(
+	ctx context.Context,
+	command *commands.IssueAPIKeyCommand,
+) (*commands.IssueAPIKeyResponse, error) {
+	apiKey, key, err := domain.NewAPIKey(command.Name, command.Subject, command.Role, command.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.apiKeyRepo.Create(ctx, apiKey); err != nil {
+		return nil, err
+	}
+
+	return &commands.IssueAPIKeyResponse{
+		APIKey: apiKey,
+		Key:    key,
+	}, nil
+}
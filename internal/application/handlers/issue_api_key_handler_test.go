@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/mocks"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestIssueAPIKeyHandler_Handle_ShouldStoreOnlyTheHash(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockAPIKeyRepository(t)
+	handler := NewIssueAPIKeyHandler(mockRepo)
+
+	mockRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*domain.APIKey")).Return(nil)
+
+	command := &commands.IssueAPIKeyCommand{
+		Name:    "payments-service",
+		Subject: "payments-service",
+		Role:    domain.RoleTeller,
+	}
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, command)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !strings.HasPrefix(response.Key, domain.APIKeyPrefix) || !strings.HasPrefix(response.Key, response.APIKey.Prefix) {
+		t.Errorf("Expected a key starting with %s, got %q", response.APIKey.Prefix, response.Key)
+	}
+	if response.APIKey.Hash != domain.HashAPIKey(response.Key) || strings.Contains(response.APIKey.Hash, response.Key) {
+		t.Errorf("Expected the hash of the key to be stored, got %q", response.APIKey.Hash)
+	}
+	if principal := response.APIKey.Principal(); principal.Subject != "payments-service" || !principal.HasRole(domain.RoleTeller) {
+		t.Errorf("Expected a teller principal, got %+v", principal)
+	}
+}
+
+func TestIssueAPIKeyHandler_Handle_ShouldRejectUnknownRole(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockAPIKeyRepository(t)
+	handler := NewIssueAPIKeyHandler(mockRepo)
+
+	command := &commands.IssueAPIKeyCommand{
+		Name:    "payments-service",
+		Subject: "payments-service",
+		Role:    domain.Role("root"),
+	}
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, command)
+
+	// Assert
+	if !errors.Is(err, domain.ErrValidation) {
+		t.Errorf("Expected validation error, got %v", err)
+	}
+	if response != nil {
+		t.Errorf("Expected nil response, got %v", response)
+	}
+}
@@ -4,125 +4,243 @@ import (
 	"arise_tech_assessment/internal/application/commands"
 	"arise_tech_assessment/internal/domain"
 	"arise_tech_assessment/internal/infrastructure/repository"
+	"bytes"
 	"context"
 	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
 )
 
 type ProcessTransactionHandler struct {
-	transactionRepo repository.TransactionRepository
-	accountRepo     repository.AccountRepository
+	txManager repository.TxManager
 }
 
-func NewProcessTransactionHandler(
-	transactionRepo repository.TransactionRepository,
-	accountRepo repository.AccountRepository,
-) *ProcessTransactionHandler {
+func NewProcessTransactionHandler(txManager repository.TxManager) *ProcessTransactionHandler {
 	return &ProcessTransactionHandler{
-		transactionRepo: transactionRepo,
-		accountRepo:     accountRepo,
+		txManager: txManager,
 	}
 }
 
+// processingFailure marks errors raised while applying a transaction to its
+// accounts; such transactions are recorded as failed.
+type processingFailure struct {
+	err error
+}
+
+func (f *processingFailure) Error() string {
+	return f.err.Error()
+}
+
+func (f *processingFailure) Unwrap() error {
+	return f.err
+}
+
 func (h *ProcessTransactionHandler) Handle(
 	ctx context.Context,
 	command *commands.ProcessTransactionCommand,
 ) (*commands.ProcessTransactionResponse, error) {
-	transaction, err := h.transactionRepo.GetByID(ctx, command.ID)
+	var transaction *domain.Transaction
+
+	err := repository.RetryOnConflict(ctx, repository.DefaultConflictRetries, func(ctx context.Context) error {
+		return h.txManager.WithinTransaction(ctx, func(ctx context.Context, uow repository.UnitOfWork) error {
+			var err error
+			transaction, err = h.process(ctx, uow, command)
+			return err
+		})
+	})
+
+	var failure *processingFailure
+	if errors.As(err, &failure) {
+		// Balance changes have been rolled back, record the failure separately.
+		failed := h.markFailed(ctx, command)
+		if failed == nil {
+			return nil, failure.err
+		}
+		return nil, &domain.TransactionFailure{Transaction: failed, Err: failure.err}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &commands.ProcessTransactionResponse{
+		Transaction: transaction,
+	}, nil
+}
+
+func (h *ProcessTransactionHandler) process(
+	ctx context.Context,
+	uow repository.UnitOfWork,
+	command *commands.ProcessTransactionCommand,
+) (*domain.Transaction, error) {
+	transaction, err := uow.Transactions().GetByIDForUpdate(ctx, command.ID)
 	if err != nil {
 		return nil, err
 	}
 
 	// Check if transaction is pending
 	if transaction.Status != domain.TransactionStatusPending {
-		return nil, errors.New("transaction is not in pending status")
+		return nil, domain.NewError(domain.ErrorCodeInvalidState, "transaction is not in pending status")
 	}
 
+	var from, to *domain.Account
 	switch transaction.Type {
 	case domain.TransactionTypeDeposit:
-		err = h.processDeposit(ctx, transaction)
+		to, err = h.processDeposit(ctx, uow, transaction)
 	case domain.TransactionTypeWithdraw:
-		err = h.processWithdraw(ctx, transaction)
+		from, err = h.processWithdraw(ctx, uow, transaction)
 	case domain.TransactionTypeTransfer:
-		err = h.processTransfer(ctx, transaction)
+		from, to, err = h.processTransfer(ctx, uow, transaction)
 	default:
-		return nil, errors.New("invalid transaction type")
+		return nil, domain.NewError(domain.ErrorCodeValidation, "invalid transaction type")
 	}
 
 	if err != nil {
-		transaction.Fail()
-		h.transactionRepo.Update(ctx, transaction)
+		// Lost optimistic races are retried rather than failing the transaction.
+		if errors.Is(err, repository.ErrConcurrentUpdate) {
+			return nil, err
+		}
+		return nil, &processingFailure{err: err}
+	}
+
+	// Record the balance movements in the ledger
+	entries, err := domain.NewLedgerEntries(transaction, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uow.Ledger().CreateEntries(ctx, entries); err != nil {
 		return nil, err
 	}
 
 	// Mark transaction as completed
 	transaction.Complete()
-	err = h.transactionRepo.Update(ctx, transaction)
-	if err != nil {
+	if err := uow.Transactions().Update(ctx, transaction); err != nil {
 		return nil, err
 	}
 
-	return &commands.ProcessTransactionResponse{
-		Transaction: transaction,
-	}, nil
+	return transaction, nil
+}
+
+// markFailed records the transaction as failed and returns it, or nil when it
+// could not be recorded or was no longer pending.
+func (h *ProcessTransactionHandler) markFailed(ctx context.Context, command *commands.ProcessTransactionCommand) *domain.Transaction {
+	var failed *domain.Transaction
+	err := h.txManager.WithinTransaction(ctx, func(ctx context.Context, uow repository.UnitOfWork) error {
+		transaction, err := uow.Transactions().GetByIDForUpdate(ctx, command.ID)
+		if err != nil {
+			return err
+		}
+
+		if transaction.Status != domain.TransactionStatusPending {
+			return nil
+		}
+
+		transaction.Fail()
+		if err := uow.Transactions().Update(ctx, transaction); err != nil {
+			return err
+		}
+		failed = transaction
+		return nil
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to mark transaction as failed", "transaction_id", command.ID, "error", err)
+		return nil
+	}
+	return failed
 }
 
-func (h *ProcessTransactionHandler) processDeposit(ctx context.Context, transaction *domain.Transaction) error {
-	account, err := h.accountRepo.GetByID(ctx, *transaction.ToAccountID)
+func (h *ProcessTransactionHandler) processDeposit(ctx context.Context, uow repository.UnitOfWork, transaction *domain.Transaction) (*domain.Account, error) {
+	account, err := uow.Accounts().GetByIDForUpdate(ctx, *transaction.ToAccountID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	err = account.Credit(transaction.Amount)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return h.accountRepo.Update(ctx, account)
+	return account, uow.Accounts().Update(ctx, account)
 }
 
-func (h *ProcessTransactionHandler) processWithdraw(ctx context.Context, transaction *domain.Transaction) error {
-	account, err := h.accountRepo.GetByID(ctx, *transaction.FromAccountID)
+func (h *ProcessTransactionHandler) processWithdraw(ctx context.Context, uow repository.UnitOfWork, transaction *domain.Transaction) (*domain.Account, error) {
+	account, err := uow.Accounts().GetByIDForUpdate(ctx, *transaction.FromAccountID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	err = account.Debit(transaction.Amount)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return h.accountRepo.Update(ctx, account)
+	return account, uow.Accounts().Update(ctx, account)
 }
 
-func (h *ProcessTransactionHandler) processTransfer(ctx context.Context, transaction *domain.Transaction) error {
-	// Get both accounts
-	fromAccount, err := h.accountRepo.GetByID(ctx, *transaction.FromAccountID)
+func (h *ProcessTransactionHandler) processTransfer(ctx context.Context, uow repository.UnitOfWork, transaction *domain.Transaction) (*domain.Account, *domain.Account, error) {
+	// Lock both accounts
+	fromAccount, toAccount, err := lockAccountPair(ctx, uow.Accounts(), *transaction.FromAccountID, *transaction.ToAccountID)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	toAccount, err := h.accountRepo.GetByID(ctx, *transaction.ToAccountID)
-	if err != nil {
-		return err
+	// Convert at the current rate when the accounts hold different currencies
+	if toAccount.Balance.Currency != transaction.Amount.Currency {
+		rate, err := uow.ExchangeRates().GetRate(ctx, transaction.Amount.Currency, toAccount.Balance.Currency)
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, nil, domain.Errorf(domain.ErrorCodeCurrencyMismatch, "no exchange rate to convert %s to %s", transaction.Amount.Currency, toAccount.Balance.Currency)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := transaction.ApplyExchangeRate(rate); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	// Debit from source account
 	err = fromAccount.Debit(transaction.Amount)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	// Credit to destination account
-	err = toAccount.Credit(transaction.Amount)
+	err = toAccount.Credit(transaction.CreditAmount())
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	// Update both accounts
-	err = h.accountRepo.Update(ctx, fromAccount)
+	err = uow.Accounts().Update(ctx, fromAccount)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	return h.accountRepo.Update(ctx, toAccount)
+	return fromAccount, toAccount, uow.Accounts().Update(ctx, toAccount)
+}
+
+// lockAccountPair locks both accounts in ascending ID order so that concurrent
+// transfers between the same accounts cannot deadlock each other.
+func lockAccountPair(ctx context.Context, accounts repository.AccountRepository, fromID, toID uuid.UUID) (*domain.Account, *domain.Account, error) {
+	firstID, secondID := fromID, toID
+	if bytes.Compare(firstID[:], secondID[:]) > 0 {
+		firstID, secondID = secondID, firstID
+	}
+
+	first, err := accounts.GetByIDForUpdate(ctx, firstID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	second, err := accounts.GetByIDForUpdate(ctx, secondID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if first.ID == fromID {
+		return first, second, nil
+	}
+	return second, first, nil
 }
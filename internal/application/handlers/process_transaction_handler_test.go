@@ -3,10 +3,12 @@ package handlers
 import (
 	"arise_tech_assessment/internal/application/commands"
 	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
 	"arise_tech_assessment/mocks"
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -16,22 +18,24 @@ func TestProcessTransactionHandler_Handle_ShouldSuccessfullyProcessDepositTransa
 	// Arrange
 	mockTxRepo := mocks.NewMockTransactionRepository(t)
 	mockAccRepo := mocks.NewMockAccountRepository(t)
-	handler := NewProcessTransactionHandler(mockTxRepo, mockAccRepo)
+	mockLedgerRepo := mocks.NewMockLedgerRepository(t)
+	handler := NewProcessTransactionHandler(newMockTxManager(t, mockTxRepo, mockAccRepo, mockLedgerRepo))
 
 	accountID := uuid.New()
-	account := domain.NewAccount("12345", "John Doe", domain.NewMoney(5000, domain.USD))
+	account := newTestAccount(t, "12345", "John Doe", domain.NewMoney(5000, domain.USD))
 	account.ID = accountID
 
 	txID := uuid.New()
 	transaction := domain.NewDepositTransaction(accountID, domain.NewMoney(2000, domain.USD), "Deposit")
 	transaction.ID = txID
 
-	mockTxRepo.EXPECT().GetByID(mock.Anything, txID).Return(transaction, nil)
-	mockAccRepo.EXPECT().GetByID(mock.Anything, accountID).Return(account, nil)
+	mockTxRepo.EXPECT().GetByIDForUpdate(mock.Anything, txID).Return(transaction, nil)
+	mockAccRepo.EXPECT().GetByIDForUpdate(mock.Anything, accountID).Return(account, nil)
 	mockAccRepo.EXPECT().Update(mock.Anything, mock.AnythingOfType("*domain.Account")).Return(nil)
 	mockTxRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(tx *domain.Transaction) bool {
 		return tx.ID == txID && tx.Status == domain.TransactionStatusCompleted
 	})).Return(nil)
+	mockLedgerRepo.EXPECT().CreateEntries(mock.Anything, mock.AnythingOfType("[]domain.LedgerEntry")).Return(nil)
 
 	command := &commands.ProcessTransactionCommand{
 		ID: txID,
@@ -63,22 +67,24 @@ func TestProcessTransactionHandler_Handle_ShouldSuccessfullyProcessWithdrawTrans
 	// Arrange
 	mockTxRepo := mocks.NewMockTransactionRepository(t)
 	mockAccRepo := mocks.NewMockAccountRepository(t)
-	handler := NewProcessTransactionHandler(mockTxRepo, mockAccRepo)
+	mockLedgerRepo := mocks.NewMockLedgerRepository(t)
+	handler := NewProcessTransactionHandler(newMockTxManager(t, mockTxRepo, mockAccRepo, mockLedgerRepo))
 
 	accountID := uuid.New()
-	account := domain.NewAccount("12345", "John Doe", domain.NewMoney(10000, domain.USD))
+	account := newTestAccount(t, "12345", "John Doe", domain.NewMoney(10000, domain.USD))
 	account.ID = accountID
 
 	txID := uuid.New()
 	transaction := domain.NewWithdrawTransaction(accountID, domain.NewMoney(3000, domain.USD), "Withdraw")
 	transaction.ID = txID
 
-	mockTxRepo.EXPECT().GetByID(mock.Anything, txID).Return(transaction, nil)
-	mockAccRepo.EXPECT().GetByID(mock.Anything, accountID).Return(account, nil)
+	mockTxRepo.EXPECT().GetByIDForUpdate(mock.Anything, txID).Return(transaction, nil)
+	mockAccRepo.EXPECT().GetByIDForUpdate(mock.Anything, accountID).Return(account, nil)
 	mockAccRepo.EXPECT().Update(mock.Anything, mock.AnythingOfType("*domain.Account")).Return(nil)
 	mockTxRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(tx *domain.Transaction) bool {
 		return tx.ID == txID && tx.Status == domain.TransactionStatusCompleted
 	})).Return(nil)
+	mockLedgerRepo.EXPECT().CreateEntries(mock.Anything, mock.AnythingOfType("[]domain.LedgerEntry")).Return(nil)
 
 	command := &commands.ProcessTransactionCommand{
 		ID: txID,
@@ -102,27 +108,29 @@ func TestProcessTransactionHandler_Handle_ShouldSuccessfullyProcessTransferTrans
 	// Arrange
 	mockTxRepo := mocks.NewMockTransactionRepository(t)
 	mockAccRepo := mocks.NewMockAccountRepository(t)
-	handler := NewProcessTransactionHandler(mockTxRepo, mockAccRepo)
+	mockLedgerRepo := mocks.NewMockLedgerRepository(t)
+	handler := NewProcessTransactionHandler(newMockTxManager(t, mockTxRepo, mockAccRepo, mockLedgerRepo))
 
 	fromAccountID := uuid.New()
-	fromAccount := domain.NewAccount("12345", "John Doe", domain.NewMoney(10000, domain.USD))
+	fromAccount := newTestAccount(t, "12345", "John Doe", domain.NewMoney(10000, domain.USD))
 	fromAccount.ID = fromAccountID
 
 	toAccountID := uuid.New()
-	toAccount := domain.NewAccount("67890", "Jane Smith", domain.NewMoney(5000, domain.USD))
+	toAccount := newTestAccount(t, "67890", "Jane Smith", domain.NewMoney(5000, domain.USD))
 	toAccount.ID = toAccountID
 
 	txID := uuid.New()
-	transaction := domain.NewTransferTransaction(fromAccountID, toAccountID, domain.NewMoney(2000, domain.USD), "Transfer")
+	transaction := newTestTransfer(t, fromAccountID, toAccountID, domain.NewMoney(2000, domain.USD), "Transfer")
 	transaction.ID = txID
 
-	mockTxRepo.EXPECT().GetByID(mock.Anything, txID).Return(transaction, nil)
-	mockAccRepo.EXPECT().GetByID(mock.Anything, fromAccountID).Return(fromAccount, nil)
-	mockAccRepo.EXPECT().GetByID(mock.Anything, toAccountID).Return(toAccount, nil)
+	mockTxRepo.EXPECT().GetByIDForUpdate(mock.Anything, txID).Return(transaction, nil)
+	mockAccRepo.EXPECT().GetByIDForUpdate(mock.Anything, fromAccountID).Return(fromAccount, nil)
+	mockAccRepo.EXPECT().GetByIDForUpdate(mock.Anything, toAccountID).Return(toAccount, nil)
 	mockAccRepo.EXPECT().Update(mock.Anything, mock.AnythingOfType("*domain.Account")).Return(nil).Times(2)
 	mockTxRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(tx *domain.Transaction) bool {
 		return tx.ID == txID && tx.Status == domain.TransactionStatusCompleted
 	})).Return(nil)
+	mockLedgerRepo.EXPECT().CreateEntries(mock.Anything, mock.AnythingOfType("[]domain.LedgerEntry")).Return(nil)
 
 	command := &commands.ProcessTransactionCommand{
 		ID: txID,
@@ -142,22 +150,122 @@ func TestProcessTransactionHandler_Handle_ShouldSuccessfullyProcessTransferTrans
 	}
 }
 
+func TestProcessTransactionHandler_Handle_ShouldConvertCrossCurrencyTransfer(t *testing.T) {
+	// Arrange
+	mockTxRepo := mocks.NewMockTransactionRepository(t)
+	mockAccRepo := mocks.NewMockAccountRepository(t)
+	mockLedgerRepo := mocks.NewMockLedgerRepository(t)
+	mockRateRepo := mocks.NewMockExchangeRateRepository(t)
+	handler := NewProcessTransactionHandler(newMockTxManager(t, mockTxRepo, mockAccRepo, mockLedgerRepo, mockRateRepo))
+
+	fromAccount := newTestAccount(t, "12345", "John Doe", domain.NewMoney(10000, domain.USD))
+	toAccount := newTestAccount(t, "67890", "Jane Smith", domain.NewMoney(5000, domain.THB))
+
+	txID := uuid.New()
+	transaction := newTestTransfer(t, fromAccount.ID, toAccount.ID, domain.NewMoney(2000, domain.USD), "FX transfer")
+	transaction.ID = txID
+
+	rate, _ := domain.NewExchangeRate(domain.USD, domain.THB, domain.MustParseRate("35.5"), time.Now())
+
+	mockTxRepo.EXPECT().GetByIDForUpdate(mock.Anything, txID).Return(transaction, nil)
+	mockAccRepo.EXPECT().GetByIDForUpdate(mock.Anything, fromAccount.ID).Return(fromAccount, nil)
+	mockAccRepo.EXPECT().GetByIDForUpdate(mock.Anything, toAccount.ID).Return(toAccount, nil)
+	mockRateRepo.EXPECT().GetRate(mock.Anything, domain.USD, domain.THB).Return(rate, nil)
+	mockAccRepo.EXPECT().Update(mock.Anything, mock.AnythingOfType("*domain.Account")).Return(nil).Times(2)
+	mockLedgerRepo.EXPECT().CreateEntries(mock.Anything, mock.MatchedBy(func(entries []domain.LedgerEntry) bool {
+		return len(entries) == 4
+	})).Return(nil)
+	mockTxRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(tx *domain.Transaction) bool {
+		return tx.ID == txID && tx.Status == domain.TransactionStatusCompleted
+	})).Return(nil)
+
+	command := &commands.ProcessTransactionCommand{
+		ID: txID,
+	}
+	ctx := context.Background()
+
+	// Act
+	response, err := handler.Handle(ctx, command)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if fromAccount.Balance != domain.NewMoney(8000, domain.USD) {
+		t.Errorf("Expected source balance 80.00 USD, got %s", fromAccount.Balance)
+	}
+
+	if toAccount.Balance != domain.NewMoney(76000, domain.THB) {
+		t.Errorf("Expected destination balance 760.00 THB, got %s", toAccount.Balance)
+	}
+
+	if response.Transaction.DestinationAmount != domain.NewMoney(71000, domain.THB) {
+		t.Errorf("Expected destination amount 710.00 THB, got %s", response.Transaction.DestinationAmount)
+	}
+
+	if response.Transaction.ExchangeRate == nil || response.Transaction.ExchangeRate.String() != "35.5" {
+		t.Errorf("Expected applied rate 35.5, got %v", response.Transaction.ExchangeRate)
+	}
+}
+
+func TestProcessTransactionHandler_Handle_ShouldFailCrossCurrencyTransferWithoutRate(t *testing.T) {
+	// Arrange
+	mockTxRepo := mocks.NewMockTransactionRepository(t)
+	mockAccRepo := mocks.NewMockAccountRepository(t)
+	mockRateRepo := mocks.NewMockExchangeRateRepository(t)
+	handler := NewProcessTransactionHandler(newMockTxManager(t, mockTxRepo, mockAccRepo, mockRateRepo))
+
+	fromAccount := newTestAccount(t, "12345", "John Doe", domain.NewMoney(10000, domain.USD))
+	toAccount := newTestAccount(t, "67890", "Jane Smith", domain.NewMoney(5000, domain.THB))
+
+	txID := uuid.New()
+	transaction := newTestTransfer(t, fromAccount.ID, toAccount.ID, domain.NewMoney(2000, domain.USD), "FX transfer")
+	transaction.ID = txID
+
+	mockTxRepo.EXPECT().GetByIDForUpdate(mock.Anything, txID).Return(transaction, nil)
+	mockAccRepo.EXPECT().GetByIDForUpdate(mock.Anything, fromAccount.ID).Return(fromAccount, nil)
+	mockAccRepo.EXPECT().GetByIDForUpdate(mock.Anything, toAccount.ID).Return(toAccount, nil)
+	mockRateRepo.EXPECT().GetRate(mock.Anything, domain.USD, domain.THB).
+		Return(nil, domain.Errorf(domain.ErrorCodeNotFound, "no exchange rate for USD/THB"))
+	mockTxRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(tx *domain.Transaction) bool {
+		return tx.ID == txID && tx.Status == domain.TransactionStatusFailed
+	})).Return(nil)
+
+	command := &commands.ProcessTransactionCommand{
+		ID: txID,
+	}
+	ctx := context.Background()
+
+	// Act
+	response, err := handler.Handle(ctx, command)
+
+	// Assert
+	if !errors.Is(err, domain.ErrCurrencyMismatch) {
+		t.Errorf("Expected currency mismatch error, got %v", err)
+	}
+
+	if response != nil {
+		t.Error("Expected nil response on error, got response")
+	}
+}
+
 func TestProcessTransactionHandler_Handle_ShouldReturnErrorForInsufficientFunds(t *testing.T) {
 	// Arrange
 	mockTxRepo := mocks.NewMockTransactionRepository(t)
 	mockAccRepo := mocks.NewMockAccountRepository(t)
-	handler := NewProcessTransactionHandler(mockTxRepo, mockAccRepo)
+	handler := NewProcessTransactionHandler(newMockTxManager(t, mockTxRepo, mockAccRepo))
 
 	accountID := uuid.New()
-	account := domain.NewAccount("12345", "John Doe", domain.NewMoney(1000, domain.USD))
+	account := newTestAccount(t, "12345", "John Doe", domain.NewMoney(1000, domain.USD))
 	account.ID = accountID
 
 	txID := uuid.New()
 	transaction := domain.NewWithdrawTransaction(accountID, domain.NewMoney(2000, domain.USD), "Withdraw")
 	transaction.ID = txID
 
-	mockTxRepo.EXPECT().GetByID(mock.Anything, txID).Return(transaction, nil)
-	mockAccRepo.EXPECT().GetByID(mock.Anything, accountID).Return(account, nil)
+	mockTxRepo.EXPECT().GetByIDForUpdate(mock.Anything, txID).Return(transaction, nil)
+	mockAccRepo.EXPECT().GetByIDForUpdate(mock.Anything, accountID).Return(account, nil)
 	mockTxRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(tx *domain.Transaction) bool {
 		return tx.ID == txID && tx.Status == domain.TransactionStatusFailed
 	})).Return(nil)
@@ -182,16 +290,28 @@ func TestProcessTransactionHandler_Handle_ShouldReturnErrorForInsufficientFunds(
 	if err.Error() != "insufficient funds" {
 		t.Errorf("Expected 'insufficient funds' error, got %s", err.Error())
 	}
+
+	if !errors.Is(err, domain.ErrInsufficientFunds) {
+		t.Errorf("Expected ErrInsufficientFunds, got %v", err)
+	}
+
+	var failure *domain.TransactionFailure
+	if !errors.As(err, &failure) {
+		t.Fatalf("Expected TransactionFailure, got %T", err)
+	}
+	if failure.Transaction.ID != txID || failure.Transaction.Status != domain.TransactionStatusFailed {
+		t.Errorf("Expected the failed transaction in the error, got %+v", failure.Transaction)
+	}
 }
 
 func TestProcessTransactionHandler_Handle_ShouldReturnErrorWhenTransactionNotFound(t *testing.T) {
 	// Arrange
 	mockTxRepo := mocks.NewMockTransactionRepository(t)
 	mockAccRepo := mocks.NewMockAccountRepository(t)
-	handler := NewProcessTransactionHandler(mockTxRepo, mockAccRepo)
+	handler := NewProcessTransactionHandler(newMockTxManager(t, mockTxRepo, mockAccRepo))
 
 	nonExistentID := uuid.New()
-	mockTxRepo.EXPECT().GetByID(mock.Anything, nonExistentID).Return(nil, errors.New("transaction not found"))
+	mockTxRepo.EXPECT().GetByIDForUpdate(mock.Anything, nonExistentID).Return(nil, errors.New("transaction not found"))
 
 	command := &commands.ProcessTransactionCommand{
 		ID: nonExistentID,
@@ -215,14 +335,14 @@ func TestProcessTransactionHandler_Handle_ShouldReturnErrorWhenTransactionIsNotP
 	// Arrange
 	mockTxRepo := mocks.NewMockTransactionRepository(t)
 	mockAccRepo := mocks.NewMockAccountRepository(t)
-	handler := NewProcessTransactionHandler(mockTxRepo, mockAccRepo)
+	handler := NewProcessTransactionHandler(newMockTxManager(t, mockTxRepo, mockAccRepo))
 
 	txID := uuid.New()
 	transaction := domain.NewTransaction(domain.TransactionTypeDeposit, domain.NewMoney(2000, domain.USD), "Deposit")
 	transaction.ID = txID
 	transaction.Complete()
 
-	mockTxRepo.EXPECT().GetByID(mock.Anything, txID).Return(transaction, nil)
+	mockTxRepo.EXPECT().GetByIDForUpdate(mock.Anything, txID).Return(transaction, nil)
 
 	command := &commands.ProcessTransactionCommand{
 		ID: txID,
@@ -246,19 +366,19 @@ func TestProcessTransactionHandler_Handle_ShouldReturnErrorForInactiveAccount(t
 	// Arrange
 	mockTxRepo := mocks.NewMockTransactionRepository(t)
 	mockAccRepo := mocks.NewMockAccountRepository(t)
-	handler := NewProcessTransactionHandler(mockTxRepo, mockAccRepo)
+	handler := NewProcessTransactionHandler(newMockTxManager(t, mockTxRepo, mockAccRepo))
 
 	accountID := uuid.New()
-	account := domain.NewAccount("12345", "John Doe", domain.NewMoney(5000, domain.USD))
+	account := newTestAccount(t, "12345", "John Doe", domain.NewMoney(5000, domain.USD))
 	account.ID = accountID
-	account.Block()
+	_ = account.Block(domain.StatusChange{Reason: domain.StatusReasonFraudSuspected, ChangedBy: "test"})
 
 	txID := uuid.New()
 	transaction := domain.NewDepositTransaction(accountID, domain.NewMoney(2000, domain.USD), "Deposit")
 	transaction.ID = txID
 
-	mockTxRepo.EXPECT().GetByID(mock.Anything, txID).Return(transaction, nil)
-	mockAccRepo.EXPECT().GetByID(mock.Anything, accountID).Return(account, nil)
+	mockTxRepo.EXPECT().GetByIDForUpdate(mock.Anything, txID).Return(transaction, nil)
+	mockAccRepo.EXPECT().GetByIDForUpdate(mock.Anything, accountID).Return(account, nil)
 	mockTxRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(tx *domain.Transaction) bool {
 		return tx.ID == txID && tx.Status == domain.TransactionStatusFailed
 	})).Return(nil)
@@ -283,4 +403,173 @@ func TestProcessTransactionHandler_Handle_ShouldReturnErrorForInactiveAccount(t
 	if err.Error() != "account is not active" {
 		t.Errorf("Expected 'account is not active' error, got %s", err.Error())
 	}
+
+	if !errors.Is(err, domain.ErrAccountInactive) {
+		t.Errorf("Expected ErrAccountInactive, got %v", err)
+	}
+}
+
+func TestProcessTransactionHandler_Handle_ShouldNotPersistDebitWhenTransferCreditFails(t *testing.T) {
+	// Arrange
+	mockTxRepo := mocks.NewMockTransactionRepository(t)
+	mockAccRepo := mocks.NewMockAccountRepository(t)
+	handler := NewProcessTransactionHandler(newMockTxManager(t, mockTxRepo, mockAccRepo))
+
+	fromAccountID := uuid.New()
+	fromAccount := newTestAccount(t, "12345", "John Doe", domain.NewMoney(10000, domain.USD))
+	fromAccount.ID = fromAccountID
+
+	toAccountID := uuid.New()
+	toAccount := newTestAccount(t, "67890", "Jane Smith", domain.NewMoney(5000, domain.USD))
+	toAccount.ID = toAccountID
+	_ = toAccount.Block(domain.StatusChange{Reason: domain.StatusReasonFraudSuspected, ChangedBy: "test"})
+
+	txID := uuid.New()
+	transaction := newTestTransfer(t, fromAccountID, toAccountID, domain.NewMoney(2000, domain.USD), "Transfer")
+	transaction.ID = txID
+
+	mockTxRepo.EXPECT().GetByIDForUpdate(mock.Anything, txID).Return(transaction, nil)
+	mockAccRepo.EXPECT().GetByIDForUpdate(mock.Anything, fromAccountID).Return(fromAccount, nil)
+	mockAccRepo.EXPECT().GetByIDForUpdate(mock.Anything, toAccountID).Return(toAccount, nil)
+	mockTxRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(tx *domain.Transaction) bool {
+		return tx.ID == txID && tx.Status == domain.TransactionStatusFailed
+	})).Return(nil)
+
+	command := &commands.ProcessTransactionCommand{
+		ID: txID,
+	}
+	ctx := context.Background()
+
+	// Act
+	response, err := handler.Handle(ctx, command)
+
+	// Assert
+	if err == nil {
+		t.Error("Expected error for blocked destination account, got nil")
+	}
+
+	if response != nil {
+		t.Error("Expected nil response on error, got response")
+	}
+
+	mockAccRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestProcessTransactionHandler_Handle_ShouldReturnErrorWhenUnitOfWorkFails(t *testing.T) {
+	// Arrange
+	txManager := mocks.NewMockTxManager(t)
+	handler := NewProcessTransactionHandler(txManager)
+
+	txManager.EXPECT().WithinTransaction(mock.Anything, mock.Anything).Return(errors.New("commit failed"))
+
+	command := &commands.ProcessTransactionCommand{
+		ID: uuid.New(),
+	}
+	ctx := context.Background()
+
+	// Act
+	response, err := handler.Handle(ctx, command)
+
+	// Assert
+	if err == nil {
+		t.Error("Expected error when commit fails, got nil")
+	}
+
+	if response != nil {
+		t.Error("Expected nil response on error, got response")
+	}
+}
+
+func TestProcessTransactionHandler_Handle_ShouldLockTransferAccountsInIDOrder(t *testing.T) {
+	// Arrange
+	mockTxRepo := mocks.NewMockTransactionRepository(t)
+	mockAccRepo := mocks.NewMockAccountRepository(t)
+	mockLedgerRepo := mocks.NewMockLedgerRepository(t)
+	handler := NewProcessTransactionHandler(newMockTxManager(t, mockTxRepo, mockAccRepo, mockLedgerRepo))
+
+	lowerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	higherID := uuid.MustParse("ffffffff-0000-0000-0000-000000000001")
+
+	fromAccount := newTestAccount(t, "12345", "John Doe", domain.NewMoney(10000, domain.USD))
+	fromAccount.ID = higherID
+
+	toAccount := newTestAccount(t, "67890", "Jane Smith", domain.NewMoney(5000, domain.USD))
+	toAccount.ID = lowerID
+
+	txID := uuid.New()
+	transaction := newTestTransfer(t, higherID, lowerID, domain.NewMoney(2000, domain.USD), "Transfer")
+	transaction.ID = txID
+
+	mockTxRepo.EXPECT().GetByIDForUpdate(mock.Anything, txID).Return(transaction, nil)
+	lockLower := mockAccRepo.EXPECT().GetByIDForUpdate(mock.Anything, lowerID).Return(toAccount, nil).Call
+	mockAccRepo.EXPECT().GetByIDForUpdate(mock.Anything, higherID).Return(fromAccount, nil).NotBefore(lockLower)
+	mockAccRepo.EXPECT().Update(mock.Anything, mock.AnythingOfType("*domain.Account")).Return(nil).Times(2)
+	mockTxRepo.EXPECT().Update(mock.Anything, mock.AnythingOfType("*domain.Transaction")).Return(nil)
+	mockLedgerRepo.EXPECT().CreateEntries(mock.Anything, mock.AnythingOfType("[]domain.LedgerEntry")).Return(nil)
+
+	command := &commands.ProcessTransactionCommand{
+		ID: txID,
+	}
+	ctx := context.Background()
+
+	// Act
+	_, err := handler.Handle(ctx, command)
+
+	// Assert
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	if fromAccount.Balance.Amount != 8000 {
+		t.Errorf("Expected source balance 8000, got %d", fromAccount.Balance.Amount)
+	}
+
+	if toAccount.Balance.Amount != 7000 {
+		t.Errorf("Expected destination balance 7000, got %d", toAccount.Balance.Amount)
+	}
+}
+
+func TestProcessTransactionHandler_Handle_ShouldRetryOnConcurrentUpdate(t *testing.T) {
+	// Arrange
+	mockTxRepo := mocks.NewMockTransactionRepository(t)
+	mockAccRepo := mocks.NewMockAccountRepository(t)
+	mockLedgerRepo := mocks.NewMockLedgerRepository(t)
+	handler := NewProcessTransactionHandler(newMockTxManager(t, mockTxRepo, mockAccRepo, mockLedgerRepo))
+
+	accountID := uuid.New()
+	txID := uuid.New()
+
+	mockTxRepo.EXPECT().GetByIDForUpdate(mock.Anything, txID).RunAndReturn(func(ctx context.Context, id uuid.UUID) (*domain.Transaction, error) {
+		transaction := domain.NewDepositTransaction(accountID, domain.NewMoney(2000, domain.USD), "Deposit")
+		transaction.ID = id
+		return transaction, nil
+	})
+	mockAccRepo.EXPECT().GetByIDForUpdate(mock.Anything, accountID).RunAndReturn(func(ctx context.Context, id uuid.UUID) (*domain.Account, error) {
+		account := newTestAccount(t, "12345", "John Doe", domain.NewMoney(5000, domain.USD))
+		account.ID = id
+		return account, nil
+	})
+	mockAccRepo.EXPECT().Update(mock.Anything, mock.AnythingOfType("*domain.Account")).Return(repository.ErrConcurrentUpdate).Once()
+	mockAccRepo.EXPECT().Update(mock.Anything, mock.AnythingOfType("*domain.Account")).Return(nil).Once()
+	mockTxRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(tx *domain.Transaction) bool {
+		return tx.ID == txID && tx.Status == domain.TransactionStatusCompleted
+	})).Return(nil).Once()
+	mockLedgerRepo.EXPECT().CreateEntries(mock.Anything, mock.AnythingOfType("[]domain.LedgerEntry")).Return(nil)
+
+	command := &commands.ProcessTransactionCommand{
+		ID: txID,
+	}
+	ctx := context.Background()
+
+	// Act
+	response, err := handler.Handle(ctx, command)
+
+	// Assert
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	if response == nil || response.Transaction.Status != domain.TransactionStatusCompleted {
+		t.Error("Expected completed transaction after retry")
+	}
 }
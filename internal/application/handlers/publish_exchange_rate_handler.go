@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+	"time"
+)
+
+type PublishExchangeRateHandler struct {
+	exchangeRateRepo repository.ExchangeRateRepository
+}
+
+func NewPublishExchangeRateHandler(exchangeRateRepo repository.ExchangeRateRepository) *PublishExchangeRateHandler {
+	return &PublishExchangeRateHandler{
+		exchangeRateRepo: exchangeRateRepo,
+	}
+}
+
+func (h *PublishExchangeRateHandler) Handle(
+	ctx context.Context,
+	command *commands.PublishExchangeRateCommand,
+) (*commands.PublishExchangeRateResponse, error) {
+	rate, err := domain.ParseRate(command.Rate)
+	if err != nil {
+		return nil, err
+	}
+
+	effectiveAt := time.Now()
+	if command.EffectiveAt != nil {
+		effectiveAt = *command.EffectiveAt
+	}
+
+	exchangeRate, err := domain.NewExchangeRate(command.BaseCurrency, command.QuoteCurrency, rate, effectiveAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.exchangeRateRepo.Create(ctx, exchangeRate); err != nil {
+		return nil, err
+	}
+
+	return &commands.PublishExchangeRateResponse{
+		ExchangeRate: exchangeRate,
+	}, nil
+}
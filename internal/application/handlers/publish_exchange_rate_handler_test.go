@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/mocks"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPublishExchangeRateHandler_Handle_ShouldStoreRate(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockExchangeRateRepository(t)
+	handler := NewPublishExchangeRateHandler(mockRepo)
+
+	effectiveAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	command := &commands.PublishExchangeRateCommand{
+		BaseCurrency:  domain.USD,
+		QuoteCurrency: domain.THB,
+		Rate:          "35.5",
+		EffectiveAt:   &effectiveAt,
+	}
+
+	mockRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(rate *domain.ExchangeRate) bool {
+		return rate.BaseCurrency == domain.USD &&
+			rate.QuoteCurrency == domain.THB &&
+			rate.Rate.String() == "35.5" &&
+			rate.EffectiveAt.Equal(effectiveAt)
+	})).Return(nil)
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, command)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if response.ExchangeRate == nil {
+		t.Fatal("Expected exchange rate in response, got nil")
+	}
+}
+
+func TestPublishExchangeRateHandler_Handle_ShouldRejectInvalidRate(t *testing.T) {
+	tests := []struct {
+		name    string
+		command *commands.PublishExchangeRateCommand
+	}{
+		{"non-numeric rate", &commands.PublishExchangeRateCommand{BaseCurrency: domain.USD, QuoteCurrency: domain.THB, Rate: "abc"}},
+		{"negative rate", &commands.PublishExchangeRateCommand{BaseCurrency: domain.USD, QuoteCurrency: domain.THB, Rate: "-1"}},
+		{"same currency", &commands.PublishExchangeRateCommand{BaseCurrency: domain.USD, QuoteCurrency: domain.USD, Rate: "1"}},
+		{"unsupported currency", &commands.PublishExchangeRateCommand{BaseCurrency: "XYZ", QuoteCurrency: domain.THB, Rate: "1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockRepo := mocks.NewMockExchangeRateRepository(t)
+			handler := NewPublishExchangeRateHandler(mockRepo)
+
+			// Act
+			response, err := handler.Handle(context.Background(), tt.command)
+
+			// Assert
+			if !errors.Is(err, domain.ErrValidation) {
+				t.Errorf("Expected validation error, got %v", err)
+			}
+			if response != nil {
+				t.Error("Expected nil response on error, got response")
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+	"time"
+)
+
+type ReplayWebhookDeliveryHandler struct {
+	deliveryRepo repository.WebhookDeliveryRepository
+}
+
+func NewReplayWebhookDeliveryHandler(deliveryRepo repository.WebhookDeliveryRepository) *ReplayWebhookDeliveryHandler {
+	return &ReplayWebhookDeliveryHandler{
+		deliveryRepo: deliveryRepo,
+	}
+}
+
+// Handle schedules the delivery to be sent again with its original payload.
+func (h *ReplayWebhookDeliveryHandler) Handle(
+	ctx context.Context,
+	command *commands.ReplayWebhookDeliveryCommand,
+) (*commands.ReplayWebhookDeliveryResponse, error) {
+	delivery, err := h.deliveryRepo.GetByIDForUpdate(ctx, command.DeliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if delivery.SubscriptionID != command.WebhookID {
+		return nil, domain.NewError(domain.ErrorCodeNotFound, "webhook delivery not found")
+	}
+
+	delivery.Replay(time.Now())
+	if err := h.deliveryRepo.Update(ctx, delivery); err != nil {
+		return nil, err
+	}
+
+	return &commands.ReplayWebhookDeliveryResponse{
+		Delivery: delivery,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/mocks"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestWebhookDelivery(t *testing.T, subscriptionID uuid.UUID) *domain.WebhookDelivery {
+	t.Helper()
+	event := domain.OutboxEvent{ID: uuid.New(), EventType: "account.blocked", Payload: []byte(`{}`), OccurredAt: time.Now()}
+	delivery, err := domain.NewWebhookDelivery(subscriptionID, event)
+	if err != nil {
+		t.Fatalf("Failed to create test delivery: %v", err)
+	}
+	return delivery
+}
+
+func TestReplayWebhookDeliveryHandler_Handle_ShouldRescheduleFailedDelivery(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockWebhookDeliveryRepository(t)
+	handler := NewReplayWebhookDeliveryHandler(mockRepo)
+
+	webhookID := uuid.New()
+	delivery := newTestWebhookDelivery(t, webhookID)
+	delivery.MarkFailed(500, errors.New("receiver answered 500 Internal Server Error"), time.Now().Add(time.Hour), 1)
+
+	mockRepo.EXPECT().GetByIDForUpdate(mock.Anything, delivery.ID).Return(delivery, nil)
+	mockRepo.EXPECT().Update(mock.Anything, delivery).Return(nil)
+
+	command := &commands.ReplayWebhookDeliveryCommand{WebhookID: webhookID, DeliveryID: delivery.ID}
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, command)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	replayed := response.Delivery
+	if replayed.Status != domain.WebhookDeliveryStatusPending || replayed.Attempts != 0 || replayed.LastError != "" {
+		t.Errorf("Expected a fresh pending delivery, got %+v", replayed)
+	}
+	if replayed.NextAttemptAt.After(time.Now()) {
+		t.Errorf("Expected the delivery to be due now, got %s", replayed.NextAttemptAt)
+	}
+}
+
+func TestReplayWebhookDeliveryHandler_Handle_ShouldRejectDeliveryOfAnotherWebhook(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockWebhookDeliveryRepository(t)
+	handler := NewReplayWebhookDeliveryHandler(mockRepo)
+
+	delivery := newTestWebhookDelivery(t, uuid.New())
+	mockRepo.EXPECT().GetByIDForUpdate(mock.Anything, delivery.ID).Return(delivery, nil)
+
+	command := &commands.ReplayWebhookDeliveryCommand{WebhookID: uuid.New(), DeliveryID: delivery.ID}
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, command)
+
+	// Assert
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+	if response != nil {
+		t.Errorf("Expected nil response, got %v", response)
+	}
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+)
+
+type RestoreAccountHandler struct {
+	txManager repository.TxManager
+}
+
+func NewRestoreAccountHandler(txManager repository.TxManager) *RestoreAccountHandler {
+	return &RestoreAccountHandler{
+		txManager: txManager,
+	}
+}
+
+func (h *RestoreAccountHandler) Handle(
+	ctx context.Context,
+	command *commands.RestoreAccountCommand,
+) (*commands.RestoreAccountResponse, error) {
+	var account *domain.Account
+
+	err := h.txManager.WithinTransaction(ctx, func(ctx context.Context, uow repository.UnitOfWork) error {
+		if err := uow.Accounts().Restore(ctx, command.ID); err != nil {
+			return err
+		}
+
+		var err error
+		account, err = uow.Accounts().GetByID(ctx, command.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &commands.RestoreAccountResponse{
+		Account: account,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/mocks"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRestoreAccountHandler_Handle_ShouldRestoreAccount(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockAccountRepository(t)
+	handler := NewRestoreAccountHandler(newMockTxManager(t, mockRepo))
+
+	account := newTestAccount(t, "12345", "John Doe", domain.NewMoney(0, domain.USD))
+
+	mockRepo.EXPECT().Restore(mock.Anything, account.ID).Return(nil)
+	mockRepo.EXPECT().GetByID(mock.Anything, account.ID).Return(account, nil)
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, &commands.RestoreAccountCommand{ID: account.ID})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if response.Account != account {
+		t.Errorf("Expected restored account %v, got %v", account, response.Account)
+	}
+}
+
+func TestRestoreAccountHandler_Handle_ShouldReturnErrorWhenNothingToRestore(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockAccountRepository(t)
+	handler := NewRestoreAccountHandler(newMockTxManager(t, mockRepo))
+
+	id := uuid.New()
+	mockRepo.EXPECT().Restore(mock.Anything, id).Return(domain.Errorf(domain.ErrorCodeNotFound, "deleted account not found"))
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, &commands.RestoreAccountCommand{ID: id})
+
+	// Assert
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+	if response != nil {
+		t.Error("Expected nil response on error, got response")
+	}
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+	"time"
+)
+
+type RevokeAPIKeyHandler struct {
+	apiKeyRepo repository.APIKeyRepository
+}
+
+func NewRevokeAPIKeyHandler(apiKeyRepo repository.APIKeyRepository) *RevokeAPIKeyHandler {
+	return &RevokeAPIKeyHandler{
+		apiKeyRepo: apiKeyRepo,
+	}
+}
+
+func (h *RevokeAPIKeyHandler) Handle(
+	ctx context.Context,
+	command *commands.RevokeAPIKeyCommand,
+) (*commands.RevokeAPIKeyResponse, error) {
+	apiKey, err := h.apiKeyRepo.GetByIDForUpdate(ctx, command.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := apiKey.Revoke(time.Now()); err != nil {
+		return nil, err
+	}
+	if err := h.apiKeyRepo.Update(ctx, apiKey); err != nil {
+		return nil, err
+	}
+
+	return &commands.RevokeAPIKeyResponse{
+		APIKey: apiKey,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/mocks"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRevokeAPIKeyHandler_Handle_ShouldRevokeKey(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockAPIKeyRepository(t)
+	handler := NewRevokeAPIKeyHandler(mockRepo)
+
+	apiKey, _, _ := domain.NewAPIKey("payments-service", "payments-service", domain.RoleTeller, nil)
+	mockRepo.EXPECT().GetByIDForUpdate(mock.Anything, apiKey.ID).Return(apiKey, nil)
+	mockRepo.EXPECT().Update(mock.Anything, apiKey).Return(nil)
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, &commands.RevokeAPIKeyCommand{ID: apiKey.ID})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if response.APIKey.RevokedAt == nil || response.APIKey.IsActive(time.Now()) {
+		t.Errorf("Expected the key to be revoked, got %+v", response.APIKey)
+	}
+}
+
+func TestRevokeAPIKeyHandler_Handle_ShouldRejectRevokedKey(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockAPIKeyRepository(t)
+	handler := NewRevokeAPIKeyHandler(mockRepo)
+
+	apiKey, _, _ := domain.NewAPIKey("payments-service", "payments-service", domain.RoleTeller, nil)
+	_ = apiKey.Revoke(time.Now())
+	mockRepo.EXPECT().GetByIDForUpdate(mock.Anything, apiKey.ID).Return(apiKey, nil)
+
+	// Act
+	ctx := context.Background()
+	response, err := handler.Handle(ctx, &commands.RevokeAPIKeyCommand{ID: apiKey.ID})
+
+	// Assert
+	if !errors.Is(err, domain.ErrInvalidState) {
+		t.Errorf("Expected invalid state error, got %v", err)
+	}
+	if response != nil {
+		t.Errorf("Expected nil response, got %v", response)
+	}
+}
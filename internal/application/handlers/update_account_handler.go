@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/domain"
 	"arise_tech_assessment/internal/infrastructure/repository"
 	"context"
 )
@@ -20,16 +21,24 @@ func (h *UpdateAccountHandler) Handle(
 	ctx context.Context,
 	command *commands.UpdateAccountCommand,
 ) (*commands.UpdateAccountResponse, error) {
-	account, err := h.accountRepo.GetByID(ctx, command.ID)
-	if err != nil {
-		return nil, err
-	}
+	var account *domain.Account
 
-	if command.HolderName != "" {
-		account.HolderName = command.HolderName
-	}
+	err := repository.RetryOnConflict(ctx, repository.DefaultConflictRetries, func(ctx context.Context) error {
+		var err error
+		account, err = h.accountRepo.GetByID(ctx, command.ID)
+		if err != nil {
+			return err
+		}
+
+		if command.HolderName != "" {
+			account.HolderName = command.HolderName
+		}
+		if command.OwnerID != "" {
+			account.SetOwner(command.OwnerID)
+		}
 
-	err = h.accountRepo.Update(ctx, account)
+		return h.accountRepo.Update(ctx, account)
+	})
 	if err != nil {
 		return nil, err
 	}
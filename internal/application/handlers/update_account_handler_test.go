@@ -3,6 +3,7 @@ package handlers
 import (
 	"arise_tech_assessment/internal/application/commands"
 	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
 	"arise_tech_assessment/mocks"
 	"context"
 	"errors"
@@ -18,7 +19,7 @@ func TestUpdateAccountHandler_Handle_ShouldSuccessfullyUpdateAccountHolderName(t
 	handler := NewUpdateAccountHandler(mockRepo)
 
 	accountID := uuid.New()
-	existingAccount := domain.NewAccount("12345", "John Doe", domain.NewMoney(10000, domain.USD))
+	existingAccount := newTestAccount(t, "12345", "John Doe", domain.NewMoney(10000, domain.USD))
 	existingAccount.ID = accountID
 
 	command := &commands.UpdateAccountCommand{
@@ -63,7 +64,7 @@ func TestUpdateAccountHandler_Handle_ShouldNotUpdateHolderNameIfEmpty(t *testing
 
 	accountID := uuid.New()
 	originalHolderName := "John Doe"
-	existingAccount := domain.NewAccount("12345", originalHolderName, domain.NewMoney(10000, domain.USD))
+	existingAccount := newTestAccount(t, "12345", originalHolderName, domain.NewMoney(10000, domain.USD))
 	existingAccount.ID = accountID
 
 	command := &commands.UpdateAccountCommand{
@@ -133,7 +134,7 @@ func TestUpdateAccountHandler_Handle_ShouldReturnErrorWhenUpdateFails(t *testing
 	handler := NewUpdateAccountHandler(mockRepo)
 
 	accountID := uuid.New()
-	existingAccount := domain.NewAccount("12345", "John Doe", domain.NewMoney(10000, domain.USD))
+	existingAccount := newTestAccount(t, "12345", "John Doe", domain.NewMoney(10000, domain.USD))
 	existingAccount.ID = accountID
 
 	command := &commands.UpdateAccountCommand{
@@ -172,9 +173,9 @@ func TestUpdateAccountHandler_Handle_ShouldPreserveOtherFieldsWhenUpdatingHolder
 	accountID := uuid.New()
 	originalBalance := domain.NewMoney(15000, domain.THB)
 	originalNumber := "98765"
-	existingAccount := domain.NewAccount(originalNumber, "Jane Doe", originalBalance)
+	existingAccount := newTestAccount(t, originalNumber, "Jane Doe", originalBalance)
 	existingAccount.ID = accountID
-	existingAccount.Block() // Change status
+	_ = existingAccount.Block(domain.StatusChange{Reason: domain.StatusReasonFraudSuspected, ChangedBy: "test"}) // Change status
 
 	command := &commands.UpdateAccountCommand{
 		ID:         accountID,
@@ -219,3 +220,70 @@ func TestUpdateAccountHandler_Handle_ShouldPreserveOtherFieldsWhenUpdatingHolder
 		t.Errorf("Expected status %s to be preserved, got %s", domain.AccountStatusBlocked, account.Status)
 	}
 }
+
+func TestUpdateAccountHandler_Handle_ShouldRetryWhenAccountWasModifiedConcurrently(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockAccountRepository(t)
+	handler := NewUpdateAccountHandler(mockRepo)
+
+	accountID := uuid.New()
+
+	command := &commands.UpdateAccountCommand{
+		ID:         accountID,
+		HolderName: "John Smith",
+	}
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetByID(mock.Anything, accountID).RunAndReturn(func(ctx context.Context, id uuid.UUID) (*domain.Account, error) {
+		account := newTestAccount(t, "12345", "John Doe", domain.NewMoney(10000, domain.USD))
+		account.ID = id
+		return account, nil
+	}).Times(2)
+	mockRepo.EXPECT().Update(mock.Anything, mock.AnythingOfType("*domain.Account")).Return(repository.ErrConcurrentUpdate).Once()
+	mockRepo.EXPECT().Update(mock.Anything, mock.AnythingOfType("*domain.Account")).Return(nil).Once()
+
+	// Act
+	response, err := handler.Handle(ctx, command)
+
+	// Assert
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	if response == nil || response.Account.HolderName != command.HolderName {
+		t.Error("Expected updated account after retry")
+	}
+}
+
+func TestUpdateAccountHandler_Handle_ShouldReturnConflictAfterRetriesAreExhausted(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockAccountRepository(t)
+	handler := NewUpdateAccountHandler(mockRepo)
+
+	accountID := uuid.New()
+
+	command := &commands.UpdateAccountCommand{
+		ID:         accountID,
+		HolderName: "John Smith",
+	}
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetByID(mock.Anything, accountID).RunAndReturn(func(ctx context.Context, id uuid.UUID) (*domain.Account, error) {
+		account := newTestAccount(t, "12345", "John Doe", domain.NewMoney(10000, domain.USD))
+		account.ID = id
+		return account, nil
+	}).Times(repository.DefaultConflictRetries)
+	mockRepo.EXPECT().Update(mock.Anything, mock.AnythingOfType("*domain.Account")).Return(repository.ErrConcurrentUpdate).Times(repository.DefaultConflictRetries)
+
+	// Act
+	response, err := handler.Handle(ctx, command)
+
+	// Assert
+	if !errors.Is(err, repository.ErrConcurrentUpdate) {
+		t.Errorf("Expected ErrConcurrentUpdate, got %v", err)
+	}
+
+	if response != nil {
+		t.Error("Expected nil response on error, got response")
+	}
+}
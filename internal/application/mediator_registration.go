@@ -1,24 +1,74 @@
 package application
 
 import (
+	"arise_tech_assessment/internal/application/behaviors"
 	"arise_tech_assessment/internal/application/handlers"
+	"arise_tech_assessment/internal/application/policies"
 	"arise_tech_assessment/internal/infrastructure/repository"
+	"fmt"
+	"log/slog"
 
 	"github.com/mehdihadeli/go-mediatr"
 	"gorm.io/gorm"
 )
 
+// Names of the pipeline behaviors RegisterBehaviors can install.
+const (
+	BehaviorRecovery      = "recovery"
+	BehaviorLogging       = "logging"
+	BehaviorValidation    = "validation"
+	BehaviorAuthorization = "authorization"
+	BehaviorTransaction   = "transaction"
+)
+
+// DefaultBehaviors is the recommended pipeline: recovery outermost so that it
+// also catches panics in the other behaviors, and validation and authorization
+// before the transaction so that rejected commands never open one.
+var DefaultBehaviors = []string{BehaviorRecovery, BehaviorLogging, BehaviorValidation, BehaviorAuthorization, BehaviorTransaction}
+
+// RegisterBehaviors installs the named pipeline behaviors around every
+// mediatr.Send, the first name being the outermost. Behaviors registered
+// before it, such as tracing and metrics, wrap these.
+func RegisterBehaviors(db *gorm.DB, names []string) error {
+	available := map[string]func() mediatr.PipelineBehavior{
+		BehaviorRecovery:   func() mediatr.PipelineBehavior { return behaviors.NewRecoveryBehavior(slog.Default()) },
+		BehaviorLogging:    func() mediatr.PipelineBehavior { return behaviors.NewLoggingBehavior(slog.Default()) },
+		BehaviorValidation: func() mediatr.PipelineBehavior { return behaviors.NewValidationBehavior() },
+		BehaviorAuthorization: func() mediatr.PipelineBehavior {
+			return behaviors.NewAuthorizationBehavior(policies.New(repository.NewAccountRepository(db), repository.NewTransactionRepository(db)))
+		},
+		BehaviorTransaction: func() mediatr.PipelineBehavior { return behaviors.NewTransactionBehavior(repository.NewTxManager(db)) },
+	}
+
+	pipeline := make([]mediatr.PipelineBehavior, 0, len(names))
+	for _, name := range names {
+		newBehavior, ok := available[name]
+		if !ok {
+			return fmt.Errorf("unknown pipeline behavior %q", name)
+		}
+		pipeline = append(pipeline, newBehavior())
+	}
+
+	return mediatr.RegisterRequestPipelineBehaviors(pipeline...)
+}
+
 func RegisterHandlers(db *gorm.DB) {
 	// Initialize repositories
 	accountRepo := repository.NewAccountRepository(db)
 	transactionRepo := repository.NewTransactionRepository(db)
+	ledgerRepo := repository.NewLedgerRepository(db)
+	exchangeRateRepo := repository.NewExchangeRateRepository(db)
+	webhookRepo := repository.NewWebhookSubscriptionRepository(db)
+	webhookDeliveryRepo := repository.NewWebhookDeliveryRepository(db)
+	apiKeyRepo := repository.NewAPIKeyRepository(db)
+	txManager := repository.NewTxManager(db)
 
 	// Documentation from https://github.com/mehdihadeli/Go-MediatR/blob/main/readme.md#registering-request-handler-to-the-mediatr
 	// is a bit outdated.
 
 	// Register Account Command Handlers
 	mediatr.RegisterRequestHandler(
-		handlers.NewCreateAccountHandler(accountRepo),
+		handlers.NewCreateAccountHandler(txManager),
 	)
 
 	mediatr.RegisterRequestHandler(
@@ -26,7 +76,27 @@ func RegisterHandlers(db *gorm.DB) {
 	)
 
 	mediatr.RegisterRequestHandler(
-		handlers.NewDeleteAccountHandler(accountRepo),
+		handlers.NewDeleteAccountHandler(txManager),
+	)
+
+	mediatr.RegisterRequestHandler(
+		handlers.NewRestoreAccountHandler(txManager),
+	)
+
+	mediatr.RegisterRequestHandler(
+		handlers.NewBlockAccountHandler(txManager),
+	)
+
+	mediatr.RegisterRequestHandler(
+		handlers.NewActivateAccountHandler(txManager),
+	)
+
+	mediatr.RegisterRequestHandler(
+		handlers.NewDeactivateAccountHandler(txManager),
+	)
+
+	mediatr.RegisterRequestHandler(
+		handlers.NewCloseAccountHandler(txManager),
 	)
 
 	// Register Account Query Handlers
@@ -42,17 +112,39 @@ func RegisterHandlers(db *gorm.DB) {
 		handlers.NewGetAccountByNumberHandler(accountRepo),
 	)
 
+	// Register Ledger Query Handlers
+	mediatr.RegisterRequestHandler(
+		handlers.NewGetAccountLedgerHandler(ledgerRepo),
+	)
+
+	mediatr.RegisterRequestHandler(
+		handlers.NewGetLedgerBalanceHandler(accountRepo, ledgerRepo),
+	)
+
+	// Register Exchange Rate Handlers
+	mediatr.RegisterRequestHandler(
+		handlers.NewPublishExchangeRateHandler(exchangeRateRepo),
+	)
+
+	mediatr.RegisterRequestHandler(
+		handlers.NewGetExchangeRateHandler(exchangeRateRepo),
+	)
+
+	mediatr.RegisterRequestHandler(
+		handlers.NewGetExchangeRatesHandler(exchangeRateRepo),
+	)
+
 	// Register Transaction Command Handlers
 	mediatr.RegisterRequestHandler(
-		handlers.NewCreateTransactionHandler(transactionRepo, accountRepo),
+		handlers.NewCreateTransactionHandler(txManager),
 	)
 
 	mediatr.RegisterRequestHandler(
-		handlers.NewProcessTransactionHandler(transactionRepo, accountRepo),
+		handlers.NewProcessTransactionHandler(txManager),
 	)
 
 	mediatr.RegisterRequestHandler(
-		handlers.NewCancelTransactionHandler(transactionRepo),
+		handlers.NewCancelTransactionHandler(txManager),
 	)
 
 	// Register Transaction Query Handlers
@@ -67,4 +159,42 @@ func RegisterHandlers(db *gorm.DB) {
 	mediatr.RegisterRequestHandler(
 		handlers.NewGetAccountTransactionsHandler(transactionRepo),
 	)
+
+	// Register Webhook Handlers
+	mediatr.RegisterRequestHandler(
+		handlers.NewCreateWebhookHandler(webhookRepo),
+	)
+
+	mediatr.RegisterRequestHandler(
+		handlers.NewDeleteWebhookHandler(webhookRepo),
+	)
+
+	mediatr.RegisterRequestHandler(
+		handlers.NewReplayWebhookDeliveryHandler(webhookDeliveryRepo),
+	)
+
+	mediatr.RegisterRequestHandler(
+		handlers.NewGetWebhookHandler(webhookRepo),
+	)
+
+	mediatr.RegisterRequestHandler(
+		handlers.NewGetWebhooksHandler(webhookRepo),
+	)
+
+	mediatr.RegisterRequestHandler(
+		handlers.NewGetWebhookDeliveriesHandler(webhookRepo, webhookDeliveryRepo),
+	)
+
+	// Register API Key Handlers
+	mediatr.RegisterRequestHandler(
+		handlers.NewIssueAPIKeyHandler(apiKeyRepo),
+	)
+
+	mediatr.RegisterRequestHandler(
+		handlers.NewRevokeAPIKeyHandler(apiKeyRepo),
+	)
+
+	mediatr.RegisterRequestHandler(
+		handlers.NewGetAPIKeysHandler(apiKeyRepo),
+	)
 }
@@ -0,0 +1,42 @@
+package policies
+
+import (
+	"arise_tech_assessment/internal/application/behaviors"
+	"arise_tech_assessment/internal/domain"
+	"context"
+	"reflect"
+)
+
+// Policy decides whether the principal may send the request, returning a
+// domain.ErrorCodeForbidden error when it may not. A policy can also narrow
+// the request instead, e.g. to the accounts of the caller.
+type Policy[TRequest any] func(ctx context.Context, principal *domain.Principal, request TRequest) error
+
+// Authorizer holds one policy per request type. Requests of a type without a
+// policy are denied, so that new commands and queries are closed until their
+// rights are decided.
+type Authorizer struct {
+	policies map[reflect.Type]func(ctx context.Context, principal *domain.Principal, request interface{}) error
+}
+
+func NewAuthorizer() *Authorizer {
+	return &Authorizer{
+		policies: map[reflect.Type]func(ctx context.Context, principal *domain.Principal, request interface{}) error{},
+	}
+}
+
+// Register sets the policy of the requests of type TRequest, replacing any
+// previous one.
+func Register[TRequest any](a *Authorizer, policy Policy[TRequest]) {
+	a.policies[reflect.TypeFor[TRequest]()] = func(ctx context.Context, principal *domain.Principal, request interface{}) error {
+		return policy(ctx, principal, request.(TRequest))
+	}
+}
+
+func (a *Authorizer) Authorize(ctx context.Context, principal *domain.Principal, request interface{}) error {
+	policy, ok := a.policies[reflect.TypeOf(request)]
+	if !ok {
+		return domain.Errorf(domain.ErrorCodeForbidden, "no policy allows %s", behaviors.RequestName(request))
+	}
+	return policy(ctx, principal, request)
+}
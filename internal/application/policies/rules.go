@@ -0,0 +1,220 @@
+package policies
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/application/queries"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// operators run the bank: they open and manage accounts and move money.
+	operators = []domain.Role{domain.RoleAdmin, domain.RoleTeller}
+	// staff may read everything; auditors only read.
+	staff = []domain.Role{domain.RoleAdmin, domain.RoleTeller, domain.RoleAuditor}
+	// readers are staff and the customers reading their own data.
+	readers = []domain.Role{domain.RoleAdmin, domain.RoleTeller, domain.RoleAuditor, domain.RoleCustomer}
+)
+
+var errNotOwner = domain.NewError(domain.ErrorCodeForbidden, "customers can only access their own accounts and transactions")
+
+// New returns the authorizer enforcing the rights of each role:
+//   - admins may do anything, and are the only ones to block, and so to
+//     unblock, accounts and to manage exchange rates, webhooks and API keys;
+//   - tellers manage accounts and transactions;
+//   - auditors read everything and change nothing;
+//   - customers only read their own accounts and the transactions involving
+//     them.
+func New(accounts repository.AccountRepository, transactions repository.TransactionRepository) *Authorizer {
+	a := NewAuthorizer()
+	o := ownership{accounts: accounts, transactions: transactions}
+
+	// Accounts
+	Register(a, allow[*commands.CreateAccountCommand](operators...))
+	Register(a, allow[*commands.UpdateAccountCommand](operators...))
+	Register(a, allow[*commands.DeleteAccountCommand](operators...))
+	Register(a, allow[*commands.RestoreAccountCommand](operators...))
+	Register(a, allow[*commands.BlockAccountCommand](domain.RoleAdmin))
+	Register(a, o.activateAccount)
+	Register(a, allow[*commands.DeactivateAccountCommand](operators...))
+	Register(a, allow[*commands.CloseAccountCommand](operators...))
+	Register(a, func(ctx context.Context, principal *domain.Principal, query *queries.GetAccountQuery) error {
+		return o.account(ctx, principal, query.ID)
+	})
+	Register(a, o.accountByNumber)
+	Register(a, func(ctx context.Context, principal *domain.Principal, query *queries.GetAccountsQuery) error {
+		return scope(principal, &query.Filter.OwnerID)
+	})
+
+	// Transactions and ledger
+	Register(a, allow[*commands.CreateTransactionCommand](operators...))
+	Register(a, allow[*commands.ProcessTransactionCommand](operators...))
+	Register(a, allow[*commands.CancelTransactionCommand](operators...))
+	Register(a, o.transaction)
+	Register(a, func(ctx context.Context, principal *domain.Principal, query *queries.GetTransactionsQuery) error {
+		return scope(principal, &query.Filter.OwnerID)
+	})
+	Register(a, func(ctx context.Context, principal *domain.Principal, query *queries.GetAccountTransactionsQuery) error {
+		return o.account(ctx, principal, query.AccountID)
+	})
+	Register(a, func(ctx context.Context, principal *domain.Principal, query *queries.GetAccountLedgerQuery) error {
+		return o.account(ctx, principal, query.AccountID)
+	})
+	Register(a, func(ctx context.Context, principal *domain.Principal, query *queries.GetLedgerBalanceQuery) error {
+		return o.account(ctx, principal, query.AccountID)
+	})
+
+	// Exchange rates
+	Register(a, allow[*commands.PublishExchangeRateCommand](domain.RoleAdmin))
+	Register(a, anyone[*queries.GetExchangeRateQuery])
+	Register(a, allow[*queries.GetExchangeRatesQuery](staff...))
+
+	// Webhooks
+	Register(a, allow[*commands.CreateWebhookCommand](domain.RoleAdmin))
+	Register(a, allow[*commands.DeleteWebhookCommand](domain.RoleAdmin))
+	Register(a, allow[*commands.ReplayWebhookDeliveryCommand](domain.RoleAdmin))
+	Register(a, allow[*queries.GetWebhookQuery](domain.RoleAdmin, domain.RoleAuditor))
+	Register(a, allow[*queries.GetWebhooksQuery](domain.RoleAdmin, domain.RoleAuditor))
+	Register(a, allow[*queries.GetWebhookDeliveriesQuery](domain.RoleAdmin, domain.RoleAuditor))
+
+	// API keys
+	Register(a, allow[*commands.IssueAPIKeyCommand](domain.RoleAdmin))
+	Register(a, allow[*commands.RevokeAPIKeyCommand](domain.RoleAdmin))
+	Register(a, allow[*queries.GetAPIKeysQuery](domain.RoleAdmin))
+
+	return a
+}
+
+// allow lets the principals holding one of the roles send the request.
+func allow[TRequest any](roles ...domain.Role) Policy[TRequest] {
+	return func(ctx context.Context, principal *domain.Principal, request TRequest) error {
+		return requireRole(principal, roles...)
+	}
+}
+
+// anyone lets every authenticated principal send the request.
+func anyone[TRequest any](ctx context.Context, principal *domain.Principal, request TRequest) error {
+	return nil
+}
+
+func requireRole(principal *domain.Principal, roles ...domain.Role) error {
+	if principal.HasAnyRole(roles...) {
+		return nil
+	}
+	return domain.Errorf(domain.ErrorCodeForbidden, "this operation requires one of the roles %v", roles)
+}
+
+// scope lets staff list everything and restricts the listings of customers
+// to their own accounts by setting the owner filter.
+func scope(principal *domain.Principal, ownerFilter *string) error {
+	if isStaff, err := staffOrCustomer(principal); isStaff || err != nil {
+		return err
+	}
+	*ownerFilter = principal.Subject
+	return nil
+}
+
+// staffOrCustomer reports whether the principal is staff, rejecting the
+// principals that are neither staff nor customers.
+func staffOrCustomer(principal *domain.Principal) (bool, error) {
+	if principal.HasAnyRole(staff...) {
+		return true, nil
+	}
+	return false, requireRole(principal, readers...)
+}
+
+// ownership checks the requests of customers against the owners of the
+// accounts they name. Accounts that do not exist are reported as not owned,
+// so that customers cannot probe for the accounts of others.
+type ownership struct {
+	accounts     repository.AccountRepository
+	transactions repository.TransactionRepository
+}
+
+// account lets staff access any account and customers their own.
+func (o ownership) account(ctx context.Context, principal *domain.Principal, id uuid.UUID) error {
+	if isStaff, err := staffOrCustomer(principal); isStaff || err != nil {
+		return err
+	}
+
+	account, err := o.accounts.GetByID(ctx, id)
+	return o.owned(principal, account, err)
+}
+
+func (o ownership) accountByNumber(ctx context.Context, principal *domain.Principal, query *queries.GetAccountByNumberQuery) error {
+	if isStaff, err := staffOrCustomer(principal); isStaff || err != nil {
+		return err
+	}
+
+	account, err := o.accounts.FindByNumber(ctx, query.Number)
+	return o.owned(principal, account, err)
+}
+
+// transaction lets customers see the transactions involving one of their
+// accounts, on either side.
+func (o ownership) transaction(ctx context.Context, principal *domain.Principal, query *queries.GetTransactionQuery) error {
+	if isStaff, err := staffOrCustomer(principal); isStaff || err != nil {
+		return err
+	}
+
+	transaction, err := o.transactions.GetByID(ctx, query.ID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return errNotOwner
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, id := range []*uuid.UUID{transaction.FromAccountID, transaction.ToAccountID} {
+		if id == nil {
+			continue
+		}
+		account, err := o.accounts.GetByID(ctx, *id)
+		if errors.Is(err, domain.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if account.IsOwnedBy(principal.Subject) {
+			return nil
+		}
+	}
+	return errNotOwner
+}
+
+// activateAccount keeps tellers from undoing the blocks set by admins.
+func (o ownership) activateAccount(ctx context.Context, principal *domain.Principal, command *commands.ActivateAccountCommand) error {
+	if err := requireRole(principal, operators...); err != nil {
+		return err
+	}
+	if principal.HasRole(domain.RoleAdmin) {
+		return nil
+	}
+
+	account, err := o.accounts.GetByID(ctx, command.ID)
+	if err != nil {
+		return err
+	}
+	if account.Status == domain.AccountStatusBlocked {
+		return domain.NewError(domain.ErrorCodeForbidden, "only admins can unblock accounts")
+	}
+	return nil
+}
+
+func (o ownership) owned(principal *domain.Principal, account *domain.Account, err error) error {
+	if errors.Is(err, domain.ErrNotFound) {
+		return errNotOwner
+	}
+	if err != nil {
+		return err
+	}
+	if !account.IsOwnedBy(principal.Subject) {
+		return errNotOwner
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+package policies
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/application/queries"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/mocks"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+func principal(subject string, roles ...domain.Role) *domain.Principal {
+	return &domain.Principal{Subject: subject, Roles: roles, Method: domain.AuthMethodAPIKey}
+}
+
+func newTestAuthorizer(t *testing.T) (*Authorizer, *mocks.MockAccountRepository, *mocks.MockTransactionRepository) {
+	t.Helper()
+	accounts := mocks.NewMockAccountRepository(t)
+	transactions := mocks.NewMockTransactionRepository(t)
+	return New(accounts, transactions), accounts, transactions
+}
+
+func newOwnedAccount(t *testing.T, owner string) *domain.Account {
+	t.Helper()
+	account, err := domain.NewAccount("1234567890", "Alice", domain.NewMoney(0, domain.USD))
+	if err != nil {
+		t.Fatalf("Failed to create account: %v", err)
+	}
+	account.SetOwner(owner)
+	return account
+}
+
+func TestAuthorizer_ShouldAllowAdminsEverything(t *testing.T) {
+	requests := []interface{}{
+		&commands.CreateAccountCommand{}, &commands.UpdateAccountCommand{}, &commands.DeleteAccountCommand{},
+		&commands.RestoreAccountCommand{}, &commands.BlockAccountCommand{}, &commands.ActivateAccountCommand{},
+		&commands.DeactivateAccountCommand{}, &commands.CloseAccountCommand{},
+		&commands.CreateTransactionCommand{}, &commands.ProcessTransactionCommand{}, &commands.CancelTransactionCommand{},
+		&commands.PublishExchangeRateCommand{},
+		&commands.CreateWebhookCommand{}, &commands.DeleteWebhookCommand{}, &commands.ReplayWebhookDeliveryCommand{},
+		&commands.IssueAPIKeyCommand{}, &commands.RevokeAPIKeyCommand{},
+		&queries.GetAccountQuery{}, &queries.GetAccountByNumberQuery{}, &queries.GetAccountsQuery{},
+		&queries.GetTransactionQuery{}, &queries.GetTransactionsQuery{}, &queries.GetAccountTransactionsQuery{},
+		&queries.GetAccountLedgerQuery{}, &queries.GetLedgerBalanceQuery{},
+		&queries.GetExchangeRateQuery{}, &queries.GetExchangeRatesQuery{},
+		&queries.GetWebhookQuery{}, &queries.GetWebhooksQuery{}, &queries.GetWebhookDeliveriesQuery{},
+		&queries.GetAPIKeysQuery{},
+	}
+	authorizer, _, _ := newTestAuthorizer(t)
+	admin := principal("root", domain.RoleAdmin)
+
+	for _, request := range requests {
+		// Act
+		err := authorizer.Authorize(context.Background(), admin, request)
+
+		// Assert
+		if err != nil {
+			t.Errorf("Expected admins to be allowed %T, got %v", request, err)
+		}
+	}
+}
+
+func TestAuthorizer_ShouldEnforceRoles(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal *domain.Principal
+		request   interface{}
+		allowed   bool
+	}{
+		{"teller blocks account", principal("bob", domain.RoleTeller), &commands.BlockAccountCommand{ID: uuid.New()}, false},
+		{"teller closes account", principal("bob", domain.RoleTeller), &commands.CloseAccountCommand{ID: uuid.New()}, true},
+		{"teller moves money", principal("bob", domain.RoleTeller), &commands.CreateTransactionCommand{}, true},
+		{"teller issues api key", principal("bob", domain.RoleTeller), &commands.IssueAPIKeyCommand{}, false},
+		{"auditor lists accounts", principal("carol", domain.RoleAuditor), &queries.GetAccountsQuery{}, true},
+		{"auditor reads webhook log", principal("carol", domain.RoleAuditor), &queries.GetWebhookDeliveriesQuery{}, true},
+		{"auditor creates account", principal("carol", domain.RoleAuditor), &commands.CreateAccountCommand{}, false},
+		{"auditor processes transaction", principal("carol", domain.RoleAuditor), &commands.ProcessTransactionCommand{ID: uuid.New()}, false},
+		{"customer moves money", principal("customer-1", domain.RoleCustomer), &commands.CreateTransactionCommand{}, false},
+		{"customer reads exchange rate", principal("customer-1", domain.RoleCustomer), &queries.GetExchangeRateQuery{}, true},
+		{"customer lists exchange rates", principal("customer-1", domain.RoleCustomer), &queries.GetExchangeRatesQuery{}, false},
+		{"no role lists accounts", principal("nobody"), &queries.GetAccountsQuery{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			authorizer, _, _ := newTestAuthorizer(t)
+
+			// Act
+			err := authorizer.Authorize(context.Background(), tt.principal, tt.request)
+
+			// Assert
+			if tt.allowed && err != nil {
+				t.Errorf("Expected the request to be allowed, got %v", err)
+			}
+			if !tt.allowed && !errors.Is(err, domain.ErrForbidden) {
+				t.Errorf("Expected forbidden error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestAuthorizer_ShouldDenyRequestsWithoutPolicy(t *testing.T) {
+	// Arrange
+	type unknownQuery struct{}
+
+	// Act
+	err := NewAuthorizer().Authorize(context.Background(), principal("root", domain.RoleAdmin), &unknownQuery{})
+
+	// Assert
+	if !errors.Is(err, domain.ErrForbidden) {
+		t.Errorf("Expected forbidden error, got %v", err)
+	}
+}
+
+func TestAuthorizer_ShouldLetCustomersReadOnlyTheirAccounts(t *testing.T) {
+	tests := []struct {
+		name    string
+		owner   string
+		err     error
+		allowed bool
+	}{
+		{"own account", "customer-1", nil, true},
+		{"account of another customer", "customer-2", nil, false},
+		{"account without owner", "", nil, false},
+		{"missing account", "", domain.NewError(domain.ErrorCodeNotFound, "account not found"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			authorizer, accounts, _ := newTestAuthorizer(t)
+			account := newOwnedAccount(t, tt.owner)
+			if tt.err != nil {
+				accounts.EXPECT().GetByID(mock.Anything, account.ID).Return(nil, tt.err)
+			} else {
+				accounts.EXPECT().GetByID(mock.Anything, account.ID).Return(account, nil)
+			}
+
+			// Act
+			err := authorizer.Authorize(context.Background(), principal("customer-1", domain.RoleCustomer), &queries.GetAccountLedgerQuery{AccountID: account.ID})
+
+			// Assert
+			if tt.allowed && err != nil {
+				t.Errorf("Expected the request to be allowed, got %v", err)
+			}
+			if !tt.allowed && !errors.Is(err, domain.ErrForbidden) {
+				t.Errorf("Expected forbidden error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestAuthorizer_ShouldScopeCustomerListingsToTheirAccounts(t *testing.T) {
+	// Arrange
+	authorizer, _, _ := newTestAuthorizer(t)
+	accountsQuery := &queries.GetAccountsQuery{}
+	transactionsQuery := &queries.GetTransactionsQuery{}
+	customer := principal("customer-1", domain.RoleCustomer)
+
+	// Act
+	accountsErr := authorizer.Authorize(context.Background(), customer, accountsQuery)
+	transactionsErr := authorizer.Authorize(context.Background(), customer, transactionsQuery)
+
+	// Assert
+	if accountsErr != nil || accountsQuery.Filter.OwnerID != "customer-1" {
+		t.Errorf("Expected the accounts to be filtered by owner, got %q (%v)", accountsQuery.Filter.OwnerID, accountsErr)
+	}
+	if transactionsErr != nil || transactionsQuery.Filter.OwnerID != "customer-1" {
+		t.Errorf("Expected the transactions to be filtered by owner, got %q (%v)", transactionsQuery.Filter.OwnerID, transactionsErr)
+	}
+}
+
+func TestAuthorizer_ShouldNotScopeStaffListings(t *testing.T) {
+	// Arrange
+	authorizer, _, _ := newTestAuthorizer(t)
+	query := &queries.GetAccountsQuery{}
+
+	// Act
+	err := authorizer.Authorize(context.Background(), principal("carol", domain.RoleAuditor), query)
+
+	// Assert
+	if err != nil || query.Filter.OwnerID != "" {
+		t.Errorf("Expected an unfiltered listing, got %q (%v)", query.Filter.OwnerID, err)
+	}
+}
+
+func TestAuthorizer_ShouldLetCustomersReadTransfersToTheirAccounts(t *testing.T) {
+	// Arrange
+	authorizer, accounts, transactions := newTestAuthorizer(t)
+	from := newOwnedAccount(t, "customer-2")
+	to := newOwnedAccount(t, "customer-1")
+	transfer, _ := domain.NewTransferTransaction(from.ID, to.ID, domain.NewMoney(100, domain.USD), "Rent")
+	transactions.EXPECT().GetByID(mock.Anything, transfer.ID).Return(transfer, nil)
+	accounts.EXPECT().GetByID(mock.Anything, from.ID).Return(from, nil)
+	accounts.EXPECT().GetByID(mock.Anything, to.ID).Return(to, nil)
+
+	// Act
+	allowedErr := authorizer.Authorize(context.Background(), principal("customer-1", domain.RoleCustomer), &queries.GetTransactionQuery{ID: transfer.ID})
+	deniedErr := authorizer.Authorize(context.Background(), principal("customer-3", domain.RoleCustomer), &queries.GetTransactionQuery{ID: transfer.ID})
+
+	// Assert
+	if allowedErr != nil {
+		t.Errorf("Expected the receiving customer to be allowed, got %v", allowedErr)
+	}
+	if !errors.Is(deniedErr, domain.ErrForbidden) {
+		t.Errorf("Expected forbidden error for an unrelated customer, got %v", deniedErr)
+	}
+}
+
+func TestAuthorizer_ShouldKeepTellersFromUnblockingAccounts(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  domain.AccountStatus
+		allowed bool
+	}{
+		{"inactive account", domain.AccountStatusInactive, true},
+		{"blocked account", domain.AccountStatusBlocked, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			authorizer, accounts, _ := newTestAuthorizer(t)
+			account := newOwnedAccount(t, "")
+			account.Status = tt.status
+			accounts.EXPECT().GetByID(mock.Anything, account.ID).Return(account, nil)
+
+			// Act
+			err := authorizer.Authorize(context.Background(), principal("bob", domain.RoleTeller), &commands.ActivateAccountCommand{ID: account.ID})
+
+			// Assert
+			if tt.allowed && err != nil {
+				t.Errorf("Expected the request to be allowed, got %v", err)
+			}
+			if !tt.allowed && !errors.Is(err, domain.ErrForbidden) {
+				t.Errorf("Expected forbidden error, got %v", err)
+			}
+		})
+	}
+}
@@ -7,9 +7,17 @@ import (
 )
 
 type GetAccountQuery struct {
-	ID uuid.UUID `json:"id" binding:"required"`
+	ID             uuid.UUID `json:"id" binding:"required"`
+	IncludeDeleted bool      `json:"include_deleted"`
 }
 
 type GetAccountResponse struct {
 	Account *domain.Account `json:"account"`
 }
+
+func (q *GetAccountQuery) Validate() error {
+	if q.ID == uuid.Nil {
+		return errIDRequired
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package queries
 
 import (
 	"arise_tech_assessment/internal/domain"
+	"strings"
 )
 
 type GetAccountByNumberQuery struct {
@@ -11,3 +12,10 @@ type GetAccountByNumberQuery struct {
 type GetAccountByNumberResponse struct {
 	Account *domain.Account `json:"account"`
 }
+
+func (q *GetAccountByNumberQuery) Validate() error {
+	if strings.TrimSpace(q.Number) == "" {
+		return domain.NewError(domain.ErrorCodeValidation, "number is required")
+	}
+	return nil
+}
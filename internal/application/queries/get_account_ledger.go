@@ -0,0 +1,27 @@
+package queries
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+
+	"github.com/google/uuid"
+)
+
+type GetAccountLedgerQuery struct {
+	AccountID uuid.UUID `json:"account_id" binding:"required"`
+	Page      int       `json:"page"`
+	PageSize  int       `json:"page_size"`
+	Cursor    string    `json:"cursor,omitempty"`
+	SkipTotal bool      `json:"skip_total,omitempty"`
+}
+
+type GetAccountLedgerResponse struct {
+	Pagination *repository.PaginationResponse[domain.LedgerEntry] `json:"pagination"`
+}
+
+func (q *GetAccountLedgerQuery) Validate() error {
+	if q.AccountID == uuid.Nil {
+		return errIDRequired
+	}
+	return validatePage(q.Page, q.PageSize)
+}
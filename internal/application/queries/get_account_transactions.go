@@ -11,8 +11,17 @@ type GetAccountTransactionsQuery struct {
 	AccountID uuid.UUID `json:"account_id" binding:"required"`
 	Page      int       `json:"page"`
 	PageSize  int       `json:"page_size"`
+	Cursor    string    `json:"cursor,omitempty"`
+	SkipTotal bool      `json:"skip_total,omitempty"`
 }
 
 type GetAccountTransactionsResponse struct {
 	Pagination *repository.PaginationResponse[domain.Transaction] `json:"pagination"`
 }
+
+func (q *GetAccountTransactionsQuery) Validate() error {
+	if q.AccountID == uuid.Nil {
+		return errIDRequired
+	}
+	return validatePage(q.Page, q.PageSize)
+}
@@ -6,10 +6,18 @@ import (
 )
 
 type GetAccountsQuery struct {
-	Page     int `json:"page"`
-	PageSize int `json:"page_size"`
+	Page      int                      `json:"page"`
+	PageSize  int                      `json:"page_size"`
+	Cursor    string                   `json:"cursor,omitempty"`
+	SkipTotal bool                     `json:"skip_total,omitempty"`
+	Filter    repository.AccountFilter `json:"filter"`
+	Sort      []repository.SortField   `json:"sort"`
 }
 
 type GetAccountsResponse struct {
 	Pagination *repository.PaginationResponse[domain.Account] `json:"pagination"`
 }
+
+func (q *GetAccountsQuery) Validate() error {
+	return validatePage(q.Page, q.PageSize)
+}
@@ -0,0 +1,19 @@
+package queries
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+)
+
+type GetAPIKeysQuery struct {
+	Page     int `json:"page"`
+	PageSize int `json:"page_size"`
+}
+
+type GetAPIKeysResponse struct {
+	Pagination *repository.PaginationResponse[domain.APIKey] `json:"pagination"`
+}
+
+func (q *GetAPIKeysQuery) Validate() error {
+	return validatePage(q.Page, q.PageSize)
+}
@@ -0,0 +1,21 @@
+package queries
+
+import (
+	"arise_tech_assessment/internal/domain"
+)
+
+type GetExchangeRateQuery struct {
+	BaseCurrency  domain.Currency `json:"base_currency" binding:"required"`
+	QuoteCurrency domain.Currency `json:"quote_currency" binding:"required"`
+}
+
+type GetExchangeRateResponse struct {
+	ExchangeRate *domain.ExchangeRate `json:"exchange_rate"`
+}
+
+func (q *GetExchangeRateQuery) Validate() error {
+	if err := q.BaseCurrency.Validate(); err != nil {
+		return err
+	}
+	return q.QuoteCurrency.Validate()
+}
@@ -0,0 +1,19 @@
+package queries
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+)
+
+type GetExchangeRatesQuery struct {
+	Page     int `json:"page"`
+	PageSize int `json:"page_size"`
+}
+
+type GetExchangeRatesResponse struct {
+	Pagination *repository.PaginationResponse[domain.ExchangeRate] `json:"pagination"`
+}
+
+func (q *GetExchangeRatesQuery) Validate() error {
+	return validatePage(q.Page, q.PageSize)
+}
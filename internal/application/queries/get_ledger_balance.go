@@ -0,0 +1,25 @@
+package queries
+
+import (
+	"arise_tech_assessment/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type GetLedgerBalanceQuery struct {
+	AccountID uuid.UUID `json:"account_id" binding:"required"`
+}
+
+type GetLedgerBalanceResponse struct {
+	AccountID      uuid.UUID    `json:"account_id"`
+	LedgerBalance  domain.Money `json:"ledger_balance"`
+	AccountBalance domain.Money `json:"account_balance"`
+	Reconciled     bool         `json:"reconciled"`
+}
+
+func (q *GetLedgerBalanceQuery) Validate() error {
+	if q.AccountID == uuid.Nil {
+		return errIDRequired
+	}
+	return nil
+}
@@ -13,3 +13,10 @@ type GetTransactionQuery struct {
 type GetTransactionResponse struct {
 	Transaction *domain.Transaction `json:"transaction"`
 }
+
+func (q *GetTransactionQuery) Validate() error {
+	if q.ID == uuid.Nil {
+		return errIDRequired
+	}
+	return nil
+}
@@ -6,10 +6,18 @@ import (
 )
 
 type GetTransactionsQuery struct {
-	Page     int `json:"page"`
-	PageSize int `json:"page_size"`
+	Page      int                          `json:"page"`
+	PageSize  int                          `json:"page_size"`
+	Cursor    string                       `json:"cursor,omitempty"`
+	SkipTotal bool                         `json:"skip_total,omitempty"`
+	Filter    repository.TransactionFilter `json:"filter"`
+	Sort      []repository.SortField       `json:"sort"`
 }
 
 type GetTransactionsResponse struct {
 	Pagination *repository.PaginationResponse[domain.Transaction] `json:"pagination"`
 }
+
+func (q *GetTransactionsQuery) Validate() error {
+	return validatePage(q.Page, q.PageSize)
+}
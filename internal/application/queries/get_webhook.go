@@ -0,0 +1,22 @@
+package queries
+
+import (
+	"arise_tech_assessment/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type GetWebhookQuery struct {
+	ID uuid.UUID `json:"id" binding:"required"`
+}
+
+type GetWebhookResponse struct {
+	Webhook *domain.WebhookSubscription `json:"webhook"`
+}
+
+func (q *GetWebhookQuery) Validate() error {
+	if q.ID == uuid.Nil {
+		return errIDRequired
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package queries
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+
+	"github.com/google/uuid"
+)
+
+type GetWebhookDeliveriesQuery struct {
+	WebhookID uuid.UUID `json:"webhook_id" binding:"required"`
+	Page      int       `json:"page"`
+	PageSize  int       `json:"page_size"`
+}
+
+type GetWebhookDeliveriesResponse struct {
+	Pagination *repository.PaginationResponse[domain.WebhookDelivery] `json:"pagination"`
+}
+
+func (q *GetWebhookDeliveriesQuery) Validate() error {
+	if q.WebhookID == uuid.Nil {
+		return errIDRequired
+	}
+	return validatePage(q.Page, q.PageSize)
+}
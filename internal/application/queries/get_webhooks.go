@@ -0,0 +1,19 @@
+package queries
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+)
+
+type GetWebhooksQuery struct {
+	Page     int `json:"page"`
+	PageSize int `json:"page_size"`
+}
+
+type GetWebhooksResponse struct {
+	Pagination *repository.PaginationResponse[domain.WebhookSubscription] `json:"pagination"`
+}
+
+func (q *GetWebhooksQuery) Validate() error {
+	return validatePage(q.Page, q.PageSize)
+}
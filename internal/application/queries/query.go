@@ -0,0 +1,26 @@
+package queries
+
+import (
+	"arise_tech_assessment/internal/domain"
+)
+
+var errIDRequired = domain.NewError(domain.ErrorCodeValidation, "id is required")
+
+// MaxPageSize is the largest page_size a listing accepts, so that a single
+// request cannot read a whole table.
+const MaxPageSize = 100
+
+// validatePage rejects negative offset pagination parameters and page sizes
+// above MaxPageSize. Zero selects the defaults.
+func validatePage(page, pageSize int) error {
+	if page < 0 {
+		return domain.NewError(domain.ErrorCodeValidation, "page cannot be negative")
+	}
+	if pageSize < 0 {
+		return domain.NewError(domain.ErrorCodeValidation, "page_size cannot be negative")
+	}
+	if pageSize > MaxPageSize {
+		return domain.Errorf(domain.ErrorCodeValidation, "page_size cannot exceed %d", MaxPageSize)
+	}
+	return nil
+}
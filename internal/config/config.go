@@ -0,0 +1,387 @@
+package config
+
+import (
+	"arise_tech_assessment/internal/application"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the runtime configuration shared by the API and the command line
+// tools. Values come from Default, then the YAML file, then the environment
+// variables named in the env tags.
+type Config struct {
+	Server   ServerConfig   `yaml:"server"`
+	Database DatabaseConfig `yaml:"database"`
+	Health   HealthConfig   `yaml:"health"`
+	Log      LogConfig      `yaml:"log"`
+	Tracing  TracingConfig  `yaml:"tracing"`
+	Pipeline PipelineConfig `yaml:"pipeline"`
+	Outbox   OutboxConfig   `yaml:"outbox"`
+	Webhooks WebhookConfig  `yaml:"webhooks"`
+	Auth     AuthConfig     `yaml:"auth"`
+	Features FeatureConfig  `yaml:"features"`
+}
+
+type ServerConfig struct {
+	Address           string        `yaml:"address" env:"SERVER_ADDRESS"`
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout" env:"SERVER_READ_HEADER_TIMEOUT"`
+	ReadTimeout       time.Duration `yaml:"read_timeout" env:"SERVER_READ_TIMEOUT"`
+	WriteTimeout      time.Duration `yaml:"write_timeout" env:"SERVER_WRITE_TIMEOUT"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout" env:"SERVER_IDLE_TIMEOUT"`
+	IdempotencyKeyTTL time.Duration `yaml:"idempotency_key_ttl" env:"SERVER_IDEMPOTENCY_KEY_TTL"`
+	ShutdownDelay     time.Duration `yaml:"shutdown_delay" env:"SERVER_SHUTDOWN_DELAY"`     // readiness fails for this long before draining starts
+	ShutdownTimeout   time.Duration `yaml:"shutdown_timeout" env:"SERVER_SHUTDOWN_TIMEOUT"` // deadline for in-flight requests
+}
+
+type DatabaseConfig struct {
+	DSN             string        `yaml:"dsn" env:"CONNECTION_STRINGS_DEFAULT"`
+	MaxOpenConns    int           `yaml:"max_open_conns" env:"DATABASE_MAX_OPEN_CONNS"`
+	MaxIdleConns    int           `yaml:"max_idle_conns" env:"DATABASE_MAX_IDLE_CONNS"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime" env:"DATABASE_CONN_MAX_LIFETIME"`
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time" env:"DATABASE_CONN_MAX_IDLE_TIME"`
+	LogLevel        string        `yaml:"log_level" env:"DATABASE_LOG_LEVEL"` // silent, error, warn or info
+	CreateDatabase  bool          `yaml:"create_database" env:"DATABASE_CREATE_DATABASE"`
+	// SlowQueryThreshold is the duration above which a statement is logged as
+	// slow; zero disables it.
+	SlowQueryThreshold time.Duration `yaml:"slow_query_threshold" env:"DATABASE_SLOW_QUERY_THRESHOLD"`
+}
+
+type HealthConfig struct {
+	CheckTimeout time.Duration `yaml:"check_timeout" env:"HEALTH_CHECK_TIMEOUT"`
+	// PoolSaturation is the share of max_open_conns in use (0 to 1) at which
+	// readiness fails.
+	PoolSaturation float64 `yaml:"pool_saturation" env:"HEALTH_POOL_SATURATION"`
+}
+
+type LogConfig struct {
+	Level string `yaml:"level" env:"LOG_LEVEL"` // debug, info, warn or error
+}
+
+type TracingConfig struct {
+	Exporter     string  `yaml:"exporter" env:"TRACING_EXPORTER"`           // none, stdout or otlp
+	OTLPEndpoint string  `yaml:"otlp_endpoint" env:"TRACING_OTLP_ENDPOINT"` // e.g. http://localhost:4318
+	ServiceName  string  `yaml:"service_name" env:"TRACING_SERVICE_NAME"`
+	SampleRatio  float64 `yaml:"sample_ratio" env:"TRACING_SAMPLE_RATIO"` // share of new traces recorded, 0 to 1
+}
+
+type PipelineConfig struct {
+	// Behaviors lists the mediator pipeline behaviors in order, outermost first.
+	Behaviors []string `yaml:"behaviors" env:"PIPELINE_BEHAVIORS"` // comma-separated in the environment
+}
+
+type OutboxConfig struct {
+	Enabled      bool          `yaml:"enabled" env:"OUTBOX_ENABLED"` // run the dispatcher in this process
+	PollInterval time.Duration `yaml:"poll_interval" env:"OUTBOX_POLL_INTERVAL"`
+	BatchSize    int           `yaml:"batch_size" env:"OUTBOX_BATCH_SIZE"`
+	MaxAttempts  int           `yaml:"max_attempts" env:"OUTBOX_MAX_ATTEMPTS"` // failed events are kept but no longer retried
+	MinBackoff   time.Duration `yaml:"min_backoff" env:"OUTBOX_MIN_BACKOFF"`
+	MaxBackoff   time.Duration `yaml:"max_backoff" env:"OUTBOX_MAX_BACKOFF"`
+	Sink         string        `yaml:"sink" env:"OUTBOX_SINK"` // none or log, in addition to the webhooks
+}
+
+type WebhookConfig struct {
+	Enabled      bool          `yaml:"enabled" env:"WEBHOOKS_ENABLED"` // run the delivery worker in this process
+	PollInterval time.Duration `yaml:"poll_interval" env:"WEBHOOKS_POLL_INTERVAL"`
+	BatchSize    int           `yaml:"batch_size" env:"WEBHOOKS_BATCH_SIZE"`
+	Timeout      time.Duration `yaml:"timeout" env:"WEBHOOKS_TIMEOUT"`           // per delivery request
+	MaxAttempts  int           `yaml:"max_attempts" env:"WEBHOOKS_MAX_ATTEMPTS"` // after which the delivery is marked failed
+	MinBackoff   time.Duration `yaml:"min_backoff" env:"WEBHOOKS_MIN_BACKOFF"`
+	MaxBackoff   time.Duration `yaml:"max_backoff" env:"WEBHOOKS_MAX_BACKOFF"`
+}
+
+type AuthConfig struct {
+	// Enabled requires an API key or a bearer token on every /api/v1 route
+	// except /health.
+	Enabled  bool          `yaml:"enabled" env:"AUTH_ENABLED"`
+	JWKSFile string        `yaml:"jwks_file" env:"AUTH_JWKS_FILE"` // keys of the bearer tokens, empty to accept API keys only
+	Issuer   string        `yaml:"issuer" env:"AUTH_ISSUER"`       // required iss claim, if set
+	Audience string        `yaml:"audience" env:"AUTH_AUDIENCE"`   // required aud claim, if set
+	Leeway   time.Duration `yaml:"leeway" env:"AUTH_LEEWAY"`       // clock skew allowed on exp and nbf
+}
+
+type FeatureConfig struct {
+	Swagger       bool `yaml:"swagger" env:"FEATURES_SWAGGER"`
+	Metrics       bool `yaml:"metrics" env:"FEATURES_METRICS"`
+	SeedOnStartup bool `yaml:"seed_on_startup" env:"FEATURES_SEED_ON_STARTUP"`
+}
+
+var (
+	databaseLogLevels = []string{"silent", "error", "warn", "info"}
+	logLevels         = []string{"debug", "info", "warn", "error"}
+	traceExporters    = []string{"none", "stdout", "otlp"}
+	outboxSinks       = []string{"none", "log"}
+)
+
+// Default returns the configuration used for any setting not given in the file
+// or the environment.
+func Default() Config {
+	return Config{
+		Server: ServerConfig{
+			Address:           ":8080",
+			ReadHeaderTimeout: 5 * time.Second,
+			ReadTimeout:       15 * time.Second,
+			WriteTimeout:      30 * time.Second,
+			IdleTimeout:       time.Minute,
+			IdempotencyKeyTTL: 24 * time.Hour,
+			ShutdownTimeout:   30 * time.Second,
+		},
+		Database: DatabaseConfig{
+			MaxOpenConns:       100,
+			MaxIdleConns:       10,
+			ConnMaxLifetime:    time.Hour,
+			LogLevel:           "warn",
+			CreateDatabase:     true,
+			SlowQueryThreshold: 200 * time.Millisecond,
+		},
+		Health: HealthConfig{
+			CheckTimeout:   2 * time.Second,
+			PoolSaturation: 0.9,
+		},
+		Log: LogConfig{
+			Level: "info",
+		},
+		Tracing: TracingConfig{
+			Exporter:    "none",
+			ServiceName: "gocrud",
+			SampleRatio: 1,
+		},
+		Pipeline: PipelineConfig{
+			Behaviors: slices.Clone(application.DefaultBehaviors),
+		},
+		Outbox: OutboxConfig{
+			Enabled:      true,
+			PollInterval: time.Second,
+			BatchSize:    100,
+			MaxAttempts:  10,
+			MinBackoff:   time.Second,
+			MaxBackoff:   10 * time.Minute,
+			Sink:         "log",
+		},
+		Webhooks: WebhookConfig{
+			Enabled:      true,
+			PollInterval: time.Second,
+			BatchSize:    20,
+			Timeout:      10 * time.Second,
+			MaxAttempts:  8,
+			MinBackoff:   10 * time.Second,
+			MaxBackoff:   time.Hour,
+		},
+		Auth: AuthConfig{
+			Enabled: true,
+			Leeway:  30 * time.Second,
+		},
+		Features: FeatureConfig{
+			Swagger:       true,
+			Metrics:       true,
+			SeedOnStartup: true,
+		},
+	}
+}
+
+// Load builds the configuration from the YAML file at path, which may be
+// empty to use only the defaults, and the environment. The result is validated.
+func Load(path string) (Config, error) {
+	cfg := Default()
+
+	if path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return Config{}, fmt.Errorf("read config file: %w", err)
+		}
+
+		decoder := yaml.NewDecoder(bytes.NewReader(content))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+			return Config{}, fmt.Errorf("parse config file %s: %w", path, err)
+		}
+	}
+
+	if err := applyEnv(reflect.ValueOf(&cfg).Elem(), os.LookupEnv); err != nil {
+		return Config{}, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+// Validate reports every invalid setting at once.
+func (c Config) Validate() error {
+	var errs []error
+
+	if c.Server.Address == "" {
+		errs = append(errs, errors.New("server.address is required"))
+	}
+	timeouts := []struct {
+		name  string
+		value time.Duration
+	}{
+		{"server.read_header_timeout", c.Server.ReadHeaderTimeout},
+		{"server.read_timeout", c.Server.ReadTimeout},
+		{"server.write_timeout", c.Server.WriteTimeout},
+		{"server.idle_timeout", c.Server.IdleTimeout},
+		{"server.shutdown_delay", c.Server.ShutdownDelay},
+		{"database.conn_max_lifetime", c.Database.ConnMaxLifetime},
+		{"database.conn_max_idle_time", c.Database.ConnMaxIdleTime},
+		{"database.slow_query_threshold", c.Database.SlowQueryThreshold},
+		{"auth.leeway", c.Auth.Leeway},
+	}
+	for _, timeout := range timeouts {
+		if timeout.value < 0 {
+			errs = append(errs, fmt.Errorf("%s cannot be negative", timeout.name))
+		}
+	}
+	if c.Server.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("server.shutdown_timeout must be positive"))
+	}
+	if c.Server.IdempotencyKeyTTL <= 0 {
+		errs = append(errs, errors.New("server.idempotency_key_ttl must be positive"))
+	}
+
+	if c.Database.DSN == "" {
+		errs = append(errs, errors.New("database.dsn is required (or set CONNECTION_STRINGS_DEFAULT)"))
+	}
+	if c.Database.MaxOpenConns < 0 || c.Database.MaxIdleConns < 0 {
+		errs = append(errs, errors.New("database connection pool sizes cannot be negative"))
+	}
+	if c.Database.MaxOpenConns > 0 && c.Database.MaxIdleConns > c.Database.MaxOpenConns {
+		errs = append(errs, errors.New("database.max_idle_conns cannot exceed database.max_open_conns"))
+	}
+	if !slices.Contains(databaseLogLevels, c.Database.LogLevel) {
+		errs = append(errs, fmt.Errorf("database.log_level must be one of %v", databaseLogLevels))
+	}
+
+	if c.Health.CheckTimeout <= 0 {
+		errs = append(errs, errors.New("health.check_timeout must be positive"))
+	}
+	if c.Health.PoolSaturation <= 0 || c.Health.PoolSaturation > 1 {
+		errs = append(errs, errors.New("health.pool_saturation must be greater than 0 and at most 1"))
+	}
+
+	if !slices.Contains(logLevels, c.Log.Level) {
+		errs = append(errs, fmt.Errorf("log.level must be one of %v", logLevels))
+	}
+
+	if !slices.Contains(traceExporters, c.Tracing.Exporter) {
+		errs = append(errs, fmt.Errorf("tracing.exporter must be one of %v", traceExporters))
+	}
+	if c.Tracing.ServiceName == "" {
+		errs = append(errs, errors.New("tracing.service_name is required"))
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		errs = append(errs, errors.New("tracing.sample_ratio must be between 0 and 1"))
+	}
+
+	if c.Outbox.PollInterval <= 0 {
+		errs = append(errs, errors.New("outbox.poll_interval must be positive"))
+	}
+	if c.Outbox.BatchSize <= 0 {
+		errs = append(errs, errors.New("outbox.batch_size must be positive"))
+	}
+	if c.Outbox.MaxAttempts <= 0 {
+		errs = append(errs, errors.New("outbox.max_attempts must be positive"))
+	}
+	if c.Outbox.MinBackoff <= 0 || c.Outbox.MaxBackoff < c.Outbox.MinBackoff {
+		errs = append(errs, errors.New("outbox.min_backoff must be positive and at most outbox.max_backoff"))
+	}
+	if !slices.Contains(outboxSinks, c.Outbox.Sink) {
+		errs = append(errs, fmt.Errorf("outbox.sink must be one of %v", outboxSinks))
+	}
+
+	if c.Webhooks.PollInterval <= 0 {
+		errs = append(errs, errors.New("webhooks.poll_interval must be positive"))
+	}
+	if c.Webhooks.BatchSize <= 0 {
+		errs = append(errs, errors.New("webhooks.batch_size must be positive"))
+	}
+	if c.Webhooks.Timeout <= 0 {
+		errs = append(errs, errors.New("webhooks.timeout must be positive"))
+	}
+	if c.Webhooks.MaxAttempts <= 0 {
+		errs = append(errs, errors.New("webhooks.max_attempts must be positive"))
+	}
+	if c.Webhooks.MinBackoff <= 0 || c.Webhooks.MaxBackoff < c.Webhooks.MinBackoff {
+		errs = append(errs, errors.New("webhooks.min_backoff must be positive and at most webhooks.max_backoff"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// applyEnv overrides the fields of v tagged with env from lookup, descending
+// into nested structs.
+func applyEnv(v reflect.Value, lookup func(string) (string, bool)) error {
+	for i := range v.NumField() {
+		field, spec := v.Field(i), v.Type().Field(i)
+		if field.Kind() == reflect.Struct {
+			if err := applyEnv(field, lookup); err != nil {
+				return err
+			}
+			continue
+		}
+
+		name := spec.Tag.Get("env")
+		raw, ok := lookup(name)
+		if name == "" || !ok {
+			continue
+		}
+
+		if err := setField(field, raw); err != nil {
+			return fmt.Errorf("environment variable %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func setField(field reflect.Value, raw string) error {
+	switch {
+	case field.Type() == reflect.TypeOf(time.Duration(0)):
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(d))
+	case field.Kind() == reflect.String:
+		field.SetString(raw)
+	case field.Kind() == reflect.Int:
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(n))
+	case field.Kind() == reflect.Float64:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	case field.Type() == reflect.TypeOf([]string(nil)):
+		values := []string{}
+		for _, value := range strings.Split(raw, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+		field.Set(reflect.ValueOf(values))
+	case field.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+	return nil
+}
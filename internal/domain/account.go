@@ -1,10 +1,10 @@
 package domain
 
 import (
-	"errors"
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type AccountStatus string
@@ -13,62 +13,112 @@ const (
 	AccountStatusActive   AccountStatus = "active"
 	AccountStatusInactive AccountStatus = "inactive"
 	AccountStatusBlocked  AccountStatus = "blocked"
+	AccountStatusClosed   AccountStatus = "closed"
 )
 
 type Account struct {
-	ID           uuid.UUID     `json:"id" gorm:"type:uuid;primary_key"`
-	Number       string        `json:"number" gorm:"uniqueIndex"`
-	HolderName   string        `json:"holder_name"`
-	Balance      Money         `json:"balance" gorm:"embedded"`
-	Status       AccountStatus `json:"status"`
-	CreatedAt    time.Time     `json:"created_at"`
-	UpdatedAt    time.Time     `json:"updated_at"`
-	Transactions []Transaction `json:"transactions,omitempty" gorm:"foreignKey:FromAccountID;references:ID"`
+	ID              uuid.UUID      `json:"id" gorm:"type:uuid;primary_key"`
+	Number          string         `json:"number" gorm:"uniqueIndex"`
+	HolderName      string         `json:"holder_name"`
+	OwnerID         *string        `json:"owner_id,omitempty" example:"customer-42"` // subject of the customer the account belongs to, NULL when none
+	Balance         Money          `json:"balance" gorm:"embedded"`
+	Status          AccountStatus  `json:"status"`
+	StatusReason    StatusReason   `json:"status_reason,omitempty"`
+	StatusChangedBy string         `json:"status_changed_by,omitempty"`
+	StatusChangedAt *time.Time     `json:"status_changed_at,omitempty"`
+	Version         int64          `json:"version" gorm:"not null;default:1"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	DeletedAt       gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"index" swaggertype:"string" format:"date-time"`
+	Transactions    []Transaction  `json:"transactions,omitempty" gorm:"foreignKey:FromAccountID;references:ID"`
+
+	aggregate
 }
 
-func NewAccount(number, holderName string, initialBalance Money) *Account {
+// NewAccount opens an active account. The initial balance must be in a
+// supported currency and cannot be negative.
+func NewAccount(number, holderName string, initialBalance Money) (*Account, error) {
+	if err := initialBalance.Validate(); err != nil {
+		return nil, err
+	}
+	if initialBalance.IsNegative() {
+		return nil, NewError(ErrorCodeValidation, "initial balance cannot be negative")
+	}
+
 	now := time.Now()
-	return &Account{
+	account := &Account{
 		ID:         uuid.New(),
 		Number:     number,
 		HolderName: holderName,
 		Balance:    initialBalance,
 		Status:     AccountStatusActive,
+		Version:    1,
 		CreatedAt:  now,
 		UpdatedAt:  now,
 	}
+	account.record(AccountCreated{
+		AccountEvent: AccountEvent{AccountID: account.ID, Time: now},
+		Balance:      initialBalance,
+	})
+	return account, nil
 }
 
 func (a *Account) Debit(amount Money) error {
 	if a.Status != AccountStatusActive {
-		return errors.New("account is not active")
+		return ErrAccountInactive
 	}
-	
-	if a.Balance.Amount < amount.Amount {
-		return errors.New("insufficient funds")
+
+	balance, err := a.Balance.Subtract(amount)
+	if err != nil {
+		return err
 	}
-	
-	a.Balance.Amount -= amount.Amount
+
+	if balance.IsNegative() {
+		return ErrInsufficientFunds
+	}
+
+	a.Balance = balance
 	a.UpdatedAt = time.Now()
 	return nil
 }
 
 func (a *Account) Credit(amount Money) error {
 	if a.Status != AccountStatusActive {
-		return errors.New("account is not active")
+		return ErrAccountInactive
+	}
+
+	balance, err := a.Balance.Add(amount)
+	if err != nil {
+		return err
 	}
-	
-	a.Balance.Amount += amount.Amount
+
+	a.Balance = balance
 	a.UpdatedAt = time.Now()
 	return nil
 }
 
-func (a *Account) Block() {
-	a.Status = AccountStatusBlocked
+// SetOwner links the account to the customer with the subject, or unlinks it
+// when the subject is empty.
+func (a *Account) SetOwner(subject string) {
+	a.OwnerID = nil
+	if subject != "" {
+		a.OwnerID = &subject
+	}
 	a.UpdatedAt = time.Now()
 }
 
-func (a *Account) Activate() {
-	a.Status = AccountStatusActive
-	a.UpdatedAt = time.Now()
-}
\ No newline at end of file
+// IsOwnedBy reports whether the account belongs to the customer with the
+// subject. Accounts without an owner belong to no one.
+func (a *Account) IsOwnedBy(subject string) bool {
+	return a.OwnerID != nil && *a.OwnerID == subject
+}
+
+// CurrentVersion returns the optimistic concurrency token the account was loaded with.
+func (a *Account) CurrentVersion() int64 {
+	return a.Version
+}
+
+// SetVersion replaces the optimistic concurrency token, used by repositories on write.
+func (a *Account) SetVersion(version int64) {
+	a.Version = version
+}
@@ -0,0 +1,123 @@
+package domain
+
+import "time"
+
+// StatusReason records why an account changed status.
+type StatusReason string
+
+const (
+	StatusReasonCustomerRequest StatusReason = "customer_request"
+	StatusReasonFraudSuspected  StatusReason = "fraud_suspected"
+	StatusReasonCompliance      StatusReason = "compliance"
+	StatusReasonDormant         StatusReason = "dormant"
+	StatusReasonResolved        StatusReason = "resolved"
+	StatusReasonOther           StatusReason = "other"
+)
+
+var statusReasons = map[StatusReason]bool{
+	StatusReasonCustomerRequest: true,
+	StatusReasonFraudSuspected:  true,
+	StatusReasonCompliance:      true,
+	StatusReasonDormant:         true,
+	StatusReasonResolved:        true,
+	StatusReasonOther:           true,
+}
+
+// accountTransitions lists the statuses an account may move to from each
+// status. Closed is terminal.
+var accountTransitions = map[AccountStatus][]AccountStatus{
+	AccountStatusActive:   {AccountStatusInactive, AccountStatusBlocked, AccountStatusClosed},
+	AccountStatusInactive: {AccountStatusActive, AccountStatusBlocked, AccountStatusClosed},
+	AccountStatusBlocked:  {AccountStatusActive, AccountStatusClosed},
+	AccountStatusClosed:   {},
+}
+
+// StatusChange describes who is changing an account's status and why.
+type StatusChange struct {
+	Reason    StatusReason
+	ChangedBy string
+}
+
+func (c StatusChange) Validate() error {
+	if !statusReasons[c.Reason] {
+		return Errorf(ErrorCodeValidation, "invalid status reason %q", string(c.Reason))
+	}
+	if c.ChangedBy == "" {
+		return NewError(ErrorCodeValidation, "changed_by is required")
+	}
+	return nil
+}
+
+// IsValid reports whether s is a known account status.
+func (s AccountStatus) IsValid() bool {
+	_, ok := accountTransitions[s]
+	return ok
+}
+
+// CanTransitionTo reports whether the state machine allows moving from s to next.
+func (s AccountStatus) CanTransitionTo(next AccountStatus) bool {
+	for _, allowed := range accountTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *Account) Block(change StatusChange) error {
+	return a.transitionTo(AccountStatusBlocked, change)
+}
+
+func (a *Account) Activate(change StatusChange) error {
+	return a.transitionTo(AccountStatusActive, change)
+}
+
+func (a *Account) Deactivate(change StatusChange) error {
+	return a.transitionTo(AccountStatusInactive, change)
+}
+
+// Close permanently closes the account. Only accounts with a zero balance can
+// be closed; callers must also ensure it has no pending transactions.
+func (a *Account) Close(change StatusChange) error {
+	if !a.Balance.IsZero() {
+		return Errorf(ErrorCodeInvalidState, "account with a balance of %s cannot be closed", a.Balance)
+	}
+	return a.transitionTo(AccountStatusClosed, change)
+}
+
+func (a *Account) transitionTo(next AccountStatus, change StatusChange) error {
+	if err := change.Validate(); err != nil {
+		return err
+	}
+
+	if !a.Status.CanTransitionTo(next) {
+		return Errorf(ErrorCodeInvalidState, "cannot change account status from %s to %s", a.Status, next)
+	}
+
+	now := time.Now()
+	changed := AccountStatusChanged{
+		AccountEvent: AccountEvent{AccountID: a.ID, Time: now},
+		From:         a.Status,
+		To:           next,
+		Reason:       change.Reason,
+		ChangedBy:    change.ChangedBy,
+	}
+
+	a.Status = next
+	a.StatusReason = change.Reason
+	a.StatusChangedBy = change.ChangedBy
+	a.StatusChangedAt = &now
+	a.UpdatedAt = now
+
+	switch next {
+	case AccountStatusActive:
+		a.record(AccountActivated{changed})
+	case AccountStatusInactive:
+		a.record(AccountDeactivated{changed})
+	case AccountStatusBlocked:
+		a.record(AccountBlocked{changed})
+	case AccountStatusClosed:
+		a.record(AccountClosed{changed})
+	}
+	return nil
+}
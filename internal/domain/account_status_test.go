@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+var testStatusChange = StatusChange{Reason: StatusReasonCustomerRequest, ChangedBy: "ops@example.com"}
+
+func TestAccount_StatusTransitions_ShouldFollowStateMachine(t *testing.T) {
+	tests := []struct {
+		name        string
+		from        AccountStatus
+		apply       func(a *Account) error
+		expected    AccountStatus
+		expectError bool
+	}{
+		{"block active", AccountStatusActive, func(a *Account) error { return a.Block(testStatusChange) }, AccountStatusBlocked, false},
+		{"deactivate active", AccountStatusActive, func(a *Account) error { return a.Deactivate(testStatusChange) }, AccountStatusInactive, false},
+		{"activate inactive", AccountStatusInactive, func(a *Account) error { return a.Activate(testStatusChange) }, AccountStatusActive, false},
+		{"activate blocked", AccountStatusBlocked, func(a *Account) error { return a.Activate(testStatusChange) }, AccountStatusActive, false},
+		{"close blocked", AccountStatusBlocked, func(a *Account) error { return a.Close(testStatusChange) }, AccountStatusClosed, false},
+		{"block blocked", AccountStatusBlocked, func(a *Account) error { return a.Block(testStatusChange) }, AccountStatusBlocked, true},
+		{"deactivate blocked", AccountStatusBlocked, func(a *Account) error { return a.Deactivate(testStatusChange) }, AccountStatusBlocked, true},
+		{"activate closed", AccountStatusClosed, func(a *Account) error { return a.Activate(testStatusChange) }, AccountStatusClosed, true},
+		{"block closed", AccountStatusClosed, func(a *Account) error { return a.Block(testStatusChange) }, AccountStatusClosed, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			account := newTestAccount(t, "12345", "Test User", NewMoney(0, USD))
+			account.Status = tt.from
+
+			// Act
+			err := tt.apply(account)
+
+			// Assert
+			if tt.expectError && !errors.Is(err, ErrInvalidState) {
+				t.Errorf("Expected invalid state error, got %v", err)
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if account.Status != tt.expected {
+				t.Errorf("Expected status %s, got %s", tt.expected, account.Status)
+			}
+		})
+	}
+}
+
+func TestAccount_StatusTransitions_ShouldRecordReasonAndActor(t *testing.T) {
+	// Arrange
+	account := newTestAccount(t, "12345", "Test User", NewMoney(0, USD))
+
+	// Act
+	err := account.Block(StatusChange{Reason: StatusReasonFraudSuspected, ChangedBy: "risk-team"})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if account.StatusReason != StatusReasonFraudSuspected {
+		t.Errorf("Expected reason %s, got %s", StatusReasonFraudSuspected, account.StatusReason)
+	}
+	if account.StatusChangedBy != "risk-team" {
+		t.Errorf("Expected changed by risk-team, got %s", account.StatusChangedBy)
+	}
+	if account.StatusChangedAt == nil {
+		t.Error("Expected StatusChangedAt to be set")
+	}
+}
+
+func TestAccount_StatusTransitions_ShouldRejectInvalidChange(t *testing.T) {
+	tests := []struct {
+		name   string
+		change StatusChange
+	}{
+		{"unknown reason", StatusChange{Reason: "bored", ChangedBy: "ops@example.com"}},
+		{"missing reason", StatusChange{ChangedBy: "ops@example.com"}},
+		{"missing actor", StatusChange{Reason: StatusReasonCompliance}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			account := newTestAccount(t, "12345", "Test User", NewMoney(0, USD))
+
+			// Act
+			err := account.Block(tt.change)
+
+			// Assert
+			if !errors.Is(err, ErrValidation) {
+				t.Errorf("Expected validation error, got %v", err)
+			}
+			if account.Status != AccountStatusActive {
+				t.Errorf("Expected status to stay %s, got %s", AccountStatusActive, account.Status)
+			}
+		})
+	}
+}
+
+func TestAccount_Close_ShouldRequireZeroBalance(t *testing.T) {
+	// Arrange
+	account := newTestAccount(t, "12345", "Test User", NewMoney(100, USD))
+
+	// Act
+	err := account.Close(testStatusChange)
+
+	// Assert
+	if !errors.Is(err, ErrInvalidState) {
+		t.Errorf("Expected invalid state error, got %v", err)
+	}
+	if account.Status != AccountStatusActive {
+		t.Errorf("Expected status to stay %s, got %s", AccountStatusActive, account.Status)
+	}
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -14,9 +15,12 @@ func TestNewAccount_ShouldInitializeAccountWithCorrectDefaults(t *testing.T) {
 	initialBalance := NewMoney(10000, USD)
 
 	// Act
-	account := NewAccount(number, holderName, initialBalance)
+	account, err := NewAccount(number, holderName, initialBalance)
 
 	// Assert
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
 	if account.ID == uuid.Nil {
 		t.Error("Expected account ID to be generated")
 	}
@@ -40,6 +44,31 @@ func TestNewAccount_ShouldInitializeAccountWithCorrectDefaults(t *testing.T) {
 	}
 }
 
+func TestNewAccount_ShouldRejectInvalidInitialBalance(t *testing.T) {
+	tests := []struct {
+		name           string
+		initialBalance Money
+	}{
+		{"negative balance", NewMoney(-1, USD)},
+		{"unsupported currency", NewMoney(1000, Currency("XXX"))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			account, err := NewAccount("12345678", "John Doe", tt.initialBalance)
+
+			// Assert
+			if !errors.Is(err, ErrValidation) {
+				t.Errorf("Expected validation error, got %v", err)
+			}
+			if account != nil {
+				t.Error("Expected no account to be created")
+			}
+		})
+	}
+}
+
 func TestAccount_Debit_ShouldCorrectlyDebitAccountBalance(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -86,6 +115,15 @@ func TestAccount_Debit_ShouldCorrectlyDebitAccountBalance(t *testing.T) {
 			"insufficient funds",
 			NewMoney(5000, USD),
 		},
+		{
+			"debit in a different currency",
+			NewMoney(10000, USD),
+			AccountStatusActive,
+			NewMoney(5000, THB),
+			true,
+			"cannot subtract THB from USD",
+			NewMoney(10000, USD),
+		},
 	}
 
 	for _, tt := range tests {
@@ -166,6 +204,15 @@ func TestAccount_Credit_ShouldCorrectlyCreditAccountBalance(t *testing.T) {
 			"account is not active",
 			NewMoney(5000, USD),
 		},
+		{
+			"credit in a different currency",
+			NewMoney(5000, THB),
+			AccountStatusActive,
+			NewMoney(3000, USD),
+			true,
+			"cannot add USD to THB",
+			NewMoney(5000, THB),
+		},
 	}
 
 	for _, tt := range tests {
@@ -224,9 +271,13 @@ func TestAccount_Block_ShouldSetAccountStatusToBlocked(t *testing.T) {
 	time.Sleep(time.Millisecond) // Ensure UpdatedAt changes
 
 	// Act
-	account.Block()
+	err := account.Block(StatusChange{Reason: StatusReasonFraudSuspected, ChangedBy: "ops@example.com"})
 
 	// Assert
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
 	if account.Status != AccountStatusBlocked {
 		t.Errorf("Expected status %s, got %s", AccountStatusBlocked, account.Status)
 	}
@@ -251,9 +302,13 @@ func TestAccount_Activate_ShouldSetAccountStatusToActive(t *testing.T) {
 	time.Sleep(time.Millisecond) // Ensure UpdatedAt changes
 
 	// Act
-	account.Activate()
+	err := account.Activate(StatusChange{Reason: StatusReasonResolved, ChangedBy: "ops@example.com"})
 
 	// Assert
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
 	if account.Status != AccountStatusActive {
 		t.Errorf("Expected status %s, got %s", AccountStatusActive, account.Status)
 	}
@@ -261,4 +316,21 @@ func TestAccount_Activate_ShouldSetAccountStatusToActive(t *testing.T) {
 	if account.UpdatedAt.Equal(initialUpdatedAt) {
 		t.Error("Expected UpdatedAt to be updated")
 	}
-}
\ No newline at end of file
+}
+func TestAccount_SetOwner_ShouldStoreNoOwnerAsNil(t *testing.T) {
+	// Arrange
+	account, _ := NewAccount("12345678", "John Doe", NewMoney(0, USD))
+
+	// Act
+	account.SetOwner("customer-1")
+	owned := account.IsOwnedBy("customer-1")
+	account.SetOwner("")
+
+	// Assert
+	if !owned {
+		t.Error("Expected the account to be owned by customer-1")
+	}
+	if account.OwnerID != nil || account.IsOwnedBy("") {
+		t.Errorf("Expected no owner, got %v", account.OwnerID)
+	}
+}
@@ -0,0 +1,50 @@
+package domain
+
+import "sort"
+
+// currencyExponents lists the supported ISO 4217 currencies with the number
+// of minor-unit digits Money amounts are stored in.
+var currencyExponents = map[Currency]int{
+	THB: 2,
+	USD: 2,
+	EUR: 2,
+	GBP: 2,
+	SGD: 2,
+	JPY: 0,
+}
+
+// IsSupported reports whether the currency is in the registry.
+func (c Currency) IsSupported() bool {
+	_, ok := currencyExponents[c]
+	return ok
+}
+
+// Exponent returns the number of minor-unit digits of the currency, e.g. 2 for
+// USD cents. Unsupported currencies report 2.
+func (c Currency) Exponent() int {
+	if exponent, ok := currencyExponents[c]; ok {
+		return exponent
+	}
+	return 2
+}
+
+// Validate returns a validation error for currencies not in the registry.
+func (c Currency) Validate() error {
+	if c == "" {
+		return NewError(ErrorCodeValidation, "currency is required")
+	}
+	if !c.IsSupported() {
+		return Errorf(ErrorCodeValidation, "unsupported currency %q", string(c))
+	}
+	return nil
+}
+
+// SupportedCurrencies returns the registered currency codes in alphabetical order.
+func SupportedCurrencies() []Currency {
+	currencies := make([]Currency, 0, len(currencyExponents))
+	for c := range currencyExponents {
+		currencies = append(currencies, c)
+	}
+	sort.Slice(currencies, func(i, j int) bool { return currencies[i] < currencies[j] })
+	return currencies
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCurrency_Validate_ShouldAcceptOnlyRegisteredCurrencies(t *testing.T) {
+	tests := []struct {
+		name        string
+		currency    Currency
+		expectError bool
+	}{
+		{"THB", THB, false},
+		{"USD", USD, false},
+		{"JPY", JPY, false},
+		{"empty", "", true},
+		{"lower case", "usd", true},
+		{"unknown", "XYZ", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			err := tt.currency.Validate()
+
+			// Assert
+			if tt.expectError && !errors.Is(err, ErrValidation) {
+				t.Errorf("Expected validation error, got %v", err)
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestCurrency_Exponent_ShouldReturnMinorUnits(t *testing.T) {
+	tests := []struct {
+		currency Currency
+		expected int
+	}{
+		{USD, 2},
+		{THB, 2},
+		{JPY, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.currency), func(t *testing.T) {
+			// Act
+			got := tt.currency.Exponent()
+
+			// Assert
+			if got != tt.expected {
+				t.Errorf("Exponent() = %d, want %d", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package domain
+
+import "fmt"
+
+// ErrorCode is a stable, machine-readable identifier for a domain failure.
+type ErrorCode string
+
+const (
+	ErrorCodeNotFound          ErrorCode = "not_found"
+	ErrorCodeInsufficientFunds ErrorCode = "insufficient_funds"
+	ErrorCodeAccountInactive   ErrorCode = "account_inactive"
+	ErrorCodeInvalidState      ErrorCode = "invalid_state"
+	ErrorCodeCurrencyMismatch  ErrorCode = "currency_mismatch"
+	ErrorCodeConflict          ErrorCode = "conflict"
+	ErrorCodeValidation        ErrorCode = "validation_failed"
+	ErrorCodeUnauthenticated   ErrorCode = "unauthenticated"
+	ErrorCodeForbidden         ErrorCode = "forbidden"
+)
+
+// Error is a domain failure carrying an ErrorCode. Errors with the same code
+// match each other under errors.Is, so callers can test against the sentinels
+// below regardless of the message.
+type Error struct {
+	Code    ErrorCode
+	Message string
+}
+
+var (
+	ErrNotFound          = NewError(ErrorCodeNotFound, "resource not found")
+	ErrInsufficientFunds = NewError(ErrorCodeInsufficientFunds, "insufficient funds")
+	ErrAccountInactive   = NewError(ErrorCodeAccountInactive, "account is not active")
+	ErrInvalidState      = NewError(ErrorCodeInvalidState, "invalid state")
+	ErrCurrencyMismatch  = NewError(ErrorCodeCurrencyMismatch, "currency mismatch")
+	ErrConflict          = NewError(ErrorCodeConflict, "conflict")
+	ErrValidation        = NewError(ErrorCodeValidation, "validation failed")
+	ErrUnauthenticated   = NewError(ErrorCodeUnauthenticated, "authentication required")
+	ErrForbidden         = NewError(ErrorCodeForbidden, "forbidden")
+)
+
+func NewError(code ErrorCode, message string) *Error {
+	return &Error{
+		Code:    code,
+		Message: message,
+	}
+}
+
+func Errorf(code ErrorCode, format string, args ...any) *Error {
+	return NewError(code, fmt.Sprintf(format, args...))
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+// TransactionFailure reports that a transaction could not be applied to its
+// accounts and was recorded as failed. It unwraps to the cause, so it matches
+// the same sentinels.
+type TransactionFailure struct {
+	Transaction *Transaction
+	Err         error
+}
+
+func (f *TransactionFailure) Error() string {
+	return f.Err.Error()
+}
+
+func (f *TransactionFailure) Unwrap() error {
+	return f.Err
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestError_IsShouldMatchByCode(t *testing.T) {
+	// Arrange
+	err := Errorf(ErrorCodeNotFound, "account %s not found", "ACC-1")
+
+	// Act & Assert
+	if !errors.Is(err, ErrNotFound) {
+		t.Error("Expected error to match ErrNotFound")
+	}
+
+	if errors.Is(err, ErrConflict) {
+		t.Error("Expected error not to match ErrConflict")
+	}
+
+	if err.Error() != "account ACC-1 not found" {
+		t.Errorf("Expected message 'account ACC-1 not found', got %s", err.Error())
+	}
+}
+
+func TestError_IsShouldMatchWrappedErrors(t *testing.T) {
+	// Arrange
+	err := fmt.Errorf("processing failed: %w", ErrInsufficientFunds)
+
+	// Act
+	var domainErr *Error
+	ok := errors.As(err, &domainErr)
+
+	// Assert
+	if !ok {
+		t.Fatal("Expected wrapped error to be a domain error")
+	}
+
+	if domainErr.Code != ErrorCodeInsufficientFunds {
+		t.Errorf("Expected code %s, got %s", ErrorCodeInsufficientFunds, domainErr.Code)
+	}
+}
+
+func TestMoney_AddShouldReturnCurrencyMismatch(t *testing.T) {
+	// Arrange
+	usd := NewMoney(100, "USD")
+	eur := NewMoney(100, "EUR")
+
+	// Act
+	_, err := usd.Add(eur)
+
+	// Assert
+	if !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch, got %v", err)
+	}
+}
@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Event is a fact raised by an aggregate when its state changes. Events are
+// stored in the outbox in the same database transaction as the change and
+// published once it has committed.
+type Event interface {
+	// EventName identifies the event type, e.g. account.blocked.
+	EventName() string
+	AggregateID() uuid.UUID
+	OccurredAt() time.Time
+}
+
+// EventNames lists the name of every event the aggregates raise.
+var EventNames = []string{
+	AccountCreated{}.EventName(),
+	AccountActivated{}.EventName(),
+	AccountDeactivated{}.EventName(),
+	AccountBlocked{}.EventName(),
+	AccountClosed{}.EventName(),
+	TransactionCreated{}.EventName(),
+	TransactionCompleted{}.EventName(),
+	TransactionFailed{}.EventName(),
+	TransactionCancelled{}.EventName(),
+}
+
+// aggregate collects the events raised by an entity until they are saved.
+type aggregate struct {
+	events []Event
+}
+
+func (a *aggregate) record(event Event) {
+	a.events = append(a.events, event)
+}
+
+// PendingEvents returns the events raised since the entity was loaded or last saved.
+func (a *aggregate) PendingEvents() []Event {
+	return a.events
+}
+
+// ClearEvents forgets the pending events once they are stored.
+func (a *aggregate) ClearEvents() {
+	a.events = nil
+}
+
+// AccountEvent holds the fields shared by every account event.
+type AccountEvent struct {
+	AccountID uuid.UUID `json:"account_id"`
+	Time      time.Time `json:"occurred_at"`
+}
+
+func (e AccountEvent) AggregateID() uuid.UUID {
+	return e.AccountID
+}
+
+func (e AccountEvent) OccurredAt() time.Time {
+	return e.Time
+}
+
+type AccountCreated struct {
+	AccountEvent
+	Balance Money `json:"balance"`
+}
+
+func (AccountCreated) EventName() string {
+	return "account.created"
+}
+
+// AccountStatusChanged holds the fields of the events raised on status changes.
+type AccountStatusChanged struct {
+	AccountEvent
+	From      AccountStatus `json:"from"`
+	To        AccountStatus `json:"to"`
+	Reason    StatusReason  `json:"reason"`
+	ChangedBy string        `json:"changed_by"`
+}
+
+type AccountActivated struct {
+	AccountStatusChanged
+}
+
+func (AccountActivated) EventName() string {
+	return "account.activated"
+}
+
+type AccountDeactivated struct {
+	AccountStatusChanged
+}
+
+func (AccountDeactivated) EventName() string {
+	return "account.deactivated"
+}
+
+type AccountBlocked struct {
+	AccountStatusChanged
+}
+
+func (AccountBlocked) EventName() string {
+	return "account.blocked"
+}
+
+type AccountClosed struct {
+	AccountStatusChanged
+}
+
+func (AccountClosed) EventName() string {
+	return "account.closed"
+}
+
+// TransactionEvent holds the fields shared by every transaction event.
+type TransactionEvent struct {
+	TransactionID uuid.UUID       `json:"transaction_id"`
+	Type          TransactionType `json:"type"`
+	Amount        Money           `json:"amount"`
+	FromAccountID *uuid.UUID      `json:"from_account_id,omitempty"`
+	ToAccountID   *uuid.UUID      `json:"to_account_id,omitempty"`
+	Time          time.Time       `json:"occurred_at"`
+}
+
+func (e TransactionEvent) AggregateID() uuid.UUID {
+	return e.TransactionID
+}
+
+func (e TransactionEvent) OccurredAt() time.Time {
+	return e.Time
+}
+
+type TransactionCreated struct {
+	TransactionEvent
+}
+
+func (TransactionCreated) EventName() string {
+	return "transaction.created"
+}
+
+type TransactionCompleted struct {
+	TransactionEvent
+	CreditAmount Money `json:"credit_amount"`
+}
+
+func (TransactionCompleted) EventName() string {
+	return "transaction.completed"
+}
+
+type TransactionFailed struct {
+	TransactionEvent
+}
+
+func (TransactionFailed) EventName() string {
+	return "transaction.failed"
+}
+
+type TransactionCancelled struct {
+	TransactionEvent
+}
+
+func (TransactionCancelled) EventName() string {
+	return "transaction.cancelled"
+}
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestAccount_ShouldRecordEventsUntilCleared(t *testing.T) {
+	// Arrange
+	account := newTestAccount(t, "12345", "Test User", NewMoney(0, USD))
+
+	// Act
+	err := account.Block(StatusChange{Reason: StatusReasonFraudSuspected, ChangedBy: "risk-team"})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	events := account.PendingEvents()
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 pending events, got %d", len(events))
+	}
+	if _, ok := events[0].(AccountCreated); !ok {
+		t.Errorf("Expected AccountCreated first, got %T", events[0])
+	}
+	blocked, ok := events[1].(AccountBlocked)
+	if !ok {
+		t.Fatalf("Expected AccountBlocked second, got %T", events[1])
+	}
+	if blocked.AggregateID() != account.ID || blocked.From != AccountStatusActive || blocked.ChangedBy != "risk-team" {
+		t.Errorf("Expected the block of account %s by risk-team, got %+v", account.ID, blocked)
+	}
+
+	account.ClearEvents()
+	if len(account.PendingEvents()) != 0 {
+		t.Errorf("Expected no pending events after clearing, got %d", len(account.PendingEvents()))
+	}
+}
+
+func TestAccount_FailedTransition_ShouldNotRecordEvent(t *testing.T) {
+	// Arrange
+	account := newTestAccount(t, "12345", "Test User", NewMoney(0, USD))
+	account.ClearEvents()
+
+	// Act
+	_ = account.Activate(testStatusChange)
+
+	// Assert
+	if len(account.PendingEvents()) != 0 {
+		t.Errorf("Expected no events for a rejected transition, got %v", account.PendingEvents())
+	}
+}
+
+func TestTransaction_ShouldRecordLifecycleEvents(t *testing.T) {
+	// Arrange
+	transaction := newTestTransfer(t, uuid.New(), uuid.New(), NewMoney(1000, USD), "Transfer")
+
+	// Act
+	transaction.Complete()
+
+	// Assert
+	events := transaction.PendingEvents()
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 pending events, got %d", len(events))
+	}
+	if events[0].EventName() != "transaction.created" || events[1].EventName() != "transaction.completed" {
+		t.Errorf("Expected created then completed, got %s then %s", events[0].EventName(), events[1].EventName())
+	}
+	completed := events[1].(TransactionCompleted)
+	if completed.CreditAmount != NewMoney(1000, USD) || *completed.ToAccountID != *transaction.ToAccountID {
+		t.Errorf("Expected the completed transfer details, got %+v", completed)
+	}
+}
+
+func TestNewOutboxEvent_ShouldSerializeEvent(t *testing.T) {
+	// Arrange
+	transaction := NewDepositTransaction(uuid.New(), NewMoney(2500, THB), "Deposit")
+	transaction.Fail()
+	event := transaction.PendingEvents()[1]
+
+	// Act
+	message, err := NewOutboxEvent(event)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if message.EventType != "transaction.failed" || message.AggregateID != transaction.ID {
+		t.Errorf("Expected transaction.failed for %s, got %s for %s", transaction.ID, message.EventType, message.AggregateID)
+	}
+	if !message.NextAttemptAt.Equal(event.OccurredAt()) || message.PublishedAt != nil {
+		t.Errorf("Expected the event to be due immediately and unpublished, got %+v", message)
+	}
+
+	var decoded TransactionFailed
+	if err := json.Unmarshal(message.Payload, &decoded); err != nil {
+		t.Fatalf("Failed to decode payload: %v", err)
+	}
+	if decoded.TransactionID != transaction.ID || decoded.Amount != transaction.Amount {
+		t.Errorf("Expected payload to round-trip, got %+v", decoded)
+	}
+}
@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// rateScale is the number of decimal places rates are stored with.
+const rateScale = 12
+
+// Rate is an exact decimal exchange rate. It is stored as NUMERIC and
+// serialized as a JSON string so that no precision is lost to floats.
+type Rate struct {
+	value *big.Rat
+}
+
+func ParseRate(s string) (Rate, error) {
+	value, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return Rate{}, Errorf(ErrorCodeValidation, "invalid exchange rate %q", s)
+	}
+	if value.Sign() <= 0 {
+		return Rate{}, NewError(ErrorCodeValidation, "exchange rate must be positive")
+	}
+	return Rate{value: value}, nil
+}
+
+// MustParseRate is like ParseRate but panics on invalid input. Intended for
+// constants and tests.
+func MustParseRate(s string) Rate {
+	rate, err := ParseRate(s)
+	if err != nil {
+		panic(err)
+	}
+	return rate
+}
+
+// Rat returns a copy of the rate as a big.Rat. The zero Rate returns zero.
+func (r Rate) Rat() *big.Rat {
+	if r.value == nil {
+		return new(big.Rat)
+	}
+	return new(big.Rat).Set(r.value)
+}
+
+func (r Rate) IsZero() bool {
+	return r.value == nil || r.value.Sign() == 0
+}
+
+// Inverse returns 1/r.
+func (r Rate) Inverse() Rate {
+	if r.IsZero() {
+		return Rate{}
+	}
+	return Rate{value: new(big.Rat).Inv(r.value)}
+}
+
+// String formats the rate with up to 12 decimal places and no trailing zeros.
+func (r Rate) String() string {
+	s := r.Rat().FloatString(rateScale)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+func (r Rate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+func (r *Rate) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := ParseRate(s)
+	if err != nil {
+		return err
+	}
+	*r = parsed
+	return nil
+}
+
+func (r Rate) Value() (driver.Value, error) {
+	return r.Rat().FloatString(rateScale), nil
+}
+
+func (r *Rate) Scan(src any) error {
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case float64:
+		s = fmt.Sprintf("%v", v)
+	default:
+		return fmt.Errorf("cannot scan %T into Rate", src)
+	}
+
+	value, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return fmt.Errorf("cannot parse rate %q", s)
+	}
+	r.value = value
+	return nil
+}
+
+// ExchangeRate is the price of one unit of BaseCurrency in QuoteCurrency,
+// e.g. USD/THB 35.5 means 1 USD buys 35.5 THB. The latest rate whose
+// EffectiveAt has passed applies.
+type ExchangeRate struct {
+	ID            uuid.UUID `json:"id" gorm:"type:uuid;primary_key"`
+	BaseCurrency  Currency  `json:"base_currency" gorm:"size:3;not null;index:idx_exchange_rates_pair,priority:1"`
+	QuoteCurrency Currency  `json:"quote_currency" gorm:"size:3;not null;index:idx_exchange_rates_pair,priority:2"`
+	Rate          Rate      `json:"rate" gorm:"type:numeric(24,12);not null" swaggertype:"string" example:"35.5"`
+	EffectiveAt   time.Time `json:"effective_at" gorm:"not null;index:idx_exchange_rates_pair,priority:3"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+func NewExchangeRate(base, quote Currency, rate Rate, effectiveAt time.Time) (*ExchangeRate, error) {
+	if err := base.Validate(); err != nil {
+		return nil, err
+	}
+	if err := quote.Validate(); err != nil {
+		return nil, err
+	}
+	if base == quote {
+		return nil, NewError(ErrorCodeValidation, "base and quote currencies must differ")
+	}
+	if rate.IsZero() {
+		return nil, NewError(ErrorCodeValidation, "exchange rate must be positive")
+	}
+
+	return &ExchangeRate{
+		ID:            uuid.New(),
+		BaseCurrency:  base,
+		QuoteCurrency: quote,
+		Rate:          rate,
+		EffectiveAt:   effectiveAt,
+		CreatedAt:     time.Now(),
+	}, nil
+}
+
+// Inverse returns the same rate quoted the other way round, e.g. THB/USD from USD/THB.
+func (r *ExchangeRate) Inverse() *ExchangeRate {
+	return &ExchangeRate{
+		ID:            r.ID,
+		BaseCurrency:  r.QuoteCurrency,
+		QuoteCurrency: r.BaseCurrency,
+		Rate:          r.Rate.Inverse(),
+		EffectiveAt:   r.EffectiveAt,
+		CreatedAt:     r.CreatedAt,
+	}
+}
+
+// Convert converts money in the base currency into the quote currency.
+func (r *ExchangeRate) Convert(m Money) (Money, error) {
+	if m.Currency != r.BaseCurrency {
+		return Money{}, Errorf(ErrorCodeCurrencyMismatch, "cannot convert %s with a %s/%s rate", m.Currency, r.BaseCurrency, r.QuoteCurrency)
+	}
+	return m.Convert(r.QuoteCurrency, r.Rate), nil
+}
+
+// ExchangeRateProvider looks up the rate currently in effect for a currency pair.
+type ExchangeRateProvider interface {
+	GetRate(ctx context.Context, base, quote Currency) (*ExchangeRate, error)
+}
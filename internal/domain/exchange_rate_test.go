@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseRate_ShouldRejectInvalidRates(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"empty", ""},
+		{"not a number", "abc"},
+		{"zero", "0"},
+		{"negative", "-35.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, err := ParseRate(tt.input)
+
+			// Assert
+			if !errors.Is(err, ErrValidation) {
+				t.Errorf("Expected validation error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestRate_ShouldRoundTripThroughJSON(t *testing.T) {
+	// Arrange
+	rate := MustParseRate("35.125")
+
+	// Act
+	data, err := json.Marshal(rate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var decoded Rate
+	err = json.Unmarshal(data, &decoded)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(data) != `"35.125"` {
+		t.Errorf("Expected \"35.125\", got %s", data)
+	}
+	if decoded.Rat().Cmp(rate.Rat()) != 0 {
+		t.Errorf("Expected %s, got %s", rate, decoded)
+	}
+}
+
+func TestMoney_Convert_ShouldRoundHalfToEven(t *testing.T) {
+	tests := []struct {
+		name     string
+		money    Money
+		to       Currency
+		rate     string
+		expected int64
+	}{
+		{"exact", NewMoney(10000, USD), THB, "35.5", 355000},
+		{"tie rounds down to even", NewMoney(5, USD), THB, "0.5", 2},
+		{"tie rounds up to even", NewMoney(7, USD), THB, "0.5", 4},
+		{"above half rounds up", NewMoney(5, USD), THB, "0.51", 3},
+		{"below half rounds down", NewMoney(5, USD), THB, "0.49", 2},
+		{"negative tie", NewMoney(-5, USD), THB, "0.5", -2},
+		{"into zero-decimal currency", NewMoney(12345, USD), JPY, "150", 18518},
+		{"from zero-decimal currency", NewMoney(1000, JPY), USD, "0.0066667", 667},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got := tt.money.Convert(tt.to, MustParseRate(tt.rate))
+
+			// Assert
+			if got.Amount != tt.expected {
+				t.Errorf("Expected amount %d, got %d", tt.expected, got.Amount)
+			}
+			if got.Currency != tt.to {
+				t.Errorf("Expected currency %s, got %s", tt.to, got.Currency)
+			}
+		})
+	}
+}
+
+func TestNewExchangeRate_ShouldRejectInvalidPairs(t *testing.T) {
+	tests := []struct {
+		name  string
+		base  Currency
+		quote Currency
+	}{
+		{"same currency", USD, USD},
+		{"unsupported base", "XYZ", THB},
+		{"unsupported quote", USD, "XYZ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, err := NewExchangeRate(tt.base, tt.quote, MustParseRate("35.5"), time.Now())
+
+			// Assert
+			if !errors.Is(err, ErrValidation) {
+				t.Errorf("Expected validation error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestExchangeRate_Inverse_ShouldSwapCurrenciesAndInvertRate(t *testing.T) {
+	// Arrange
+	rate, _ := NewExchangeRate(USD, THB, MustParseRate("40"), time.Now())
+
+	// Act
+	inverse := rate.Inverse()
+
+	// Assert
+	if inverse.BaseCurrency != THB || inverse.QuoteCurrency != USD {
+		t.Errorf("Expected THB/USD, got %s/%s", inverse.BaseCurrency, inverse.QuoteCurrency)
+	}
+	if inverse.Rate.String() != "0.025" {
+		t.Errorf("Expected rate 0.025, got %s", inverse.Rate)
+	}
+}
+
+func TestExchangeRate_Convert_ShouldRejectOtherCurrencies(t *testing.T) {
+	// Arrange
+	rate, _ := NewExchangeRate(USD, THB, MustParseRate("35.5"), time.Now())
+
+	// Act
+	_, err := rate.Convert(NewMoney(1000, EUR))
+
+	// Assert
+	if !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected currency mismatch, got %v", err)
+	}
+}
@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func newTestAccount(t *testing.T, number, holderName string, balance Money) *Account {
+	t.Helper()
+	account, err := NewAccount(number, holderName, balance)
+	if err != nil {
+		t.Fatalf("Failed to create test account: %v", err)
+	}
+	return account
+}
+
+func newTestTransfer(t *testing.T, fromAccountID, toAccountID uuid.UUID, amount Money, description string) *Transaction {
+	t.Helper()
+	transaction, err := NewTransferTransaction(fromAccountID, toAccountID, amount, description)
+	if err != nil {
+		t.Fatalf("Failed to create test transfer: %v", err)
+	}
+	return transaction
+}
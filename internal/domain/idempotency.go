@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// IdempotencyRecord stores the outcome of a request made with an
+// Idempotency-Key so retries can be answered with the original response.
+type IdempotencyRecord struct {
+	Key          string    `json:"key" gorm:"primaryKey;size:255"`
+	RequestHash  string    `json:"request_hash" gorm:"size:64;not null"`
+	StatusCode   int       `json:"status_code"` // zero while the original request is in flight
+	ContentType  string    `json:"content_type"`
+	ResponseBody []byte    `json:"response_body"`
+	CreatedAt    time.Time `json:"created_at"`
+	ExpiresAt    time.Time `json:"expires_at" gorm:"index"`
+}
+
+func NewIdempotencyRecord(key, requestHash string, ttl time.Duration) *IdempotencyRecord {
+	now := time.Now()
+	return &IdempotencyRecord{
+		Key:         key,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(ttl),
+	}
+}
+
+func (r *IdempotencyRecord) IsCompleted() bool {
+	return r.StatusCode != 0
+}
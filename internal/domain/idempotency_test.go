@@ -0,0 +1,19 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewIdempotencyRecord_ShouldStartInFlight(t *testing.T) {
+	// Act
+	record := NewIdempotencyRecord("key-1", "hash", time.Hour)
+
+	// Assert
+	if record.IsCompleted() {
+		t.Error("Expected new record to be in flight")
+	}
+	if record.ExpiresAt.Sub(record.CreatedAt) != time.Hour {
+		t.Errorf("Expected record to expire after 1h, got %v", record.ExpiresAt.Sub(record.CreatedAt))
+	}
+}
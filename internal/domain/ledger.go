@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EntryDirection string
+
+const (
+	EntryDirectionDebit  EntryDirection = "debit"
+	EntryDirectionCredit EntryDirection = "credit"
+)
+
+// ExternalAccountID is the ledger counterparty for funds entering or leaving
+// the system through deposits and withdrawals. It has no Account row and its
+// running balance is not tracked.
+var ExternalAccountID = uuid.Nil
+
+// FXPositionAccountID is the ledger counterparty of currency conversions. It
+// takes the source currency in and pays the destination currency out, so each
+// currency balances on its own. Like ExternalAccountID it has no Account row.
+var FXPositionAccountID = uuid.MustParse("00000000-0000-0000-0000-000000000f0f")
+
+// LedgerEntry is one side of a double-entry posting. Credits increase an
+// account's balance and debits decrease it.
+type LedgerEntry struct {
+	ID            uuid.UUID      `json:"id" gorm:"type:uuid;primary_key"`
+	AccountID     uuid.UUID      `json:"account_id" gorm:"type:uuid;index"`
+	TransactionID uuid.UUID      `json:"transaction_id" gorm:"type:uuid;index"`
+	Direction     EntryDirection `json:"direction"`
+	Amount        Money          `json:"amount" gorm:"embedded"`
+	Balance       Money          `json:"balance" gorm:"embedded;embeddedPrefix:balance_"` // running balance after this entry
+	CreatedAt     time.Time      `json:"created_at"`
+}
+
+func NewLedgerEntry(accountID, transactionID uuid.UUID, direction EntryDirection, amount, balance Money) LedgerEntry {
+	return LedgerEntry{
+		ID:            uuid.New(),
+		AccountID:     accountID,
+		TransactionID: transactionID,
+		Direction:     direction,
+		Amount:        amount,
+		Balance:       balance,
+		CreatedAt:     time.Now(),
+	}
+}
+
+// SignedAmount returns the entry's effect on the account balance.
+func (e LedgerEntry) SignedAmount() int64 {
+	if e.Direction == EntryDirectionDebit {
+		return -e.Amount.Amount
+	}
+	return e.Amount.Amount
+}
+
+// NewLedgerEntries builds the balanced postings for a processed transaction.
+// from and to are the accounts after the transaction was applied; the one not
+// involved in the transaction type may be nil.
+func NewLedgerEntries(transaction *Transaction, from, to *Account) ([]LedgerEntry, error) {
+	external := Money{Currency: transaction.Amount.Currency}
+
+	var entries []LedgerEntry
+	switch transaction.Type {
+	case TransactionTypeDeposit:
+		if to == nil {
+			return nil, errors.New("deposit requires a destination account")
+		}
+		entries = []LedgerEntry{
+			NewLedgerEntry(ExternalAccountID, transaction.ID, EntryDirectionDebit, transaction.Amount, external),
+			NewLedgerEntry(to.ID, transaction.ID, EntryDirectionCredit, transaction.Amount, to.Balance),
+		}
+	case TransactionTypeWithdraw:
+		if from == nil {
+			return nil, errors.New("withdrawal requires a source account")
+		}
+		entries = []LedgerEntry{
+			NewLedgerEntry(from.ID, transaction.ID, EntryDirectionDebit, transaction.Amount, from.Balance),
+			NewLedgerEntry(ExternalAccountID, transaction.ID, EntryDirectionCredit, transaction.Amount, external),
+		}
+	case TransactionTypeTransfer:
+		if from == nil || to == nil {
+			return nil, errors.New("transfer requires both source and destination accounts")
+		}
+		credit := transaction.CreditAmount()
+		if credit.Currency == transaction.Amount.Currency {
+			entries = []LedgerEntry{
+				NewLedgerEntry(from.ID, transaction.ID, EntryDirectionDebit, transaction.Amount, from.Balance),
+				NewLedgerEntry(to.ID, transaction.ID, EntryDirectionCredit, credit, to.Balance),
+			}
+			break
+		}
+		// Route the conversion through the FX position so each currency balances.
+		entries = []LedgerEntry{
+			NewLedgerEntry(from.ID, transaction.ID, EntryDirectionDebit, transaction.Amount, from.Balance),
+			NewLedgerEntry(FXPositionAccountID, transaction.ID, EntryDirectionCredit, transaction.Amount, external),
+			NewLedgerEntry(FXPositionAccountID, transaction.ID, EntryDirectionDebit, credit, Money{Currency: credit.Currency}),
+			NewLedgerEntry(to.ID, transaction.ID, EntryDirectionCredit, credit, to.Balance),
+		}
+	default:
+		return nil, errors.New("invalid transaction type")
+	}
+
+	if err := ValidateLedgerEntries(entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// ValidateLedgerEntries checks the double-entry invariant: the entries of each
+// transaction sum to zero in every currency.
+func ValidateLedgerEntries(entries []LedgerEntry) error {
+	type key struct {
+		transactionID uuid.UUID
+		currency      Currency
+	}
+
+	sums := make(map[key]int64)
+	for _, entry := range entries {
+		if !entry.Amount.IsPositive() {
+			return errors.New("ledger entry amount must be positive")
+		}
+		sums[key{entry.TransactionID, entry.Amount.Currency}] += entry.SignedAmount()
+	}
+
+	for _, sum := range sums {
+		if sum != 0 {
+			return errors.New("ledger entries do not balance")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewLedgerEntries_ShouldBalanceEveryTransactionType(t *testing.T) {
+	from := newTestAccount(t, "12345", "John Doe", NewMoney(8000, USD))
+	to := newTestAccount(t, "67890", "Jane Smith", NewMoney(7000, USD))
+	amount := NewMoney(2000, USD)
+
+	tests := []struct {
+		name        string
+		transaction *Transaction
+		from        *Account
+		to          *Account
+	}{
+		{"deposit", NewDepositTransaction(to.ID, amount, "Deposit"), nil, to},
+		{"withdraw", NewWithdrawTransaction(from.ID, amount, "Withdraw"), from, nil},
+		{"transfer", newTestTransfer(t, from.ID, to.ID, amount, "Transfer"), from, to},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			entries, err := NewLedgerEntries(tt.transaction, tt.from, tt.to)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if len(entries) != 2 {
+				t.Fatalf("Expected 2 entries, got %d", len(entries))
+			}
+
+			var sum int64
+			for _, entry := range entries {
+				if entry.TransactionID != tt.transaction.ID {
+					t.Errorf("Expected transaction ID %s, got %s", tt.transaction.ID, entry.TransactionID)
+				}
+				sum += entry.SignedAmount()
+			}
+			if sum != 0 {
+				t.Errorf("Expected entries to sum to zero, got %d", sum)
+			}
+		})
+	}
+}
+
+func TestNewLedgerEntries_ShouldRecordRunningBalanceOfCustomerAccounts(t *testing.T) {
+	// Arrange
+	from := newTestAccount(t, "12345", "John Doe", NewMoney(8000, USD))
+	to := newTestAccount(t, "67890", "Jane Smith", NewMoney(7000, USD))
+	transaction := newTestTransfer(t, from.ID, to.ID, NewMoney(2000, USD), "Transfer")
+
+	// Act
+	entries, err := NewLedgerEntries(transaction, from, to)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	debit, credit := entries[0], entries[1]
+	if debit.AccountID != from.ID || debit.Direction != EntryDirectionDebit {
+		t.Errorf("Expected debit from source account, got %s on %s", debit.Direction, debit.AccountID)
+	}
+	if debit.Balance != from.Balance {
+		t.Errorf("Expected debit running balance %v, got %v", from.Balance, debit.Balance)
+	}
+	if credit.AccountID != to.ID || credit.Direction != EntryDirectionCredit {
+		t.Errorf("Expected credit to destination account, got %s on %s", credit.Direction, credit.AccountID)
+	}
+	if credit.Balance != to.Balance {
+		t.Errorf("Expected credit running balance %v, got %v", to.Balance, credit.Balance)
+	}
+}
+
+func TestNewLedgerEntries_ShouldPostDepositsAgainstExternalAccount(t *testing.T) {
+	// Arrange
+	to := newTestAccount(t, "67890", "Jane Smith", NewMoney(7000, USD))
+	transaction := NewDepositTransaction(to.ID, NewMoney(2000, USD), "Deposit")
+
+	// Act
+	entries, err := NewLedgerEntries(transaction, nil, to)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if entries[0].AccountID != ExternalAccountID || entries[0].Direction != EntryDirectionDebit {
+		t.Errorf("Expected external debit, got %s on %s", entries[0].Direction, entries[0].AccountID)
+	}
+}
+
+func TestNewLedgerEntries_ShouldReturnErrorWhenAccountIsMissing(t *testing.T) {
+	// Arrange
+	transaction := newTestTransfer(t, uuid.New(), uuid.New(), NewMoney(2000, USD), "Transfer")
+
+	// Act
+	_, err := NewLedgerEntries(transaction, nil, nil)
+
+	// Assert
+	if err == nil {
+		t.Error("Expected error for missing accounts, got nil")
+	}
+}
+
+func TestValidateLedgerEntries_ShouldRejectUnbalancedEntries(t *testing.T) {
+	transactionID := uuid.New()
+
+	tests := []struct {
+		name        string
+		entries     []LedgerEntry
+		expectError bool
+	}{
+		{
+			"balanced",
+			[]LedgerEntry{
+				NewLedgerEntry(uuid.New(), transactionID, EntryDirectionDebit, NewMoney(500, USD), NewMoney(0, USD)),
+				NewLedgerEntry(uuid.New(), transactionID, EntryDirectionCredit, NewMoney(500, USD), NewMoney(500, USD)),
+			},
+			false,
+		},
+		{
+			"unbalanced",
+			[]LedgerEntry{
+				NewLedgerEntry(uuid.New(), transactionID, EntryDirectionDebit, NewMoney(500, USD), NewMoney(0, USD)),
+				NewLedgerEntry(uuid.New(), transactionID, EntryDirectionCredit, NewMoney(400, USD), NewMoney(400, USD)),
+			},
+			true,
+		},
+		{
+			"balanced amounts in different currencies",
+			[]LedgerEntry{
+				NewLedgerEntry(uuid.New(), transactionID, EntryDirectionDebit, NewMoney(500, USD), NewMoney(0, USD)),
+				NewLedgerEntry(uuid.New(), transactionID, EntryDirectionCredit, NewMoney(500, THB), NewMoney(500, THB)),
+			},
+			true,
+		},
+		{
+			"non-positive amount",
+			[]LedgerEntry{
+				NewLedgerEntry(uuid.New(), transactionID, EntryDirectionDebit, NewMoney(0, USD), NewMoney(0, USD)),
+				NewLedgerEntry(uuid.New(), transactionID, EntryDirectionCredit, NewMoney(0, USD), NewMoney(0, USD)),
+			},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			err := ValidateLedgerEntries(tt.entries)
+
+			// Assert
+			if tt.expectError && err == nil {
+				t.Error("Expected error, got nil")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestNewLedgerEntries_ShouldRouteCrossCurrencyTransfersThroughFXPosition(t *testing.T) {
+	// Arrange
+	from := newTestAccount(t, "12345", "John Doe", NewMoney(8000, USD))
+	to := newTestAccount(t, "67890", "Jane Smith", NewMoney(71000, THB))
+	transaction := newTestTransfer(t, from.ID, to.ID, NewMoney(2000, USD), "Transfer")
+	rate, _ := NewExchangeRate(USD, THB, MustParseRate("35.5"), time.Now())
+	_ = transaction.ApplyExchangeRate(rate)
+
+	// Act
+	entries, err := NewLedgerEntries(transaction, from, to)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(entries) != 4 {
+		t.Fatalf("Expected 4 entries, got %d", len(entries))
+	}
+
+	fxEntries := 0
+	for _, entry := range entries {
+		if entry.AccountID == FXPositionAccountID {
+			fxEntries++
+		}
+	}
+	if fxEntries != 2 {
+		t.Errorf("Expected 2 FX position entries, got %d", fxEntries)
+	}
+
+	credit := entries[3]
+	if credit.AccountID != to.ID || credit.Amount != NewMoney(71000, THB) {
+		t.Errorf("Expected destination credit of 710.00 THB, got %s to %s", credit.Amount, credit.AccountID)
+	}
+}
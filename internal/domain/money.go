@@ -1,8 +1,9 @@
 package domain
 
 import (
-	"errors"
 	"fmt"
+	"math"
+	"math/big"
 )
 
 type Currency string
@@ -10,6 +11,10 @@ type Currency string
 const (
 	THB Currency = "THB"
 	USD Currency = "USD"
+	EUR Currency = "EUR"
+	GBP Currency = "GBP"
+	SGD Currency = "SGD"
+	JPY Currency = "JPY"
 )
 
 type Money struct {
@@ -38,7 +43,7 @@ func (m Money) IsPositive() bool {
 
 func (m Money) Add(other Money) (Money, error) {
 	if m.Currency != other.Currency {
-		return Money{}, errors.New("cannot add money with different currencies")
+		return Money{}, Errorf(ErrorCodeCurrencyMismatch, "cannot add %s to %s", other.Currency, m.Currency)
 	}
 
 	return Money{
@@ -49,7 +54,7 @@ func (m Money) Add(other Money) (Money, error) {
 
 func (m Money) Subtract(other Money) (Money, error) {
 	if m.Currency != other.Currency {
-		return Money{}, errors.New("cannot subtract money with different currencies")
+		return Money{}, Errorf(ErrorCodeCurrencyMismatch, "cannot subtract %s from %s", other.Currency, m.Currency)
 	}
 
 	return Money{
@@ -58,10 +63,78 @@ func (m Money) Subtract(other Money) (Money, error) {
 	}, nil
 }
 
+// Convert returns the amount in another currency at the given rate, adjusting
+// for the difference in minor units. The result is rounded half to even so
+// that conversions are deterministic and unbiased.
+func (m Money) Convert(to Currency, rate Rate) Money {
+	value := new(big.Rat).SetInt64(m.Amount)
+	value.Mul(value, rate.Rat())
+
+	shift := to.Exponent() - m.Currency.Exponent()
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(shift))), nil))
+	if shift >= 0 {
+		value.Mul(value, scale)
+	} else {
+		value.Quo(value, scale)
+	}
+
+	return Money{
+		Amount:   roundHalfEven(value),
+		Currency: to,
+	}
+}
+
+// Validate checks that the money is in a supported currency.
+func (m Money) Validate() error {
+	return m.Currency.Validate()
+}
+
+// String formats the amount in major units using the currency's exponent.
 func (m Money) String() string {
-	return fmt.Sprintf("%.2f %s", float64(m.Amount)/100, m.Currency)
+	exponent := m.Currency.Exponent()
+	if exponent == 0 {
+		return fmt.Sprintf("%d %s", m.Amount, m.Currency)
+	}
+
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	unit := int64(math.Pow10(exponent))
+	minor := fmt.Sprintf("%0*d", exponent, amount%unit)
+	return fmt.Sprintf("%s%d.%s %s", sign, amount/unit, minor, m.Currency)
 }
 
 func (m Money) ToFloat() float64 {
-	return float64(m.Amount) / 100
+	return float64(m.Amount) / math.Pow10(m.Currency.Exponent())
+}
+
+// roundHalfEven rounds to the nearest integer, resolving ties to the even neighbour.
+func roundHalfEven(value *big.Rat) int64 {
+	quotient, remainder := new(big.Int).QuoRem(value.Num(), value.Denom(), new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient.Int64()
+	}
+
+	// Compare twice the remainder with the denominator to find which side of .5 we are on.
+	twice := new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2))
+	cmp := twice.Cmp(value.Denom())
+	if cmp > 0 || (cmp == 0 && quotient.Bit(0) == 1) {
+		if value.Sign() < 0 {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+	return quotient.Int64()
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
 }
@@ -240,6 +240,8 @@ func TestMoney_String_ShouldReturnFormattedString(t *testing.T) {
 		{"positive USD", NewMoney(12345, USD), "123.45 USD"},
 		{"zero THB", NewMoney(0, THB), "0.00 THB"},
 		{"negative USD", NewMoney(-5432, USD), "-54.32 USD"},
+		{"small amount USD", NewMoney(5, USD), "0.05 USD"},
+		{"zero-decimal JPY", NewMoney(1500, JPY), "1500 JPY"},
 	}
 
 	for _, tt := range tests {
@@ -267,6 +269,7 @@ func TestMoney_ToFloat_ShouldConvertAmountToFloat(t *testing.T) {
 		{"positive amount", NewMoney(12345, USD), 123.45},
 		{"zero amount", NewMoney(0, USD), 0.0},
 		{"negative amount", NewMoney(-5432, USD), -54.32},
+		{"zero-decimal currency", NewMoney(1500, JPY), 1500},
 	}
 
 	for _, tt := range tests {
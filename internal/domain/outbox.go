@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxEvent is a domain event waiting in the outbox table to be published.
+// It is written in the same database transaction as the change that raised
+// it, so an event is stored if and only if the change is committed.
+type OutboxEvent struct {
+	ID            uuid.UUID       `json:"id" gorm:"type:uuid;primaryKey"`
+	EventType     string          `json:"event_type"`
+	AggregateID   uuid.UUID       `json:"aggregate_id" gorm:"type:uuid"`
+	Payload       json.RawMessage `json:"payload" gorm:"type:jsonb"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+	Attempts      int             `json:"attempts"`
+	LastError     string          `json:"last_error,omitempty"`
+	NextAttemptAt time.Time       `json:"next_attempt_at"`
+	PublishedAt   *time.Time      `json:"published_at,omitempty"`
+}
+
+func NewOutboxEvent(event Event) (*OutboxEvent, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OutboxEvent{
+		ID:            uuid.New(),
+		EventType:     event.EventName(),
+		AggregateID:   event.AggregateID(),
+		Payload:       payload,
+		OccurredAt:    event.OccurredAt(),
+		NextAttemptAt: event.OccurredAt(),
+	}, nil
+}
+
+func (e *OutboxEvent) MarkPublished(at time.Time) {
+	e.PublishedAt = &at
+	e.LastError = ""
+}
+
+// MarkFailed records a failed delivery attempt and schedules the next one.
+func (e *OutboxEvent) MarkFailed(err error, nextAttemptAt time.Time) {
+	e.Attempts++
+	e.LastError = err.Error()
+	e.NextAttemptAt = nextAttemptAt
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"context"
+	"slices"
+)
+
+// Role grants a set of rights to the principals holding it.
+type Role string
+
+const (
+	RoleAdmin    Role = "admin"
+	RoleTeller   Role = "teller"
+	RoleAuditor  Role = "auditor"
+	RoleCustomer Role = "customer"
+)
+
+var roles = []Role{RoleAdmin, RoleTeller, RoleAuditor, RoleCustomer}
+
+func (r Role) Validate() error {
+	if !slices.Contains(roles, r) {
+		return Errorf(ErrorCodeValidation, "invalid role %q, must be one of %v", string(r), roles)
+	}
+	return nil
+}
+
+type AuthMethod string
+
+const (
+	AuthMethodAPIKey AuthMethod = "api_key"
+	AuthMethodJWT    AuthMethod = "jwt"
+)
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	// Subject identifies the caller, e.g. the sub claim of a token or the
+	// subject an API key was issued for.
+	Subject string
+	Roles   []Role
+	Method  AuthMethod
+}
+
+func (p *Principal) HasRole(role Role) bool {
+	return slices.Contains(p.Roles, role)
+}
+
+// HasAnyRole reports whether the principal holds at least one of the roles.
+func (p *Principal) HasAnyRole(roles ...Role) bool {
+	return slices.ContainsFunc(roles, p.HasRole)
+}
+
+type principalKey struct{}
+
+// ContextWithPrincipal returns a copy of ctx carrying the principal.
+func ContextWithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal of the request ctx belongs to, if
+// it was authenticated.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
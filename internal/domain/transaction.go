@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type TransactionType string
@@ -23,52 +24,145 @@ const (
 	TransactionStatusCancelled TransactionStatus = "cancelled"
 )
 
+// IsValid reports whether t is a known transaction type.
+func (t TransactionType) IsValid() bool {
+	switch t {
+	case TransactionTypeDeposit, TransactionTypeWithdraw, TransactionTypeTransfer:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether s is a known transaction status.
+func (s TransactionStatus) IsValid() bool {
+	switch s {
+	case TransactionStatusPending, TransactionStatusCompleted, TransactionStatusFailed, TransactionStatusCancelled:
+		return true
+	}
+	return false
+}
+
 type Transaction struct {
 	ID                uuid.UUID         `json:"id" gorm:"type:uuid;primary_key"`
 	Type              TransactionType   `json:"type"`
 	Status            TransactionStatus `json:"status"`
 	Amount            Money             `json:"amount" gorm:"embedded"`
+	DestinationAmount Money             `json:"destination_amount" gorm:"embedded;embeddedPrefix:destination_"`
+	ExchangeRate      *Rate             `json:"exchange_rate,omitempty" gorm:"type:numeric(24,12)" swaggertype:"string"`
+	RateTimestamp     *time.Time        `json:"rate_timestamp,omitempty"`
 	FromAccountID     *uuid.UUID        `json:"from_account_id,omitempty" gorm:"type:uuid;index"`
 	ToAccountID       *uuid.UUID        `json:"to_account_id,omitempty" gorm:"type:uuid;index"`
 	FromAccount       *Account          `json:"from_account,omitempty" gorm:"foreignKey:FromAccountID;references:ID"`
 	ToAccount         *Account          `json:"to_account,omitempty" gorm:"foreignKey:ToAccountID;references:ID"`
 	Description       string            `json:"description"`
-	Reference         string            `json:"reference" gorm:"uniqueIndex"`
+	Reference         *string           `json:"reference,omitempty" gorm:"uniqueIndex"` // NULL when none was given, so that only given references must be unique
 	ProcessedAt       *time.Time        `json:"processed_at,omitempty"`
 	CreatedAt         time.Time         `json:"created_at"`
 	UpdatedAt         time.Time         `json:"updated_at"`
+	DeletedAt         gorm.DeletedAt    `json:"deleted_at,omitempty" gorm:"index" swaggertype:"string" format:"date-time"`
+
+	aggregate
 }
 
 func NewTransaction(txType TransactionType, amount Money, description string) *Transaction {
 	now := time.Now()
 	return &Transaction{
-		ID:          uuid.New(),
-		Type:        txType,
-		Status:      TransactionStatusPending,
-		Amount:      amount,
-		Description: description,
-		CreatedAt:   now,
-		UpdatedAt:   now,
+		ID:                uuid.New(),
+		Type:              txType,
+		Status:            TransactionStatusPending,
+		Amount:            amount,
+		DestinationAmount: amount,
+		Description:       description,
+		CreatedAt:         now,
+		UpdatedAt:         now,
 	}
 }
 
 func NewDepositTransaction(toAccountID uuid.UUID, amount Money, description string) *Transaction {
 	tx := NewTransaction(TransactionTypeDeposit, amount, description)
 	tx.ToAccountID = &toAccountID
+	tx.record(TransactionCreated{tx.event(tx.CreatedAt)})
 	return tx
 }
 
 func NewWithdrawTransaction(fromAccountID uuid.UUID, amount Money, description string) *Transaction {
 	tx := NewTransaction(TransactionTypeWithdraw, amount, description)
 	tx.FromAccountID = &fromAccountID
+	tx.record(TransactionCreated{tx.event(tx.CreatedAt)})
 	return tx
 }
 
-func NewTransferTransaction(fromAccountID, toAccountID uuid.UUID, amount Money, description string) *Transaction {
+// NewTransferTransaction creates a pending transfer between two different
+// accounts. It fails when the transfer would not pass Validate.
+func NewTransferTransaction(fromAccountID, toAccountID uuid.UUID, amount Money, description string) (*Transaction, error) {
 	tx := NewTransaction(TransactionTypeTransfer, amount, description)
 	tx.FromAccountID = &fromAccountID
 	tx.ToAccountID = &toAccountID
-	return tx
+	if err := tx.Validate(); err != nil {
+		return nil, err
+	}
+	tx.record(TransactionCreated{tx.event(tx.CreatedAt)})
+	return tx, nil
+}
+
+// Validate checks the invariants the database also enforces: a positive amount
+// in a supported currency, and the accounts required by the transaction type,
+// with a transfer never moving money from an account to itself.
+func (t *Transaction) Validate() error {
+	if err := t.Amount.Validate(); err != nil {
+		return err
+	}
+	if !t.Amount.IsPositive() {
+		return NewError(ErrorCodeValidation, "amount must be positive")
+	}
+
+	switch t.Type {
+	case TransactionTypeDeposit:
+		if t.ToAccountID == nil || t.FromAccountID != nil {
+			return NewError(ErrorCodeValidation, "deposit requires only a destination account")
+		}
+	case TransactionTypeWithdraw:
+		if t.FromAccountID == nil || t.ToAccountID != nil {
+			return NewError(ErrorCodeValidation, "withdrawal requires only a source account")
+		}
+	case TransactionTypeTransfer:
+		if t.FromAccountID == nil || t.ToAccountID == nil {
+			return NewError(ErrorCodeValidation, "transfer requires both source and destination accounts")
+		}
+		if *t.FromAccountID == *t.ToAccountID {
+			return NewError(ErrorCodeValidation, "cannot transfer to the same account")
+		}
+	default:
+		return NewError(ErrorCodeValidation, "invalid transaction type")
+	}
+
+	return nil
+}
+
+// ApplyExchangeRate converts the source amount of a cross-currency transfer
+// and records the destination amount together with the rate used.
+func (t *Transaction) ApplyExchangeRate(rate *ExchangeRate) error {
+	destination, err := rate.Convert(t.Amount)
+	if err != nil {
+		return err
+	}
+
+	applied := rate.Rate
+	timestamp := rate.EffectiveAt
+	t.DestinationAmount = destination
+	t.ExchangeRate = &applied
+	t.RateTimestamp = &timestamp
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// CreditAmount is the amount received by the destination account. It equals
+// Amount unless an exchange rate was applied.
+func (t *Transaction) CreditAmount() Money {
+	if t.DestinationAmount.Currency == "" {
+		return t.Amount
+	}
+	return t.DestinationAmount
 }
 
 func (t *Transaction) Complete() {
@@ -76,6 +170,7 @@ func (t *Transaction) Complete() {
 	t.Status = TransactionStatusCompleted
 	t.ProcessedAt = &now
 	t.UpdatedAt = now
+	t.record(TransactionCompleted{TransactionEvent: t.event(now), CreditAmount: t.CreditAmount()})
 }
 
 func (t *Transaction) Fail() {
@@ -83,14 +178,34 @@ func (t *Transaction) Fail() {
 	t.Status = TransactionStatusFailed
 	t.ProcessedAt = &now
 	t.UpdatedAt = now
+	t.record(TransactionFailed{t.event(now)})
 }
 
 func (t *Transaction) Cancel() {
+	now := time.Now()
 	t.Status = TransactionStatusCancelled
-	t.UpdatedAt = time.Now()
+	t.UpdatedAt = now
+	t.record(TransactionCancelled{t.event(now)})
 }
 
+// event returns the fields shared by the events of t, as of at.
+func (t *Transaction) event(at time.Time) TransactionEvent {
+	return TransactionEvent{
+		TransactionID: t.ID,
+		Type:          t.Type,
+		Amount:        t.Amount,
+		FromAccountID: t.FromAccountID,
+		ToAccountID:   t.ToAccountID,
+		Time:          at,
+	}
+}
+
+// SetReference sets the reference of the transaction, clearing it when ref is
+// empty.
 func (t *Transaction) SetReference(ref string) {
-	t.Reference = ref
+	t.Reference = nil
+	if ref != "" {
+		t.Reference = &ref
+	}
 	t.UpdatedAt = time.Now()
 }
\ No newline at end of file
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -108,9 +109,12 @@ func TestNewTransferTransaction_ShouldCreateTransferTransaction(t *testing.T) {
 	description := "Transfer transaction"
 
 	// Act
-	tx := NewTransferTransaction(fromAccountID, toAccountID, amount, description)
+	tx, err := NewTransferTransaction(fromAccountID, toAccountID, amount, description)
 
 	// Assert
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
 	if tx.Type != TransactionTypeTransfer {
 		t.Errorf("Expected type %s, got %s", TransactionTypeTransfer, tx.Type)
 	}
@@ -128,6 +132,101 @@ func TestNewTransferTransaction_ShouldCreateTransferTransaction(t *testing.T) {
 	}
 }
 
+func TestNewTransferTransaction_ShouldRejectInvalidTransfer(t *testing.T) {
+	accountID := uuid.New()
+
+	tests := []struct {
+		name          string
+		fromAccountID uuid.UUID
+		toAccountID   uuid.UUID
+		amount        Money
+	}{
+		{"same account", accountID, accountID, NewMoney(1000, USD)},
+		{"zero amount", uuid.New(), uuid.New(), NewMoney(0, USD)},
+		{"negative amount", uuid.New(), uuid.New(), NewMoney(-1000, USD)},
+		{"unsupported currency", uuid.New(), uuid.New(), NewMoney(1000, Currency("XXX"))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			tx, err := NewTransferTransaction(tt.fromAccountID, tt.toAccountID, tt.amount, "Transfer")
+
+			// Assert
+			if !errors.Is(err, ErrValidation) {
+				t.Errorf("Expected validation error, got %v", err)
+			}
+			if tx != nil {
+				t.Error("Expected no transaction to be created")
+			}
+		})
+	}
+}
+
+func TestTransaction_Validate_ShouldRequireAccountsForType(t *testing.T) {
+	accountID := uuid.New()
+
+	tests := []struct {
+		name        string
+		transaction *Transaction
+		expectError bool
+	}{
+		{"valid deposit", NewDepositTransaction(accountID, NewMoney(1000, USD), "Deposit"), false},
+		{"valid withdrawal", NewWithdrawTransaction(accountID, NewMoney(1000, USD), "Withdraw"), false},
+		{"deposit with zero amount", NewDepositTransaction(accountID, NewMoney(0, USD), "Deposit"), true},
+		{"withdrawal with negative amount", NewWithdrawTransaction(accountID, NewMoney(-1000, USD), "Withdraw"), true},
+		{"deposit without destination", NewTransaction(TransactionTypeDeposit, NewMoney(1000, USD), "Deposit"), true},
+		{"withdrawal without source", NewTransaction(TransactionTypeWithdraw, NewMoney(1000, USD), "Withdraw"), true},
+		{"unknown type", NewTransaction(TransactionType("refund"), NewMoney(1000, USD), "Refund"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			err := tt.transaction.Validate()
+
+			// Assert
+			if tt.expectError && !errors.Is(err, ErrValidation) {
+				t.Errorf("Expected validation error, got %v", err)
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestTransaction_ApplyExchangeRate_ShouldRecordConversion(t *testing.T) {
+	// Arrange
+	tx := newTestTransfer(t, uuid.New(), uuid.New(), NewMoney(10000, USD), "FX transfer")
+	effectiveAt := time.Now().Add(-time.Minute)
+	rate, _ := NewExchangeRate(USD, THB, MustParseRate("35.5"), effectiveAt)
+
+	// Act
+	err := tx.ApplyExchangeRate(rate)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if tx.Amount != NewMoney(10000, USD) {
+		t.Errorf("Expected source amount to be unchanged, got %s", tx.Amount)
+	}
+
+	if tx.CreditAmount() != NewMoney(355000, THB) {
+		t.Errorf("Expected destination amount 3550.00 THB, got %s", tx.CreditAmount())
+	}
+
+	if tx.ExchangeRate == nil || tx.ExchangeRate.String() != "35.5" {
+		t.Errorf("Expected applied rate 35.5, got %v", tx.ExchangeRate)
+	}
+
+	if tx.RateTimestamp == nil || !tx.RateTimestamp.Equal(effectiveAt) {
+		t.Errorf("Expected rate timestamp %v, got %v", effectiveAt, tx.RateTimestamp)
+	}
+}
+
 func TestTransaction_Complete_ShouldSetStatusToCompletedAndProcessedAt(t *testing.T) {
 	tx := NewTransaction(TransactionTypeDeposit, NewMoney(1000, USD), "Test")
 	
@@ -200,8 +299,8 @@ func TestTransaction_SetReference_ShouldSetTransactionReference(t *testing.T) {
 	
 	tx.SetReference(reference)
 	
-	if tx.Reference != reference {
-		t.Errorf("Expected reference %s, got %s", reference, tx.Reference)
+	if tx.Reference == nil || *tx.Reference != reference {
+		t.Errorf("Expected reference %s, got %v", reference, tx.Reference)
 	}
 	
 	if tx.UpdatedAt.Equal(initialUpdatedAt) {
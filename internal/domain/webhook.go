@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// minWebhookSecretLength keeps signing secrets long enough to resist guessing.
+const minWebhookSecretLength = 16
+
+// EventTypes is a list of event names stored as a JSON array.
+type EventTypes []string
+
+func (t EventTypes) Value() (driver.Value, error) {
+	if t == nil {
+		return "[]", nil
+	}
+	b, err := json.Marshal([]string(t))
+	return string(b), err
+}
+
+func (t *EventTypes) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		return json.Unmarshal([]byte(v), t)
+	case []byte:
+		return json.Unmarshal(v, t)
+	default:
+		return fmt.Errorf("cannot scan %T into EventTypes", src)
+	}
+}
+
+// WebhookSubscription asks for the events of the listed types to be POSTed to
+// URL, signed with Secret.
+type WebhookSubscription struct {
+	ID         uuid.UUID  `json:"id" gorm:"type:uuid;primaryKey"`
+	URL        string     `json:"url" example:"https://example.com/hooks/gocrud"`
+	EventTypes EventTypes `json:"event_types" gorm:"type:jsonb" swaggertype:"array,string" example:"transaction.completed,account.blocked"`
+	Secret     string     `json:"-"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+func NewWebhookSubscription(rawURL string, eventTypes []string, secret string) (*WebhookSubscription, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, Errorf(ErrorCodeValidation, "webhook url must be an absolute http or https URL, got %q", rawURL)
+	}
+	if len(eventTypes) == 0 {
+		return nil, NewError(ErrorCodeValidation, "at least one event type is required")
+	}
+	for _, eventType := range eventTypes {
+		if !slices.Contains(EventNames, eventType) {
+			return nil, Errorf(ErrorCodeValidation, "unknown event type %q, must be one of %v", eventType, EventNames)
+		}
+	}
+	if len(secret) < minWebhookSecretLength {
+		return nil, Errorf(ErrorCodeValidation, "webhook secret must be at least %d characters", minWebhookSecretLength)
+	}
+
+	now := time.Now()
+	return &WebhookSubscription{
+		ID:         uuid.New(),
+		URL:        rawURL,
+		EventTypes: slices.Compact(slices.Sorted(slices.Values(eventTypes))),
+		Secret:     secret,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, nil
+}
+
+// Subscribes reports whether events of the type are delivered to the subscription.
+func (s *WebhookSubscription) Subscribes(eventType string) bool {
+	return slices.Contains(s.EventTypes, eventType)
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery is one event sent, or to be sent, to one subscription. It
+// doubles as the delivery log: the outcome of the latest attempt is kept with
+// the request body so that the delivery can be replayed as it was.
+type WebhookDelivery struct {
+	ID             uuid.UUID             `json:"id" gorm:"type:uuid;primaryKey"`
+	SubscriptionID uuid.UUID             `json:"subscription_id" gorm:"type:uuid"`
+	EventID        uuid.UUID             `json:"event_id" gorm:"type:uuid"`
+	EventType      string                `json:"event_type"`
+	Payload        json.RawMessage       `json:"payload" gorm:"type:jsonb" swaggertype:"object"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int                   `json:"attempts"`
+	ResponseStatus int                   `json:"response_status,omitempty"` // HTTP status of the latest attempt, 0 if none was received
+	LastError      string                `json:"last_error,omitempty"`
+	NextAttemptAt  time.Time             `json:"next_attempt_at"`
+	DeliveredAt    *time.Time            `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time             `json:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+}
+
+// webhookPayload is the body POSTed for an event.
+type webhookPayload struct {
+	ID         uuid.UUID       `json:"id"`
+	Type       string          `json:"type"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// NewWebhookDelivery schedules the outbox event for immediate delivery to the
+// subscription.
+func NewWebhookDelivery(subscriptionID uuid.UUID, event OutboxEvent) (*WebhookDelivery, error) {
+	payload, err := json.Marshal(webhookPayload{
+		ID:         event.ID,
+		Type:       event.EventType,
+		OccurredAt: event.OccurredAt,
+		Data:       event.Payload,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &WebhookDelivery{
+		ID:             uuid.New(),
+		SubscriptionID: subscriptionID,
+		EventID:        event.ID,
+		EventType:      event.EventType,
+		Payload:        payload,
+		Status:         WebhookDeliveryStatusPending,
+		NextAttemptAt:  now,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}, nil
+}
+
+func (d *WebhookDelivery) MarkSucceeded(responseStatus int, at time.Time) {
+	d.Attempts++
+	d.Status = WebhookDeliveryStatusSucceeded
+	d.ResponseStatus = responseStatus
+	d.LastError = ""
+	d.DeliveredAt = &at
+}
+
+// MarkFailed records a failed attempt. The delivery is retried at
+// nextAttemptAt, unless it has used up maxAttempts and is marked failed.
+func (d *WebhookDelivery) MarkFailed(responseStatus int, err error, nextAttemptAt time.Time, maxAttempts int) {
+	d.Attempts++
+	d.ResponseStatus = responseStatus
+	d.LastError = err.Error()
+	d.NextAttemptAt = nextAttemptAt
+	if d.Attempts >= maxAttempts {
+		d.Status = WebhookDeliveryStatusFailed
+	}
+}
+
+// Replay schedules the delivery to be sent again at once with a fresh retry
+// budget, whatever the outcome of the previous attempts.
+func (d *WebhookDelivery) Replay(at time.Time) {
+	d.Status = WebhookDeliveryStatusPending
+	d.Attempts = 0
+	d.LastError = ""
+	d.NextAttemptAt = at
+	d.DeliveredAt = nil
+}
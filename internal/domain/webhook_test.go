@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewWebhookSubscription_ShouldValidateInput(t *testing.T) {
+	tests := []struct {
+		name       string
+		url        string
+		eventTypes []string
+		secret     string
+	}{
+		{"relative url", "/hooks", []string{"account.blocked"}, "0123456789abcdef"},
+		{"unsupported scheme", "ftp://example.com/hooks", []string{"account.blocked"}, "0123456789abcdef"},
+		{"no event types", "https://example.com/hooks", nil, "0123456789abcdef"},
+		{"unknown event type", "https://example.com/hooks", []string{"account.renamed"}, "0123456789abcdef"},
+		{"short secret", "https://example.com/hooks", []string{"account.blocked"}, "secret"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, err := NewWebhookSubscription(tt.url, tt.eventTypes, tt.secret)
+
+			// Assert
+			if !errors.Is(err, ErrValidation) {
+				t.Errorf("Expected validation error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestWebhookDelivery_MarkFailed_ShouldFailOnceAttemptsAreUsedUp(t *testing.T) {
+	// Arrange
+	delivery, _ := NewWebhookDelivery(uuid.New(), OutboxEvent{ID: uuid.New(), EventType: "account.closed", Payload: []byte(`{}`)})
+	next := time.Now().Add(time.Minute)
+
+	// Act
+	delivery.MarkFailed(502, errors.New("receiver answered 502 Bad Gateway"), next, 2)
+	afterFirst := delivery.Status
+	delivery.MarkFailed(0, errors.New("connection refused"), next, 2)
+
+	// Assert
+	if afterFirst != WebhookDeliveryStatusPending {
+		t.Errorf("Expected the delivery to stay pending after the first failure, got %s", afterFirst)
+	}
+	if delivery.Status != WebhookDeliveryStatusFailed || delivery.Attempts != 2 || delivery.LastError != "connection refused" {
+		t.Errorf("Expected the delivery to fail after 2 attempts, got %+v", delivery)
+	}
+
+	// Act
+	delivery.Replay(next)
+
+	// Assert
+	if delivery.Status != WebhookDeliveryStatusPending || delivery.Attempts != 0 || !delivery.NextAttemptAt.Equal(next) {
+		t.Errorf("Expected the replayed delivery to be pending again, got %+v", delivery)
+	}
+}
+
+func TestNewWebhookDelivery_ShouldWrapEventInEnvelope(t *testing.T) {
+	// Arrange
+	event := OutboxEvent{ID: uuid.New(), EventType: "account.blocked", Payload: []byte(`{"reason":"fraud_suspected"}`), OccurredAt: time.Now().UTC()}
+
+	// Act
+	delivery, err := NewWebhookDelivery(uuid.New(), event)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var envelope struct {
+		ID   uuid.UUID       `json:"id"`
+		Type string          `json:"type"`
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(delivery.Payload, &envelope); err != nil {
+		t.Fatalf("Failed to decode payload: %v", err)
+	}
+	if envelope.ID != event.ID || envelope.Type != event.EventType || string(envelope.Data) != string(event.Payload) {
+		t.Errorf("Expected the event in the envelope, got %+v", envelope)
+	}
+}
+
+func TestEventTypes_ShouldRoundTripThroughDatabaseValue(t *testing.T) {
+	// Arrange
+	types := EventTypes{"account.blocked", "transaction.failed"}
+
+	// Act
+	value, err := types.Value()
+	var scanned EventTypes
+	scanErr := scanned.Scan([]byte(value.(string)))
+
+	// Assert
+	if err != nil || scanErr != nil {
+		t.Fatalf("Unexpected errors: %v, %v", err, scanErr)
+	}
+	if len(scanned) != 2 || scanned[0] != "account.blocked" || scanned[1] != "transaction.failed" {
+		t.Errorf("Expected %v, got %v", types, scanned)
+	}
+}
@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"arise_tech_assessment/internal/config"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// claims are the JWT claims read from bearer tokens. Roles unknown to the
+// service are ignored.
+type claims struct {
+	jwt.RegisteredClaims
+	Roles []string `json:"roles"`
+}
+
+// Authenticator verifies API keys against their stored hashes and bearer
+// tokens against a JWKS file.
+type Authenticator struct {
+	apiKeys repository.APIKeyRepository
+	keys    *KeySet // nil when bearer tokens are not accepted
+	parser  *jwt.Parser
+	now     func() time.Time
+}
+
+// NewAuthenticator loads the JWKS file named in cfg, if any.
+func NewAuthenticator(apiKeys repository.APIKeyRepository, cfg config.AuthConfig) (*Authenticator, error) {
+	a := &Authenticator{
+		apiKeys: apiKeys,
+		now:     time.Now,
+	}
+
+	if cfg.JWKSFile != "" {
+		keys, err := LoadKeySet(cfg.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		a.keys = keys
+	}
+
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"RS256", "HS256"}),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(cfg.Leeway),
+		jwt.WithTimeFunc(func() time.Time { return a.now() }),
+	}
+	if cfg.Issuer != "" {
+		options = append(options, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		options = append(options, jwt.WithAudience(cfg.Audience))
+	}
+	a.parser = jwt.NewParser(options...)
+
+	return a, nil
+}
+
+func (a *Authenticator) AuthenticateAPIKey(ctx context.Context, key string) (*domain.Principal, error) {
+	if !strings.HasPrefix(key, domain.APIKeyPrefix) {
+		return nil, domain.NewError(domain.ErrorCodeUnauthenticated, "invalid api key")
+	}
+
+	apiKey, err := a.apiKeys.FindByHash(ctx, domain.HashAPIKey(key))
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, domain.NewError(domain.ErrorCodeUnauthenticated, "invalid api key")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !apiKey.IsActive(a.now()) {
+		return nil, domain.NewError(domain.ErrorCodeUnauthenticated, "api key is revoked or expired")
+	}
+
+	return apiKey.Principal(), nil
+}
+
+func (a *Authenticator) AuthenticateBearer(ctx context.Context, token string) (*domain.Principal, error) {
+	if a.keys == nil {
+		return nil, domain.NewError(domain.ErrorCodeUnauthenticated, "bearer tokens are not accepted, use an api key")
+	}
+
+	var c claims
+	_, err := a.parser.ParseWithClaims(token, &c, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return a.keys.find(kid, t.Method.Alg())
+	})
+	if err != nil {
+		return nil, domain.Errorf(domain.ErrorCodeUnauthenticated, "invalid bearer token: %v", err)
+	}
+	if c.Subject == "" {
+		return nil, domain.NewError(domain.ErrorCodeUnauthenticated, "invalid bearer token: sub claim is required")
+	}
+
+	principal := &domain.Principal{Subject: c.Subject, Method: domain.AuthMethodJWT}
+	for _, role := range c.Roles {
+		if domain.Role(role).Validate() == nil && !slices.Contains(principal.Roles, domain.Role(role)) {
+			principal.Roles = append(principal.Roles, domain.Role(role))
+		}
+	}
+	return principal, nil
+}
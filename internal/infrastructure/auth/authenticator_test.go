@@ -0,0 +1,226 @@
+package auth
+
+import (
+	"arise_tech_assessment/internal/config"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/mocks"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/mock"
+)
+
+var hmacSecret = []byte("0123456789abcdef0123456789abcdef")
+
+// writeJWKS writes a key set holding the public half of rsaKey as "rsa-1" and
+// hmacSecret as "hmac-1", and returns its path.
+func writeJWKS(t *testing.T, rsaKey *rsa.PrivateKey) string {
+	t.Helper()
+	encode := base64.RawURLEncoding.EncodeToString
+	jwks := map[string]any{"keys": []map[string]string{
+		{"kty": "RSA", "kid": "rsa-1", "use": "sig", "n": encode(rsaKey.N.Bytes()), "e": encode(big.NewInt(int64(rsaKey.E)).Bytes())},
+		{"kty": "oct", "kid": "hmac-1", "alg": "HS256", "k": encode(hmacSecret)},
+		{"kty": "RSA", "kid": "enc-1", "use": "enc"},
+	}}
+	content, _ := json.Marshal(jwks)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		t.Fatalf("Failed to write JWKS: %v", err)
+	}
+	return path
+}
+
+func newTestAuthenticator(t *testing.T, rsaKey *rsa.PrivateKey) (*Authenticator, *mocks.MockAPIKeyRepository) {
+	t.Helper()
+	apiKeys := mocks.NewMockAPIKeyRepository(t)
+	a, err := NewAuthenticator(apiKeys, config.AuthConfig{
+		JWKSFile: writeJWKS(t, rsaKey),
+		Issuer:   "https://issuer.example.com",
+		Audience: "gocrud",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create authenticator: %v", err)
+	}
+	return a, apiKeys
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, kid string, key any, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("Failed to sign token: %v", err)
+	}
+	return signed
+}
+
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":   "alice",
+		"iss":   "https://issuer.example.com",
+		"aud":   "gocrud",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"roles": []string{"teller", "superuser", "teller"},
+	}
+}
+
+func TestAuthenticator_AuthenticateBearer_ShouldAcceptSignedTokens(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	tests := []struct {
+		name   string
+		method jwt.SigningMethod
+		kid    string
+		key    any
+	}{
+		{name: "RS256 with kid", method: jwt.SigningMethodRS256, kid: "rsa-1", key: rsaKey},
+		{name: "RS256 without kid", method: jwt.SigningMethodRS256, key: rsaKey},
+		{name: "HS256", method: jwt.SigningMethodHS256, kid: "hmac-1", key: hmacSecret},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			a, _ := newTestAuthenticator(t, rsaKey)
+			token := sign(t, tt.method, tt.kid, tt.key, validClaims())
+
+			// Act
+			principal, err := a.AuthenticateBearer(context.Background(), token)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if principal.Subject != "alice" || principal.Method != domain.AuthMethodJWT {
+				t.Errorf("Expected alice authenticated by JWT, got %+v", principal)
+			}
+			if len(principal.Roles) != 1 || !principal.HasRole(domain.RoleTeller) {
+				t.Errorf("Expected only the known teller role, got %v", principal.Roles)
+			}
+		})
+	}
+}
+
+func TestAuthenticator_AuthenticateBearer_ShouldRejectInvalidTokens(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	otherKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	with := func(key string, value any) jwt.MapClaims {
+		claims := validClaims()
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+		return claims
+	}
+	tests := []struct {
+		name   string
+		method jwt.SigningMethod
+		key    any
+		claims jwt.MapClaims
+	}{
+		{name: "unknown key", method: jwt.SigningMethodRS256, key: otherKey, claims: validClaims()},
+		{name: "expired", method: jwt.SigningMethodRS256, key: rsaKey, claims: with("exp", time.Now().Add(-time.Hour).Unix())},
+		{name: "no expiry", method: jwt.SigningMethodRS256, key: rsaKey, claims: with("exp", nil)},
+		{name: "other issuer", method: jwt.SigningMethodRS256, key: rsaKey, claims: with("iss", "https://evil.example.com")},
+		{name: "other audience", method: jwt.SigningMethodRS256, key: rsaKey, claims: with("aud", "another-service")},
+		{name: "no subject", method: jwt.SigningMethodRS256, key: rsaKey, claims: with("sub", nil)},
+		{name: "unsupported algorithm", method: jwt.SigningMethodHS512, key: hmacSecret, claims: validClaims()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			a, _ := newTestAuthenticator(t, rsaKey)
+			token := sign(t, tt.method, "", tt.key, tt.claims)
+
+			// Act
+			_, err := a.AuthenticateBearer(context.Background(), token)
+
+			// Assert
+			if !errors.Is(err, domain.ErrUnauthenticated) {
+				t.Errorf("Expected unauthenticated error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestAuthenticator_AuthenticateBearer_ShouldRejectTokensWithoutJWKS(t *testing.T) {
+	// Arrange
+	a, err := NewAuthenticator(mocks.NewMockAPIKeyRepository(t), config.AuthConfig{})
+	if err != nil {
+		t.Fatalf("Failed to create authenticator: %v", err)
+	}
+	token := sign(t, jwt.SigningMethodHS256, "", hmacSecret, validClaims())
+
+	// Act
+	_, err = a.AuthenticateBearer(context.Background(), token)
+
+	// Assert
+	if !errors.Is(err, domain.ErrUnauthenticated) {
+		t.Errorf("Expected unauthenticated error, got %v", err)
+	}
+}
+
+func TestAuthenticator_AuthenticateAPIKey_ShouldAcceptActiveKey(t *testing.T) {
+	// Arrange
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	a, apiKeys := newTestAuthenticator(t, rsaKey)
+	apiKey, key, _ := domain.NewAPIKey("payments-service", "payments-service", domain.RoleTeller, nil)
+	apiKeys.EXPECT().FindByHash(mock.Anything, domain.HashAPIKey(key)).Return(apiKey, nil)
+
+	// Act
+	principal, err := a.AuthenticateAPIKey(context.Background(), key)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if principal.Subject != "payments-service" || !principal.HasRole(domain.RoleTeller) || principal.Method != domain.AuthMethodAPIKey {
+		t.Errorf("Expected the teller principal of the key, got %+v", principal)
+	}
+}
+
+func TestAuthenticator_AuthenticateAPIKey_ShouldRejectRevokedKey(t *testing.T) {
+	// Arrange
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	a, apiKeys := newTestAuthenticator(t, rsaKey)
+	apiKey, key, _ := domain.NewAPIKey("payments-service", "payments-service", domain.RoleTeller, nil)
+	_ = apiKey.Revoke(time.Now())
+	apiKeys.EXPECT().FindByHash(mock.Anything, domain.HashAPIKey(key)).Return(apiKey, nil)
+
+	// Act
+	_, err := a.AuthenticateAPIKey(context.Background(), key)
+
+	// Assert
+	if !errors.Is(err, domain.ErrUnauthenticated) {
+		t.Errorf("Expected unauthenticated error, got %v", err)
+	}
+}
+
+func TestAuthenticator_AuthenticateAPIKey_ShouldRejectUnknownKey(t *testing.T) {
+	// Arrange
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	a, apiKeys := newTestAuthenticator(t, rsaKey)
+	apiKeys.EXPECT().FindByHash(mock.Anything, mock.Anything).Return(nil, domain.NewError(domain.ErrorCodeNotFound, "api key not found"))
+
+	// Act
+	_, err := a.AuthenticateAPIKey(context.Background(), domain.APIKeyPrefix+"guessed")
+
+	// Assert
+	if !errors.Is(err, domain.ErrUnauthenticated) {
+		t.Errorf("Expected unauthenticated error, got %v", err)
+	}
+}
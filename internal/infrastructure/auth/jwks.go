@@ -0,0 +1,127 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+)
+
+// minHMACKeyLength is the shortest HS256 key accepted, the size of its digest.
+const minHMACKeyLength = 32
+
+// jsonWebKey holds the JWK members used for RS256 and HS256 keys.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	K   string `json:"k"`
+}
+
+// verificationKey is a key from the set, ready for the JWT library: an
+// *rsa.PublicKey for RS256 or a []byte for HS256.
+type verificationKey struct {
+	kid string
+	alg string
+	key any
+}
+
+// KeySet is the set of keys bearer tokens may be signed with.
+type KeySet struct {
+	keys []verificationKey
+}
+
+// LoadKeySet reads a JWKS file. RSA keys verify RS256 tokens and symmetric
+// ("oct") keys HS256 tokens; encryption keys are skipped.
+func LoadKeySet(path string) (*KeySet, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read JWKS file: %w", err)
+	}
+
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(content, &jwks); err != nil {
+		return nil, fmt.Errorf("parse JWKS file %s: %w", path, err)
+	}
+
+	set := &KeySet{}
+	for i, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := parseKey(jwk)
+		if err != nil {
+			return nil, fmt.Errorf("JWKS file %s, key %d: %w", path, i, err)
+		}
+		set.keys = append(set.keys, key)
+	}
+	if len(set.keys) == 0 {
+		return nil, fmt.Errorf("JWKS file %s has no signing keys", path)
+	}
+
+	return set, nil
+}
+
+func parseKey(jwk jsonWebKey) (verificationKey, error) {
+	switch jwk.Kty {
+	case "RSA":
+		if jwk.Alg != "" && jwk.Alg != "RS256" {
+			return verificationKey{}, fmt.Errorf("unsupported algorithm %s for an RSA key", jwk.Alg)
+		}
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return verificationKey{}, fmt.Errorf("invalid modulus: %w", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return verificationKey{}, fmt.Errorf("invalid exponent: %w", err)
+		}
+		if len(n) == 0 || len(e) == 0 {
+			return verificationKey{}, errors.New("modulus and exponent are required")
+		}
+		key := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+		return verificationKey{kid: jwk.Kid, alg: "RS256", key: key}, nil
+	case "oct":
+		if jwk.Alg != "" && jwk.Alg != "HS256" {
+			return verificationKey{}, fmt.Errorf("unsupported algorithm %s for a symmetric key", jwk.Alg)
+		}
+		secret, err := base64.RawURLEncoding.DecodeString(jwk.K)
+		if err != nil {
+			return verificationKey{}, fmt.Errorf("invalid symmetric key: %w", err)
+		}
+		if len(secret) < minHMACKeyLength {
+			return verificationKey{}, fmt.Errorf("symmetric key must be at least %d bytes", minHMACKeyLength)
+		}
+		return verificationKey{kid: jwk.Kid, alg: "HS256", key: secret}, nil
+	default:
+		return verificationKey{}, fmt.Errorf("unsupported key type %q", jwk.Kty)
+	}
+}
+
+// find returns the key for a token signed with alg. Tokens naming a kid get
+// that key; tokens without one are accepted when a single key fits alg.
+func (s *KeySet) find(kid, alg string) (any, error) {
+	var found []verificationKey
+	for _, key := range s.keys {
+		if key.alg == alg && (kid == "" || key.kid == kid) {
+			found = append(found, key)
+		}
+	}
+
+	switch {
+	case len(found) == 1:
+		return found[0].key, nil
+	case len(found) == 0:
+		return nil, fmt.Errorf("no %s key with id %q", alg, kid)
+	default:
+		return nil, fmt.Errorf("token must name one of the %s keys with kid", alg)
+	}
+}
@@ -1,15 +1,15 @@
 package infrastructure
 
 import (
-	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/config"
+	"arise_tech_assessment/internal/infrastructure/logging"
+	"arise_tech_assessment/internal/infrastructure/migrations"
+	"context"
 	"database/sql"
-	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/url"
-	"os"
 	"strings"
-	"time"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -20,21 +20,23 @@ type DatabaseInitializer struct {
 	*gorm.DB
 }
 
-func CreateDbInitializer(connectionString string) DatabaseInitializer {
+func CreateDbInitializer(cfg config.DatabaseConfig) DatabaseInitializer {
 	return DatabaseInitializer{
-		DB: NewGormDB(connectionString),
+		DB: NewGormDB(cfg),
 	}
 }
 
-func NewGormDB(connectionString string) *gorm.DB {
-	dsn := os.Getenv("CONNECTION_STRINGS_DEFAULT")
-	if dsn == "" {
-		log.Fatal("Fatal: CONNECTION_STRINGS_DEFAULT environment variable is not set.")
-	}
+var gormLogLevels = map[string]logger.LogLevel{
+	"silent": logger.Silent,
+	"error":  logger.Error,
+	"warn":   logger.Warn,
+	"info":   logger.Info,
+}
 
-	parsedDSN, err := url.Parse(dsn)
+func NewGormDB(cfg config.DatabaseConfig) *gorm.DB {
+	parsedDSN, err := url.Parse(cfg.DSN)
 	if err != nil {
-		log.Fatalf("Fatal: Invalid CONNECTION_STRINGS_DEFAULT DSN format: %v", err)
+		logging.Fatal("Invalid database DSN format", "error", err)
 	}
 
 	dbName := parsedDSN.Path
@@ -42,9 +44,38 @@ func NewGormDB(connectionString string) *gorm.DB {
 		dbName = dbName[1:]
 	}
 	if dbName == "" {
-		log.Fatalf("Fatal: Database name not found in CONNECTION_STRINGS_DEFAULT DSN path.")
+		logging.Fatal("Database name not found in database DSN path")
+	}
+
+	if cfg.CreateDatabase {
+		createDatabase(parsedDSN, dbName)
+	}
+
+	slog.Info("Connecting GORM to application database", "database", dbName)
+	db, err := gorm.Open(postgres.Open(cfg.DSN), &gorm.Config{
+		Logger:         logging.NewGormLogger(slog.Default(), gormLogLevels[cfg.LogLevel], cfg.SlowQueryThreshold),
+		TranslateError: true,
+	})
+	if err != nil {
+		logging.Fatal("Failed to connect GORM to application database", "database", dbName, "error", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		logging.Fatal("Failed to get database connection pool", "error", err)
 	}
+	sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+	slog.Info("Connected GORM to application database", "database", dbName)
 
+	return db
+}
+
+// createDatabase connects to the server's default 'postgres' database and
+// creates dbName when it does not exist yet.
+func createDatabase(parsedDSN *url.URL, dbName string) {
 	user := parsedDSN.User.Username()
 	password, _ := parsedDSN.User.Password()
 	host := parsedDSN.Hostname()
@@ -60,55 +91,55 @@ func NewGormDB(connectionString string) *gorm.DB {
 
 	rootDB, err := sql.Open("pgx", rootDSN)
 	if err != nil {
-		log.Fatalf("Fatal: Failed to open connection to default 'postgres' database: %v", err)
+		logging.Fatal("Failed to open connection to default 'postgres' database", "error", err)
 	}
 	defer func() {
 		if closeErr := rootDB.Close(); closeErr != nil {
-			log.Printf("Warning: Failed to close root database connection: %v", closeErr)
+			slog.Warn("Failed to close root database connection", "error", closeErr)
 		}
 	}()
 
-	rootDB.SetMaxIdleConns(10)
-	rootDB.SetMaxOpenConns(100)
-	rootDB.SetConnMaxLifetime(time.Hour)
-
 	if err = rootDB.Ping(); err != nil {
-		log.Fatalf("Fatal: Failed to ping default 'postgres' database. Check credentials, host/port, or server status: %v", err)
+		logging.Fatal("Failed to ping default 'postgres' database. Check credentials, host/port, or server status", "error", err)
 	}
-	log.Println("Successfully connected to PostgreSQL server (default database).")
+	slog.Info("Connected to PostgreSQL server (default database)")
 
 	createDBSQL := fmt.Sprintf("CREATE DATABASE %s", dbName)
 	_, err = rootDB.Exec(createDBSQL)
 	if err != nil {
 		if strings.Contains(err.Error(), fmt.Sprintf(`database "%s" already exists`, dbName)) {
-			log.Printf("Database '%s' already exists. Skipping creation.", dbName)
+			slog.Info("Database already exists, skipping creation", "database", dbName)
 		} else {
-			log.Fatalf("Fatal: Failed to create database '%s': %v", dbName, err)
+			logging.Fatal("Failed to create database", "database", dbName, "error", err)
 		}
 	} else {
-		log.Printf("Database '%s' created successfully.", dbName)
+		slog.Info("Database created", "database", dbName)
 	}
+}
 
-	log.Printf("Attempting to connect GORM to application database '%s'...", dbName)
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
-	})
+// PendingMigrations returns the embedded migrations not yet applied to the
+// database. The schema itself is only changed by the migrate command.
+func (initializer *DatabaseInitializer) PendingMigrations(ctx context.Context) ([]migrations.Migration, error) {
+	db, err := initializer.DB.DB()
 	if err != nil {
-		log.Fatalf("Fatal: Failed to connect GORM to application database '%s': %v", dbName, err)
+		return nil, err
 	}
-	log.Println("Successfully connected GORM to application database.")
 
-	return db
-}
+	embedded, err := migrations.Embedded()
+	if err != nil {
+		return nil, err
+	}
 
-func (initializer *DatabaseInitializer) Init() error {
-	err := initializer.DB.AutoMigrate(&domain.Account{}, &domain.Transaction{})
+	return migrations.NewMigrator(db, embedded).Pending(ctx)
+}
 
+// Close releases the connection pool.
+func (initializer *DatabaseInitializer) Close() error {
+	db, err := initializer.DB.DB()
 	if err != nil {
-		return errors.New("Failed to run auto migration.")
+		return err
 	}
-
-	return nil
+	return db.Close()
 }
 
 func (initializer *DatabaseInitializer) Seed() error {
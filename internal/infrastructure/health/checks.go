@@ -0,0 +1,46 @@
+package health
+
+import (
+	"arise_tech_assessment/internal/infrastructure/migrations"
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// Database pings the database.
+func Database(db *sql.DB) Checker {
+	return NewCheck("database", db.PingContext)
+}
+
+// Migrations fails while migrations compiled into the binary have not been
+// applied, since queries may then reference columns that do not exist yet.
+func Migrations(pending func(ctx context.Context) ([]migrations.Migration, error)) Checker {
+	return NewCheck("migrations", func(ctx context.Context) error {
+		missing, err := pending(ctx)
+		if err != nil {
+			return err
+		}
+		if len(missing) > 0 {
+			return fmt.Errorf("%d migration(s) pending, the first is %04d_%s", len(missing), missing[0].Version, missing[0].Name)
+		}
+		return nil
+	})
+}
+
+// ConnectionPool fails when at least threshold (0 to 1) of the open
+// connection limit is in use, as new requests would queue for a connection.
+// It always passes when the pool is unlimited.
+func ConnectionPool(db *sql.DB, threshold float64) Checker {
+	return NewCheck("connection_pool", func(ctx context.Context) error {
+		stats := db.Stats()
+		if stats.MaxOpenConnections <= 0 {
+			return nil
+		}
+
+		usage := float64(stats.InUse) / float64(stats.MaxOpenConnections)
+		if usage >= threshold {
+			return fmt.Errorf("%d of %d connections in use", stats.InUse, stats.MaxOpenConnections)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,123 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusUp   Status = "up"
+	StatusDown Status = "down"
+)
+
+// Checker probes one dependency. Check returns an error describing why the
+// dependency cannot serve requests.
+type Checker interface {
+	Name() string
+	Check(ctx context.Context) error
+}
+
+type checkFunc struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+// NewCheck adapts a function to a Checker.
+func NewCheck(name string, check func(ctx context.Context) error) Checker {
+	return checkFunc{name: name, check: check}
+}
+
+func (c checkFunc) Name() string {
+	return c.name
+}
+
+func (c checkFunc) Check(ctx context.Context) error {
+	return c.check(ctx)
+}
+
+type CheckResult struct {
+	Name       string `json:"name"`
+	Status     Status `json:"status"`
+	DurationMs int64  `json:"duration_ms"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Report is the outcome of running every registered check. It is up only when
+// all checks are.
+type Report struct {
+	Status Status        `json:"status"`
+	Checks []CheckResult `json:"checks"`
+}
+
+// Registry runs the registered checks concurrently, each bounded by timeout.
+type Registry struct {
+	timeout  time.Duration
+	checkers []Checker
+}
+
+func NewRegistry(timeout time.Duration) *Registry {
+	return &Registry{timeout: timeout}
+}
+
+// Register adds checkers to the registry. It must not be called concurrently
+// with Run.
+func (r *Registry) Register(checkers ...Checker) {
+	r.checkers = append(r.checkers, checkers...)
+}
+
+func (r *Registry) Run(ctx context.Context) Report {
+	report := Report{
+		Status: StatusUp,
+		Checks: make([]CheckResult, len(r.checkers)),
+	}
+
+	var wg sync.WaitGroup
+	for i, checker := range r.checkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			report.Checks[i] = r.run(ctx, checker)
+		}()
+	}
+	wg.Wait()
+
+	for _, result := range report.Checks {
+		if result.Status != StatusUp {
+			report.Status = StatusDown
+		}
+	}
+	return report
+}
+
+func (r *Registry) run(ctx context.Context, checker Checker) CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	started := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		done <- checker.Check(ctx)
+	}()
+
+	// A check that ignores its context still cannot hold up the probe.
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	result := CheckResult{
+		Name:       checker.Name(),
+		Status:     StatusUp,
+		DurationMs: time.Since(started).Milliseconds(),
+	}
+	if err != nil {
+		result.Status = StatusDown
+		result.Error = err.Error()
+	}
+	return result
+}
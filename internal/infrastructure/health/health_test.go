@@ -0,0 +1,91 @@
+package health
+
+import (
+	"arise_tech_assessment/internal/infrastructure/migrations"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRegistry_Run_ShouldBeUpWhenEveryCheckPasses(t *testing.T) {
+	// Arrange
+	registry := NewRegistry(time.Second)
+	registry.Register(
+		NewCheck("first", func(ctx context.Context) error { return nil }),
+		NewCheck("second", func(ctx context.Context) error { return nil }),
+	)
+
+	// Act
+	report := registry.Run(context.Background())
+
+	// Assert
+	if report.Status != StatusUp {
+		t.Errorf("Expected status %s, got %s", StatusUp, report.Status)
+	}
+	if len(report.Checks) != 2 || report.Checks[0].Name != "first" || report.Checks[1].Name != "second" {
+		t.Errorf("Expected results in registration order, got %+v", report.Checks)
+	}
+}
+
+func TestRegistry_Run_ShouldReportFailingCheck(t *testing.T) {
+	// Arrange
+	registry := NewRegistry(time.Second)
+	registry.Register(
+		NewCheck("database", func(ctx context.Context) error { return errors.New("connection refused") }),
+		NewCheck("migrations", func(ctx context.Context) error { return nil }),
+	)
+
+	// Act
+	report := registry.Run(context.Background())
+
+	// Assert
+	if report.Status != StatusDown {
+		t.Errorf("Expected status %s, got %s", StatusDown, report.Status)
+	}
+	if report.Checks[0].Status != StatusDown || report.Checks[0].Error != "connection refused" {
+		t.Errorf("Expected database check to be down with its error, got %+v", report.Checks[0])
+	}
+	if report.Checks[1].Status != StatusUp {
+		t.Errorf("Expected migrations check to be up, got %+v", report.Checks[1])
+	}
+}
+
+func TestRegistry_Run_ShouldTimeOutSlowChecks(t *testing.T) {
+	// Arrange
+	release := make(chan struct{})
+	defer close(release)
+
+	registry := NewRegistry(20 * time.Millisecond)
+	registry.Register(NewCheck("stuck", func(ctx context.Context) error {
+		<-release // ignores its context
+		return nil
+	}))
+
+	// Act
+	started := time.Now()
+	report := registry.Run(context.Background())
+
+	// Assert
+	if elapsed := time.Since(started); elapsed > time.Second {
+		t.Errorf("Expected the probe to return after the timeout, took %s", elapsed)
+	}
+	if report.Status != StatusDown || report.Checks[0].Error != context.DeadlineExceeded.Error() {
+		t.Errorf("Expected the stuck check to time out, got %+v", report.Checks[0])
+	}
+}
+
+func TestMigrations_ShouldFailWhilePending(t *testing.T) {
+	// Arrange
+	checker := Migrations(func(ctx context.Context) ([]migrations.Migration, error) {
+		return []migrations.Migration{{Version: 2, Name: "integrity_constraints"}}, nil
+	})
+
+	// Act
+	err := checker.Check(context.Background())
+
+	// Assert
+	if err == nil || err.Error() != "1 migration(s) pending, the first is 0002_integrity_constraints" {
+		t.Errorf("Expected pending migration error, got %v", err)
+	}
+}
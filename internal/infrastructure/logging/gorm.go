@@ -0,0 +1,75 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// GormLogger sends GORM output to slog. Failed statements are logged at error
+// level, slow ones at warn and, when the GORM level is info, every statement at
+// debug. Bind parameters are never logged, so SQL cannot leak personal data.
+type GormLogger struct {
+	logger        *slog.Logger
+	level         logger.LogLevel
+	slowThreshold time.Duration
+}
+
+func NewGormLogger(l *slog.Logger, level logger.LogLevel, slowThreshold time.Duration) *GormLogger {
+	return &GormLogger{logger: l, level: level, slowThreshold: slowThreshold}
+}
+
+func (g *GormLogger) LogMode(level logger.LogLevel) logger.Interface {
+	copied := *g
+	copied.level = level
+	return &copied
+}
+
+func (g *GormLogger) Info(ctx context.Context, msg string, args ...interface{}) {
+	if g.level >= logger.Info {
+		g.logger.InfoContext(ctx, fmt.Sprintf(msg, args...))
+	}
+}
+
+func (g *GormLogger) Warn(ctx context.Context, msg string, args ...interface{}) {
+	if g.level >= logger.Warn {
+		g.logger.WarnContext(ctx, fmt.Sprintf(msg, args...))
+	}
+}
+
+func (g *GormLogger) Error(ctx context.Context, msg string, args ...interface{}) {
+	if g.level >= logger.Error {
+		g.logger.ErrorContext(ctx, fmt.Sprintf(msg, args...))
+	}
+}
+
+func (g *GormLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+	if g.level <= logger.Silent {
+		return
+	}
+
+	elapsed := time.Since(begin)
+	attrs := func() []any {
+		sql, rows := fc()
+		return []any{slog.String("sql", sql), slog.Int64("rows", rows), slog.Duration("elapsed", elapsed)}
+	}
+
+	switch {
+	case err != nil && g.level >= logger.Error && !errors.Is(err, gorm.ErrRecordNotFound):
+		g.logger.ErrorContext(ctx, "database query failed", append(attrs(), slog.String("error", err.Error()))...)
+	case g.slowThreshold > 0 && elapsed > g.slowThreshold && g.level >= logger.Warn:
+		g.logger.WarnContext(ctx, "slow database query", attrs()...)
+	case g.level >= logger.Info:
+		g.logger.DebugContext(ctx, "database query", attrs()...)
+	}
+}
+
+// ParamsFilter drops the bind parameters so that logged SQL keeps its placeholders.
+func (g *GormLogger) ParamsFilter(ctx context.Context, sql string, params ...interface{}) (string, []interface{}) {
+	return sql, nil
+}
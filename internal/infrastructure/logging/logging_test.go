@@ -0,0 +1,161 @@
+package logging
+
+import (
+	"arise_tech_assessment/internal/config"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func decodeRecords(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var record map[string]any
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("Expected JSON log record, got %s", line)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestNew_ShouldAddRequestIDFromContext(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	log := New(&buf, config.LogConfig{Level: "info"}).With("component", "test")
+	ctx := WithRequestID(context.Background(), "req-123")
+
+	// Act
+	log.InfoContext(ctx, "with id")
+	log.Info("without id")
+
+	// Assert
+	records := decodeRecords(t, &buf)
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 records, got %d", len(records))
+	}
+	if records[0]["request_id"] != "req-123" {
+		t.Errorf("Expected request_id req-123, got %v", records[0]["request_id"])
+	}
+	if _, ok := records[1]["request_id"]; ok {
+		t.Errorf("Expected no request_id without one in the context, got %v", records[1]["request_id"])
+	}
+}
+
+func TestNew_ShouldAddTraceFromContext(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	log := New(&buf, config.LogConfig{Level: "info"})
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  spanID,
+	}))
+
+	// Act
+	log.InfoContext(ctx, "traced")
+
+	// Assert
+	record := decodeRecords(t, &buf)[0]
+	if record["trace_id"] != traceID.String() || record["span_id"] != spanID.String() {
+		t.Errorf("Expected trace and span IDs, got %v", record)
+	}
+}
+
+func TestNew_ShouldRedactPersonalData(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	log := New(&buf, config.LogConfig{Level: "info"})
+
+	// Act
+	log.Info("created", "number", "1234567890", "holder_name", "Jane Doe",
+		slog.Group("transfer", slog.String("account_number", "9876543210")))
+
+	// Assert
+	output := buf.String()
+	for _, secret := range []string{"1234567890", "Jane Doe", "9876543210"} {
+		if strings.Contains(output, secret) {
+			t.Errorf("Expected %q to be redacted, got %s", secret, output)
+		}
+	}
+	record := decodeRecords(t, &buf)[0]
+	if record["number"] != "******7890" {
+		t.Errorf("Expected masked number, got %v", record["number"])
+	}
+	if record["holder_name"] != "[REDACTED]" {
+		t.Errorf("Expected redacted holder name, got %v", record["holder_name"])
+	}
+}
+
+func TestNew_ShouldFilterByLevel(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	log := New(&buf, config.LogConfig{Level: "warn"})
+
+	// Act
+	log.Info("dropped")
+	log.Warn("kept")
+
+	// Assert
+	records := decodeRecords(t, &buf)
+	if len(records) != 1 || records[0]["msg"] != "kept" {
+		t.Errorf("Expected only the warning, got %v", records)
+	}
+}
+
+func TestGormLogger_Trace_ShouldLogFailuresAndSlowQueries(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	gormLogger := NewGormLogger(New(&buf, config.LogConfig{Level: "debug"}), logger.Warn, 100*time.Millisecond)
+	ctx := WithRequestID(context.Background(), "req-456")
+	statement := func() (string, int64) { return `SELECT * FROM "accounts" WHERE id = $1`, 1 }
+
+	// Act
+	gormLogger.Trace(ctx, time.Now(), statement, errors.New("connection reset"))
+	gormLogger.Trace(ctx, time.Now(), statement, gorm.ErrRecordNotFound)
+	gormLogger.Trace(ctx, time.Now().Add(-time.Second), statement, nil)
+	gormLogger.Trace(ctx, time.Now(), statement, nil)
+
+	// Assert
+	records := decodeRecords(t, &buf)
+	if len(records) != 2 {
+		t.Fatalf("Expected a failure and a slow query, got %v", records)
+	}
+	if records[0]["level"] != "ERROR" || records[0]["error"] != "connection reset" {
+		t.Errorf("Expected the failure at error level, got %v", records[0])
+	}
+	if records[1]["level"] != "WARN" || records[1]["msg"] != "slow database query" {
+		t.Errorf("Expected the slow query at warn level, got %v", records[1])
+	}
+	if records[1]["request_id"] != "req-456" {
+		t.Errorf("Expected request_id on SQL records, got %v", records[1]["request_id"])
+	}
+}
+
+func TestGormLogger_ParamsFilter_ShouldDropParameters(t *testing.T) {
+	// Arrange
+	gormLogger := NewGormLogger(slog.Default(), logger.Info, 0)
+
+	// Act
+	sql, params := gormLogger.ParamsFilter(context.Background(), "SELECT $1", "1234567890")
+
+	// Assert
+	if sql != "SELECT $1" || params != nil {
+		t.Errorf("Expected SQL without parameters, got %q %v", sql, params)
+	}
+}
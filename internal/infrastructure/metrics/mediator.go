@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"arise_tech_assessment/internal/application/behaviors"
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/domain"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mehdihadeli/go-mediatr"
+)
+
+// MediatorBehavior is a mediatr pipeline behavior timing every request and
+// counting the transactions created, completed and failed.
+type MediatorBehavior struct {
+	metrics *Metrics
+}
+
+func NewMediatorBehavior(m *Metrics) *MediatorBehavior {
+	return &MediatorBehavior{metrics: m}
+}
+
+func (b *MediatorBehavior) Handle(ctx context.Context, request interface{}, next mediatr.RequestHandlerFunc) (interface{}, error) {
+	name := behaviors.RequestName(request)
+
+	started := time.Now()
+	response, err := next(ctx)
+	b.metrics.mediatorDuration.WithLabelValues(name).Observe(time.Since(started).Seconds())
+
+	if err != nil {
+		b.metrics.mediatorErrors.WithLabelValues(name, errorCode(err)).Inc()
+	}
+	b.observeTransactions(response, err)
+
+	return response, err
+}
+
+func (b *MediatorBehavior) observeTransactions(response interface{}, err error) {
+	var failure *domain.TransactionFailure
+	if errors.As(err, &failure) {
+		b.metrics.transactionsFailed.WithLabelValues(transactionLabels(failure.Transaction)...).Inc()
+		return
+	}
+
+	switch r := response.(type) {
+	case *commands.CreateTransactionResponse:
+		if r != nil && r.Transaction != nil {
+			b.metrics.transactionsCreated.WithLabelValues(transactionLabels(r.Transaction)...).Inc()
+		}
+	case *commands.ProcessTransactionResponse:
+		if r != nil && r.Transaction != nil {
+			labels := transactionLabels(r.Transaction)
+			b.metrics.transactionsCompleted.WithLabelValues(labels...).Inc()
+			b.metrics.transactionVolume.WithLabelValues(labels...).Add(float64(r.Transaction.Amount.Amount))
+		}
+	}
+}
+
+func transactionLabels(transaction *domain.Transaction) []string {
+	return []string{string(transaction.Type), string(transaction.Amount.Currency)}
+}
+
+// errorCode labels domain errors by their code and everything else as internal.
+func errorCode(err error) string {
+	var domainErr *domain.Error
+	if errors.As(err, &domainErr) {
+		return string(domainErr.Code)
+	}
+	return "internal"
+}
@@ -0,0 +1,110 @@
+package metrics
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Metrics owns the Prometheus registry of the service and the collectors fed
+// by the HTTP middleware, the mediator behavior and the GORM plugin.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpDuration     *prometheus.HistogramVec
+	mediatorDuration *prometheus.HistogramVec
+	mediatorErrors   *prometheus.CounterVec
+	queryDuration    *prometheus.HistogramVec
+
+	transactionsCreated   *prometheus.CounterVec
+	transactionsCompleted *prometheus.CounterVec
+	transactionsFailed    *prometheus.CounterVec
+	transactionVolume     *prometheus.CounterVec
+
+	domainEvents *prometheus.CounterVec
+}
+
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "Duration of HTTP requests by route template, method and status code.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		mediatorDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "mediator_request_duration_seconds",
+			Help:    "Duration of mediator request handlers by request type.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"request"}),
+		mediatorErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "mediator_request_errors_total",
+			Help: "Mediator requests that returned an error, by request type and error code.",
+		}, []string{"request", "code"}),
+		queryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "db_query_duration_seconds",
+			Help:    "Duration of GORM statements by operation and table.",
+			Buckets: prometheus.ExponentialBuckets(0.0005, 2, 14),
+		}, []string{"operation", "table"}),
+		transactionsCreated: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "transactions_created_total",
+			Help: "Transactions created, by type and currency.",
+		}, []string{"type", "currency"}),
+		transactionsCompleted: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "transactions_completed_total",
+			Help: "Transactions processed successfully, by type and currency.",
+		}, []string{"type", "currency"}),
+		transactionsFailed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "transactions_failed_total",
+			Help: "Transactions that failed while processing, by type and currency.",
+		}, []string{"type", "currency"}),
+		transactionVolume: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "transaction_volume_minor_units_total",
+			Help: "Amount moved by completed transactions in minor currency units, by type and currency.",
+		}, []string{"type", "currency"}),
+		domainEvents: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "domain_events_total",
+			Help: "Domain events delivered from the outbox to in-process handlers, by event name.",
+		}, []string{"event"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpDuration,
+		m.mediatorDuration,
+		m.mediatorErrors,
+		m.queryDuration,
+		m.transactionsCreated,
+		m.transactionsCompleted,
+		m.transactionsFailed,
+		m.transactionVolume,
+		m.domainEvents,
+	)
+
+	return m
+}
+
+// Handler serves the registry in the Prometheus exposition format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// ObserveHTTPRequest records a served request. route is the route template,
+// e.g. /api/v1/accounts/:id, so that IDs do not explode the label set.
+func (m *Metrics) ObserveHTTPRequest(method, route string, status int, duration time.Duration) {
+	m.httpDuration.WithLabelValues(method, route, strconv.Itoa(status)).Observe(duration.Seconds())
+}
+
+// ObserveDomainEvent counts an event delivered by the outbox dispatcher. It is
+// an outbox.EventHandler.
+func (m *Metrics) ObserveDomainEvent(_ context.Context, event domain.Event) error {
+	m.domainEvents.WithLabelValues(event.EventName()).Inc()
+	return nil
+}
@@ -0,0 +1,128 @@
+package metrics
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/domain"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func handlerReturning(response interface{}, err error) func(ctx context.Context) (interface{}, error) {
+	return func(ctx context.Context) (interface{}, error) {
+		return response, err
+	}
+}
+
+func TestMediatorBehavior_ShouldCountTransactionLifecycle(t *testing.T) {
+	// Arrange
+	m := New()
+	behavior := NewMediatorBehavior(m)
+	ctx := context.Background()
+
+	created := domain.NewDepositTransaction(uuid.New(), domain.NewMoney(5000, domain.USD), "Deposit")
+	completed := domain.NewDepositTransaction(uuid.New(), domain.NewMoney(2500, domain.USD), "Deposit")
+	completed.Complete()
+	failed := domain.NewWithdrawTransaction(uuid.New(), domain.NewMoney(1000, domain.THB), "Withdraw")
+	failed.Fail()
+
+	// Act
+	_, _ = behavior.Handle(ctx, &commands.CreateTransactionCommand{},
+		handlerReturning(&commands.CreateTransactionResponse{Transaction: created}, nil))
+	_, _ = behavior.Handle(ctx, &commands.ProcessTransactionCommand{},
+		handlerReturning(&commands.ProcessTransactionResponse{Transaction: completed}, nil))
+	_, err := behavior.Handle(ctx, &commands.ProcessTransactionCommand{},
+		handlerReturning((*commands.ProcessTransactionResponse)(nil), &domain.TransactionFailure{Transaction: failed, Err: domain.ErrInsufficientFunds}))
+
+	// Assert
+	if !errors.Is(err, domain.ErrInsufficientFunds) {
+		t.Errorf("Expected the handler error to pass through, got %v", err)
+	}
+	if got := testutil.ToFloat64(m.transactionsCreated.WithLabelValues("deposit", "USD")); got != 1 {
+		t.Errorf("Expected 1 created deposit, got %v", got)
+	}
+	if got := testutil.ToFloat64(m.transactionsCompleted.WithLabelValues("deposit", "USD")); got != 1 {
+		t.Errorf("Expected 1 completed deposit, got %v", got)
+	}
+	if got := testutil.ToFloat64(m.transactionVolume.WithLabelValues("deposit", "USD")); got != 2500 {
+		t.Errorf("Expected volume 2500, got %v", got)
+	}
+	if got := testutil.ToFloat64(m.transactionsFailed.WithLabelValues("withdraw", "THB")); got != 1 {
+		t.Errorf("Expected 1 failed withdrawal, got %v", got)
+	}
+	if got := testutil.ToFloat64(m.mediatorErrors.WithLabelValues("ProcessTransactionCommand", "insufficient_funds")); got != 1 {
+		t.Errorf("Expected 1 insufficient_funds error, got %v", got)
+	}
+	if got := testutil.CollectAndCount(m.mediatorDuration); got != 2 {
+		t.Errorf("Expected durations for 2 request types, got %d", got)
+	}
+}
+
+func TestMediatorBehavior_ShouldLabelUnknownErrorsAsInternal(t *testing.T) {
+	// Arrange
+	m := New()
+	behavior := NewMediatorBehavior(m)
+
+	// Act
+	_, _ = behavior.Handle(context.Background(), &commands.CreateAccountCommand{},
+		handlerReturning(nil, errors.New("connection reset")))
+
+	// Assert
+	if got := testutil.ToFloat64(m.mediatorErrors.WithLabelValues("CreateAccountCommand", "internal")); got != 1 {
+		t.Errorf("Expected 1 internal error, got %v", got)
+	}
+}
+
+func TestInstrumentDB_ShouldTimeStatementsByOperationAndTable(t *testing.T) {
+	// Arrange
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=127.0.0.1 port=1 user=test dbname=test"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+		Logger:               logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	m := New()
+	if err := m.InstrumentDB(db, "test"); err != nil {
+		t.Fatalf("Failed to instrument database: %v", err)
+	}
+
+	// Act
+	var accounts []domain.Account
+	db.Find(&accounts)
+	db.Create(&domain.Account{ID: uuid.New()})
+
+	// Assert
+	if got := testutil.CollectAndCount(m.queryDuration, "db_query_duration_seconds"); got != 2 {
+		t.Errorf("Expected 2 operation and table series, got %d", got)
+	}
+	if got, err := testutil.GatherAndCount(m.registry, "go_sql_max_open_connections"); err != nil || got != 1 {
+		t.Errorf("Expected pool statistics to be exported, got %d series (%v)", got, err)
+	}
+}
+
+func TestObserveDomainEvent_ShouldCountEventsByName(t *testing.T) {
+	// Arrange
+	m := New()
+	ctx := context.Background()
+
+	// Act
+	_ = m.ObserveDomainEvent(ctx, domain.AccountBlocked{})
+	_ = m.ObserveDomainEvent(ctx, domain.AccountBlocked{})
+	_ = m.ObserveDomainEvent(ctx, domain.TransactionCompleted{})
+
+	// Assert
+	if got := testutil.ToFloat64(m.domainEvents.WithLabelValues(domain.AccountBlocked{}.EventName())); got != 2 {
+		t.Errorf("Expected 2 account.blocked events, got %v", got)
+	}
+	if got := testutil.ToFloat64(m.domainEvents.WithLabelValues(domain.TransactionCompleted{}.EventName())); got != 1 {
+		t.Errorf("Expected 1 transaction.completed event, got %v", got)
+	}
+}
@@ -0,0 +1,91 @@
+package migrations
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoad_ShouldPairScriptsAndOrderByVersion(t *testing.T) {
+	// Arrange
+	fsys := fstest.MapFS{
+		"0002_add_index.up.sql":        {Data: []byte("CREATE INDEX x ON t (c);")},
+		"0002_add_index.down.sql":      {Data: []byte("DROP INDEX x;")},
+		"0001_initial_schema.up.sql":   {Data: []byte("CREATE TABLE t (c int);")},
+		"0001_initial_schema.down.sql": {Data: []byte("DROP TABLE t;")},
+		"README.md":                    {Data: []byte("ignored")},
+	}
+
+	// Act
+	migrations, err := Load(fsys)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(migrations) != 2 {
+		t.Fatalf("Expected 2 migrations, got %d", len(migrations))
+	}
+	if migrations[0].Version != 1 || migrations[0].Name != "initial_schema" {
+		t.Errorf("Expected 0001_initial_schema first, got %04d_%s", migrations[0].Version, migrations[0].Name)
+	}
+	if migrations[1].Down != "DROP INDEX x;" {
+		t.Errorf("Expected down script of 0002, got %q", migrations[1].Down)
+	}
+}
+
+func TestLoad_ShouldRejectMigrationWithoutUpScript(t *testing.T) {
+	// Arrange
+	fsys := fstest.MapFS{
+		"0001_initial_schema.down.sql": {Data: []byte("DROP TABLE t;")},
+	}
+
+	// Act
+	_, err := Load(fsys)
+
+	// Assert
+	if err == nil {
+		t.Error("Expected error for missing up script, got nil")
+	}
+}
+
+func TestEmbedded_ShouldLoadBundledMigrations(t *testing.T) {
+	// Act
+	migrations, err := Embedded()
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for i, migration := range migrations {
+		if migration.Version != int64(i+1) {
+			t.Errorf("Expected version %d, got %d", i+1, migration.Version)
+		}
+		if migration.Down == "" {
+			t.Errorf("Expected migration %04d_%s to have a down script", migration.Version, migration.Name)
+		}
+	}
+}
+
+func TestCreate_ShouldNumberAfterLatestMigration(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "0003_existing.up.sql"), []byte("SELECT 1;"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Act
+	up, down, err := Create(dir, "Add Account Tags")
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if filepath.Base(up) != "0004_add_account_tags.up.sql" {
+		t.Errorf("Expected 0004_add_account_tags.up.sql, got %s", filepath.Base(up))
+	}
+	if filepath.Base(down) != "0004_add_account_tags.down.sql" {
+		t.Errorf("Expected 0004_add_account_tags.down.sql, got %s", filepath.Base(down))
+	}
+}
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS exchange_rates;
+DROP TABLE IF EXISTS idempotency_records;
+DROP TABLE IF EXISTS ledger_entries;
+DROP TABLE IF EXISTS transactions;
+DROP TABLE IF EXISTS accounts;
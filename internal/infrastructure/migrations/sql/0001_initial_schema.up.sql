@@ -0,0 +1,84 @@
+-- Baseline of the schema previously created by GORM AutoMigrate. Every
+-- statement is guarded so that databases created that way can adopt it.
+
+CREATE TABLE IF NOT EXISTS accounts (
+    id                uuid PRIMARY KEY,
+    number            text,
+    holder_name       text,
+    amount            bigint,
+    currency          text,
+    status            text,
+    status_reason     text,
+    status_changed_by text,
+    status_changed_at timestamptz,
+    version           bigint NOT NULL DEFAULT 1,
+    created_at        timestamptz,
+    updated_at        timestamptz,
+    deleted_at        timestamptz
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_accounts_number ON accounts (number);
+CREATE INDEX IF NOT EXISTS idx_accounts_deleted_at ON accounts (deleted_at);
+
+CREATE TABLE IF NOT EXISTS transactions (
+    id                   uuid PRIMARY KEY,
+    type                 text,
+    status               text,
+    amount               bigint,
+    currency             text,
+    destination_amount   bigint,
+    destination_currency text,
+    exchange_rate        numeric(24, 12),
+    rate_timestamp       timestamptz,
+    from_account_id      uuid CONSTRAINT fk_transactions_from_account REFERENCES accounts (id),
+    to_account_id        uuid CONSTRAINT fk_transactions_to_account REFERENCES accounts (id),
+    description          text,
+    reference            text,
+    processed_at         timestamptz,
+    created_at           timestamptz,
+    updated_at           timestamptz,
+    deleted_at           timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_transactions_from_account_id ON transactions (from_account_id);
+CREATE INDEX IF NOT EXISTS idx_transactions_to_account_id ON transactions (to_account_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transactions_reference ON transactions (reference);
+CREATE INDEX IF NOT EXISTS idx_transactions_deleted_at ON transactions (deleted_at);
+
+CREATE TABLE IF NOT EXISTS ledger_entries (
+    id               uuid PRIMARY KEY,
+    account_id       uuid,
+    transaction_id   uuid,
+    direction        text,
+    amount           bigint,
+    currency         text,
+    balance_amount   bigint,
+    balance_currency text,
+    created_at       timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_account_id ON ledger_entries (account_id);
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_transaction_id ON ledger_entries (transaction_id);
+
+CREATE TABLE IF NOT EXISTS idempotency_records (
+    key           varchar(255) PRIMARY KEY,
+    request_hash  varchar(64) NOT NULL,
+    status_code   bigint,
+    content_type  text,
+    response_body bytea,
+    created_at    timestamptz,
+    expires_at    timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_records_expires_at ON idempotency_records (expires_at);
+
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    id             uuid PRIMARY KEY,
+    base_currency  varchar(3) NOT NULL,
+    quote_currency varchar(3) NOT NULL,
+    rate           numeric(24, 12) NOT NULL,
+    effective_at   timestamptz NOT NULL,
+    created_at     timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_exchange_rates_pair ON exchange_rates (base_currency, quote_currency, effective_at);
@@ -0,0 +1,25 @@
+ALTER TABLE ledger_entries
+    DROP CONSTRAINT IF EXISTS fk_ledger_entries_transaction,
+    DROP CONSTRAINT IF EXISTS chk_ledger_entries_direction,
+    DROP CONSTRAINT IF EXISTS chk_ledger_entries_balance_non_negative,
+    DROP CONSTRAINT IF EXISTS chk_ledger_entries_amount_positive;
+
+ALTER TABLE transactions
+    DROP CONSTRAINT IF EXISTS fk_transactions_from_account,
+    DROP CONSTRAINT IF EXISTS fk_transactions_to_account,
+    ADD CONSTRAINT fk_transactions_from_account
+        FOREIGN KEY (from_account_id) REFERENCES accounts (id),
+    ADD CONSTRAINT fk_transactions_to_account
+        FOREIGN KEY (to_account_id) REFERENCES accounts (id);
+
+ALTER TABLE transactions
+    DROP CONSTRAINT IF EXISTS chk_transactions_accounts,
+    DROP CONSTRAINT IF EXISTS chk_transactions_status,
+    DROP CONSTRAINT IF EXISTS chk_transactions_type,
+    DROP CONSTRAINT IF EXISTS chk_transactions_destination_amount_positive,
+    DROP CONSTRAINT IF EXISTS chk_transactions_amount_positive;
+
+ALTER TABLE accounts
+    DROP CONSTRAINT IF EXISTS chk_accounts_status_reason,
+    DROP CONSTRAINT IF EXISTS chk_accounts_status,
+    DROP CONSTRAINT IF EXISTS chk_accounts_balance_non_negative;
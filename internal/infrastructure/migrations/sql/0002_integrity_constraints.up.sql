@@ -0,0 +1,44 @@
+-- Enforce the money and reference invariants of the domain in the schema, so
+-- that rows written outside the application cannot break them either.
+
+ALTER TABLE accounts
+    ADD CONSTRAINT chk_accounts_balance_non_negative CHECK (amount >= 0),
+    ADD CONSTRAINT chk_accounts_status
+        CHECK (status IN ('active', 'inactive', 'blocked', 'closed')),
+    ADD CONSTRAINT chk_accounts_status_reason
+        CHECK (status_reason IN ('', 'customer_request', 'fraud_suspected', 'compliance', 'dormant', 'resolved', 'other'));
+
+ALTER TABLE transactions
+    ADD CONSTRAINT chk_transactions_amount_positive CHECK (amount > 0),
+    ADD CONSTRAINT chk_transactions_destination_amount_positive CHECK (destination_amount > 0),
+    ADD CONSTRAINT chk_transactions_type
+        CHECK (type IN ('deposit', 'withdraw', 'transfer')),
+    ADD CONSTRAINT chk_transactions_status
+        CHECK (status IN ('pending', 'completed', 'failed', 'cancelled')),
+    ADD CONSTRAINT chk_transactions_accounts CHECK (
+        (type = 'deposit' AND from_account_id IS NULL AND to_account_id IS NOT NULL)
+        OR (type = 'withdraw' AND from_account_id IS NOT NULL AND to_account_id IS NULL)
+        OR (type = 'transfer' AND from_account_id IS NOT NULL AND to_account_id IS NOT NULL
+            AND from_account_id <> to_account_id)
+    );
+
+-- Accounts are soft-deleted, so a hard delete of one with history is a mistake.
+-- GORM AutoMigrate may also have created fk_accounts_transactions.
+ALTER TABLE transactions
+    DROP CONSTRAINT IF EXISTS fk_accounts_transactions,
+    DROP CONSTRAINT IF EXISTS fk_transactions_from_account,
+    DROP CONSTRAINT IF EXISTS fk_transactions_to_account,
+    ADD CONSTRAINT fk_transactions_from_account
+        FOREIGN KEY (from_account_id) REFERENCES accounts (id) ON DELETE RESTRICT,
+    ADD CONSTRAINT fk_transactions_to_account
+        FOREIGN KEY (to_account_id) REFERENCES accounts (id) ON DELETE RESTRICT;
+
+-- Ledger account_id is not a foreign key: the external and FX position
+-- counterparties have no account row.
+ALTER TABLE ledger_entries
+    ADD CONSTRAINT chk_ledger_entries_amount_positive CHECK (amount > 0),
+    ADD CONSTRAINT chk_ledger_entries_balance_non_negative CHECK (balance_amount >= 0),
+    ADD CONSTRAINT chk_ledger_entries_direction
+        CHECK (direction IN ('debit', 'credit')),
+    ADD CONSTRAINT fk_ledger_entries_transaction
+        FOREIGN KEY (transaction_id) REFERENCES transactions (id) ON DELETE RESTRICT;
@@ -0,0 +1,18 @@
+-- Domain events are written here in the same transaction as the change that
+-- raised them, then published by the outbox dispatcher.
+
+CREATE TABLE outbox_events (
+    id              uuid PRIMARY KEY,
+    event_type      text        NOT NULL,
+    aggregate_id    uuid        NOT NULL,
+    payload         jsonb       NOT NULL,
+    occurred_at     timestamptz NOT NULL,
+    attempts        integer     NOT NULL DEFAULT 0 CONSTRAINT chk_outbox_events_attempts CHECK (attempts >= 0),
+    last_error      text        NOT NULL DEFAULT '',
+    next_attempt_at timestamptz NOT NULL,
+    published_at    timestamptz
+);
+
+-- Only unpublished events are polled, so keep the index to those.
+CREATE INDEX idx_outbox_events_due ON outbox_events (next_attempt_at) WHERE published_at IS NULL;
+CREATE INDEX idx_outbox_events_aggregate_id ON outbox_events (aggregate_id);
@@ -0,0 +1,34 @@
+-- Webhook subscriptions and the log of deliveries made to them. Deliveries
+-- are created from published outbox events and sent by the webhook worker.
+
+CREATE TABLE webhook_subscriptions (
+    id          uuid PRIMARY KEY,
+    url         text        NOT NULL,
+    event_types jsonb       NOT NULL,
+    secret      text        NOT NULL,
+    created_at  timestamptz NOT NULL,
+    updated_at  timestamptz NOT NULL
+);
+
+CREATE TABLE webhook_deliveries (
+    id              uuid PRIMARY KEY,
+    subscription_id uuid        NOT NULL CONSTRAINT fk_webhook_deliveries_subscription
+        REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    event_id        uuid        NOT NULL,
+    event_type      text        NOT NULL,
+    payload         jsonb       NOT NULL,
+    status          text        NOT NULL CONSTRAINT chk_webhook_deliveries_status
+        CHECK (status IN ('pending', 'succeeded', 'failed')),
+    attempts        integer     NOT NULL DEFAULT 0 CONSTRAINT chk_webhook_deliveries_attempts CHECK (attempts >= 0),
+    response_status integer     NOT NULL DEFAULT 0,
+    last_error      text        NOT NULL DEFAULT '',
+    next_attempt_at timestamptz NOT NULL,
+    delivered_at    timestamptz,
+    created_at      timestamptz NOT NULL,
+    updated_at      timestamptz NOT NULL,
+    -- An outbox event may be published more than once; deliver it once per subscription.
+    CONSTRAINT uq_webhook_deliveries_event UNIQUE (subscription_id, event_id)
+);
+
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_webhook_deliveries_subscription_created ON webhook_deliveries (subscription_id, created_at);
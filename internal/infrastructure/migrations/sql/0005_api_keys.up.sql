@@ -0,0 +1,15 @@
+-- API keys are stored as SHA-256 hashes; the plain key is only shown when issued.
+
+CREATE TABLE api_keys (
+    id         uuid PRIMARY KEY,
+    name       text        NOT NULL,
+    prefix     text        NOT NULL,
+    hash       text        NOT NULL CONSTRAINT uq_api_keys_hash UNIQUE,
+    subject    text        NOT NULL,
+    role       text        NOT NULL CONSTRAINT chk_api_keys_role
+        CHECK (role IN ('admin', 'teller', 'auditor', 'customer')),
+    expires_at timestamptz,
+    revoked_at timestamptz,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL
+);
@@ -0,0 +1,6 @@
+-- Links accounts to the customer they belong to, identified by the subject of
+-- the customer's credentials. Existing accounts have no owner.
+
+ALTER TABLE accounts ADD COLUMN owner_id text;
+
+CREATE INDEX idx_accounts_owner_id ON accounts (owner_id) WHERE owner_id IS NOT NULL;
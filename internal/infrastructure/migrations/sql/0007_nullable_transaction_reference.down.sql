@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_transactions_reference;
+CREATE UNIQUE INDEX idx_transactions_reference ON transactions (reference);
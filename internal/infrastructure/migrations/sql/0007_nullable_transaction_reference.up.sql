@@ -0,0 +1,8 @@
+-- Transactions created without a reference were stored with an empty one,
+-- which the unique index allowed only once. Store them as NULL and only
+-- require the given references to be unique.
+
+UPDATE transactions SET reference = NULL WHERE reference = '';
+
+DROP INDEX IF EXISTS idx_transactions_reference;
+CREATE UNIQUE INDEX idx_transactions_reference ON transactions (reference) WHERE reference IS NOT NULL;
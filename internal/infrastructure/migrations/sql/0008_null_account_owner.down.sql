@@ -0,0 +1,2 @@
+-- Nothing to revert: NULL is what the previous version meant by an empty owner.
+SELECT 1;
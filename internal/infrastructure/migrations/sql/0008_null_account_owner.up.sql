@@ -0,0 +1,4 @@
+-- Accounts without an owner were written with an empty owner_id. Store them as
+-- NULL, as idx_accounts_owner_id expects.
+
+UPDATE accounts SET owner_id = NULL WHERE owner_id = '';
@@ -0,0 +1,159 @@
+package outbox
+
+import (
+	"arise_tech_assessment/internal/config"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+// Sink receives every published outbox event, e.g. to forward it to a
+// message broker. Publish must be idempotent: an event is delivered at least
+// once and may be delivered again after a crash or a failed attempt.
+type Sink interface {
+	Publish(ctx context.Context, event domain.OutboxEvent) error
+}
+
+// LogSink logs each event, which is useful during development.
+type LogSink struct {
+	logger *slog.Logger
+}
+
+func NewLogSink(logger *slog.Logger) *LogSink {
+	return &LogSink{logger: logger}
+}
+
+func (s *LogSink) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	s.logger.InfoContext(ctx, "Domain event published",
+		"event_id", event.ID,
+		"event_type", event.EventType,
+		"aggregate_id", event.AggregateID,
+	)
+	return nil
+}
+
+// Sinks publishes every event to each of its sinks in turn.
+type Sinks []Sink
+
+func (s Sinks) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	var errs []error
+	for _, sink := range s {
+		errs = append(errs, sink.Publish(ctx, event))
+	}
+	return errors.Join(errs...)
+}
+
+// Dispatcher polls the outbox and publishes the due events to the mediatr
+// notification handlers and to the sink. An event is marked published only
+// once every handler and the sink accepted it, otherwise it is retried with
+// an exponential backoff until MaxAttempts is reached.
+type Dispatcher struct {
+	txManager repository.TxManager
+	sink      Sink
+	cfg       config.OutboxConfig
+	now       func() time.Time
+}
+
+// NewDispatcher creates a dispatcher; sink may be nil to only notify the
+// in-process handlers.
+func NewDispatcher(txManager repository.TxManager, sink Sink, cfg config.OutboxConfig) *Dispatcher {
+	return &Dispatcher{
+		txManager: txManager,
+		sink:      sink,
+		cfg:       cfg,
+		now:       time.Now,
+	}
+}
+
+// Run dispatches events every PollInterval until ctx is cancelled. A full
+// batch is followed immediately by the next one to drain a backlog.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		dispatched, err := d.DispatchBatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "Failed to dispatch outbox events", "error", err)
+		}
+
+		if err == nil && dispatched == d.cfg.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchBatch locks up to BatchSize due events, publishes them and records
+// the outcome of each, returning how many were attempted. The locks are held
+// until the outcomes are saved, so concurrent dispatchers never publish the
+// same event at the same time.
+func (d *Dispatcher) DispatchBatch(ctx context.Context) (int, error) {
+	var dispatched int
+
+	err := d.txManager.WithinTransaction(ctx, func(ctx context.Context, uow repository.UnitOfWork) error {
+		events, err := uow.Outbox().LockDue(ctx, d.now(), d.cfg.MaxAttempts, d.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		for i := range events {
+			event := &events[i]
+			d.dispatch(ctx, event)
+			if err := uow.Outbox().Update(ctx, event); err != nil {
+				return err
+			}
+		}
+
+		dispatched = len(events)
+		return nil
+	})
+
+	return dispatched, err
+}
+
+func (d *Dispatcher) dispatch(ctx context.Context, event *domain.OutboxEvent) {
+	err := d.publish(ctx, *event)
+	if err == nil {
+		event.MarkPublished(d.now())
+		return
+	}
+
+	event.MarkFailed(err, d.now().Add(Backoff(d.cfg.MinBackoff, d.cfg.MaxBackoff, event.Attempts+1)))
+	if event.Attempts >= d.cfg.MaxAttempts {
+		slog.ErrorContext(ctx, "Giving up on outbox event",
+			"event_id", event.ID, "event_type", event.EventType, "attempts", event.Attempts, "error", err)
+		return
+	}
+	slog.WarnContext(ctx, "Failed to publish outbox event",
+		"event_id", event.ID, "event_type", event.EventType, "attempts", event.Attempts, "error", err)
+}
+
+func (d *Dispatcher) publish(ctx context.Context, event domain.OutboxEvent) error {
+	var errs []error
+	if t, ok := eventTypes[event.EventType]; ok {
+		errs = append(errs, t.notify(ctx, event.Payload))
+	}
+	if d.sink != nil {
+		errs = append(errs, d.sink.Publish(ctx, event))
+	}
+	return errors.Join(errs...)
+}
+
+// Backoff returns the delay after the given number of failed attempts:
+// minDelay doubled for every failure after the first, capped at maxDelay.
+func Backoff(minDelay, maxDelay time.Duration, failures int) time.Duration {
+	delay := minDelay
+	for i := 1; i < failures && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
@@ -0,0 +1,240 @@
+package outbox
+
+import (
+	"arise_tech_assessment/internal/config"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"arise_tech_assessment/mocks"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mehdihadeli/go-mediatr"
+	"github.com/stretchr/testify/mock"
+)
+
+var testConfig = config.OutboxConfig{
+	PollInterval: time.Second,
+	BatchSize:    10,
+	MaxAttempts:  5,
+	MinBackoff:   time.Second,
+	MaxBackoff:   time.Minute,
+}
+
+type sinkFunc func(ctx context.Context, event domain.OutboxEvent) error
+
+func (f sinkFunc) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	return f(ctx, event)
+}
+
+type blockedHandler struct {
+	received []domain.AccountBlocked
+}
+
+func (h *blockedHandler) Handle(ctx context.Context, event domain.AccountBlocked) error {
+	h.received = append(h.received, event)
+	return nil
+}
+
+// newTestDispatcher returns a dispatcher reading events from the outbox mock
+// at a fixed time.
+func newTestDispatcher(t *testing.T, outbox *mocks.MockOutboxRepository, sink Sink, now time.Time) *Dispatcher {
+	uow := mocks.NewMockUnitOfWork(t)
+	uow.EXPECT().Outbox().Return(outbox).Maybe()
+
+	txManager := mocks.NewMockTxManager(t)
+	txManager.EXPECT().
+		WithinTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(ctx context.Context, uow repository.UnitOfWork) error) error {
+			return fn(ctx, uow)
+		})
+
+	d := NewDispatcher(txManager, sink, testConfig)
+	d.now = func() time.Time { return now }
+	return d
+}
+
+func newBlockedEvent(t *testing.T) *domain.OutboxEvent {
+	t.Helper()
+	account, err := domain.NewAccount("12345", "Test User", domain.NewMoney(0, domain.USD))
+	if err != nil {
+		t.Fatalf("Failed to create test account: %v", err)
+	}
+	if err := account.Block(domain.StatusChange{Reason: domain.StatusReasonFraudSuspected, ChangedBy: "risk-team"}); err != nil {
+		t.Fatalf("Failed to block test account: %v", err)
+	}
+	event, err := domain.NewOutboxEvent(account.PendingEvents()[1])
+	if err != nil {
+		t.Fatalf("Failed to create outbox event: %v", err)
+	}
+	return event
+}
+
+func TestDispatcher_DispatchBatch_ShouldNotifyHandlersAndSink(t *testing.T) {
+	// Arrange
+	mediatr.ClearNotificationRegistrations()
+	defer mediatr.ClearNotificationRegistrations()
+	handler := &blockedHandler{}
+	if err := mediatr.RegisterNotificationHandler[domain.AccountBlocked](handler); err != nil {
+		t.Fatalf("Failed to register handler: %v", err)
+	}
+
+	now := time.Now()
+	event := newBlockedEvent(t)
+	var sunk []domain.OutboxEvent
+	sink := sinkFunc(func(ctx context.Context, event domain.OutboxEvent) error {
+		sunk = append(sunk, event)
+		return nil
+	})
+
+	outbox := mocks.NewMockOutboxRepository(t)
+	outbox.EXPECT().LockDue(mock.Anything, now, testConfig.MaxAttempts, testConfig.BatchSize).Return([]domain.OutboxEvent{*event}, nil)
+	outbox.EXPECT().Update(mock.Anything, mock.MatchedBy(func(e *domain.OutboxEvent) bool {
+		return e.ID == event.ID && e.PublishedAt != nil && e.PublishedAt.Equal(now)
+	})).Return(nil)
+
+	// Act
+	dispatched, err := newTestDispatcher(t, outbox, sink, now).DispatchBatch(context.Background())
+
+	// Assert
+	if err != nil || dispatched != 1 {
+		t.Fatalf("Expected 1 event dispatched, got %d (%v)", dispatched, err)
+	}
+	if len(handler.received) != 1 || handler.received[0].ChangedBy != "risk-team" {
+		t.Errorf("Expected the handler to receive the decoded event, got %+v", handler.received)
+	}
+	if len(sunk) != 1 || sunk[0].ID != event.ID {
+		t.Errorf("Expected the sink to receive event %s, got %+v", event.ID, sunk)
+	}
+}
+
+func TestSubscribe_ShouldDeliverDispatchedEventsToHandler(t *testing.T) {
+	// Arrange
+	mediatr.ClearNotificationRegistrations()
+	defer mediatr.ClearNotificationRegistrations()
+	var received []domain.Event
+	err := Subscribe(func(ctx context.Context, event domain.Event) error {
+		received = append(received, event)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to subscribe: %v", err)
+	}
+
+	now := time.Now()
+	event := newBlockedEvent(t)
+
+	outbox := mocks.NewMockOutboxRepository(t)
+	outbox.EXPECT().LockDue(mock.Anything, now, testConfig.MaxAttempts, testConfig.BatchSize).Return([]domain.OutboxEvent{*event}, nil)
+	outbox.EXPECT().Update(mock.Anything, mock.MatchedBy(func(e *domain.OutboxEvent) bool {
+		return e.ID == event.ID && e.PublishedAt != nil
+	})).Return(nil)
+
+	// Act
+	_, err = newTestDispatcher(t, outbox, nil, now).DispatchBatch(context.Background())
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(received) != 1 {
+		t.Fatalf("Expected 1 event, got %d", len(received))
+	}
+	blocked, ok := received[0].(domain.AccountBlocked)
+	if !ok || blocked.AggregateID() != event.AggregateID {
+		t.Errorf("Expected account.blocked for %s, got %+v", event.AggregateID, received[0])
+	}
+}
+
+func TestSubscribe_ShouldCoverEveryPublishedEventType(t *testing.T) {
+	// Arrange
+	mediatr.ClearNotificationRegistrations()
+	defer mediatr.ClearNotificationRegistrations()
+	var received []string
+	err := Subscribe(func(ctx context.Context, event domain.Event) error {
+		received = append(received, event.EventName())
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to subscribe: %v", err)
+	}
+
+	// Act
+	for name, eventType := range eventTypes {
+		if err := eventType.notify(context.Background(), []byte(`{}`)); err != nil {
+			t.Errorf("Expected %s to be delivered, got %v", name, err)
+		}
+	}
+
+	// Assert
+	if len(received) != len(eventTypes) {
+		t.Errorf("Expected %d events, got %v", len(eventTypes), received)
+	}
+}
+
+func TestDispatcher_DispatchBatch_ShouldScheduleRetryWhenSinkFails(t *testing.T) {
+	// Arrange
+	now := time.Now()
+	event := newBlockedEvent(t)
+	event.Attempts = 2
+	sink := sinkFunc(func(ctx context.Context, event domain.OutboxEvent) error {
+		return errors.New("broker unavailable")
+	})
+
+	outbox := mocks.NewMockOutboxRepository(t)
+	outbox.EXPECT().LockDue(mock.Anything, now, testConfig.MaxAttempts, testConfig.BatchSize).Return([]domain.OutboxEvent{*event}, nil)
+	var saved *domain.OutboxEvent
+	outbox.EXPECT().Update(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, e *domain.OutboxEvent) error {
+			saved = e
+			return nil
+		})
+
+	// Act
+	_, err := newTestDispatcher(t, outbox, sink, now).DispatchBatch(context.Background())
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if saved.PublishedAt != nil || saved.Attempts != 3 || saved.LastError != "broker unavailable" {
+		t.Errorf("Expected a third failed attempt, got %+v", saved)
+	}
+	if expected := now.Add(4 * time.Second); !saved.NextAttemptAt.Equal(expected) {
+		t.Errorf("Expected next attempt at %s, got %s", expected, saved.NextAttemptAt)
+	}
+}
+
+func TestDispatcher_DispatchBatch_ShouldReturnLockError(t *testing.T) {
+	// Arrange
+	outbox := mocks.NewMockOutboxRepository(t)
+	outbox.EXPECT().LockDue(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("connection reset"))
+
+	// Act
+	dispatched, err := newTestDispatcher(t, outbox, nil, time.Now()).DispatchBatch(context.Background())
+
+	// Assert
+	if err == nil || dispatched != 0 {
+		t.Errorf("Expected the lock error and nothing dispatched, got %d (%v)", dispatched, err)
+	}
+}
+
+func TestBackoff_ShouldDoubleUpToMax(t *testing.T) {
+	tests := []struct {
+		failures int
+		expected time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{4, 8 * time.Second},
+		{7, time.Minute},
+		{60, time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := Backoff(time.Second, time.Minute, tt.failures); got != tt.expected {
+			t.Errorf("Expected backoff %s after %d failures, got %s", tt.expected, tt.failures, got)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package outbox
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mehdihadeli/go-mediatr"
+)
+
+// EventHandler reacts to any domain event published by the dispatcher.
+type EventHandler func(ctx context.Context, event domain.Event) error
+
+type eventType struct {
+	notify    func(ctx context.Context, payload []byte) error
+	subscribe func(handler EventHandler) error
+}
+
+// eventTypes decode a stored event back into its type by event name, since
+// mediatr routes notifications to handlers by their Go type.
+var eventTypes = map[string]eventType{
+	domain.AccountCreated{}.EventName():       eventTypeOf[domain.AccountCreated](),
+	domain.AccountActivated{}.EventName():     eventTypeOf[domain.AccountActivated](),
+	domain.AccountDeactivated{}.EventName():   eventTypeOf[domain.AccountDeactivated](),
+	domain.AccountBlocked{}.EventName():       eventTypeOf[domain.AccountBlocked](),
+	domain.AccountClosed{}.EventName():        eventTypeOf[domain.AccountClosed](),
+	domain.TransactionCreated{}.EventName():   eventTypeOf[domain.TransactionCreated](),
+	domain.TransactionCompleted{}.EventName(): eventTypeOf[domain.TransactionCompleted](),
+	domain.TransactionFailed{}.EventName():    eventTypeOf[domain.TransactionFailed](),
+	domain.TransactionCancelled{}.EventName(): eventTypeOf[domain.TransactionCancelled](),
+}
+
+func eventTypeOf[T domain.Event]() eventType {
+	return eventType{
+		notify: notify[T],
+		subscribe: func(handler EventHandler) error {
+			return mediatr.RegisterNotificationHandler[T](eventHandler[T](handler))
+		},
+	}
+}
+
+func notify[T domain.Event](ctx context.Context, payload []byte) error {
+	var event T
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return fmt.Errorf("decode %s: %w", event.EventName(), err)
+	}
+	return mediatr.Publish(ctx, event)
+}
+
+// Subscribe registers handler as a mediatr notification handler of every
+// event type the dispatcher publishes.
+func Subscribe(handler EventHandler) error {
+	for name, t := range eventTypes {
+		if err := t.subscribe(handler); err != nil {
+			return fmt.Errorf("subscribe to %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// eventHandler adapts an EventHandler to the notifications of type T.
+type eventHandler[T domain.Event] EventHandler
+
+func (h eventHandler[T]) Handle(ctx context.Context, event T) error {
+	return h(ctx, event)
+}
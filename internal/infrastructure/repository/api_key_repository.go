@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type APIKeyRepository interface {
+	Repository[domain.APIKey, uuid.UUID]
+	FindByHash(ctx context.Context, hash string) (*domain.APIKey, error)
+}
+
+type apiKeyRepository struct {
+	*GormRepository[domain.APIKey, uuid.UUID]
+}
+
+func NewAPIKeyRepository(db *gorm.DB) APIKeyRepository {
+	return &apiKeyRepository{
+		GormRepository: NewGormRepository[domain.APIKey, uuid.UUID](db),
+	}
+}
+
+func (r *apiKeyRepository) FindByHash(ctx context.Context, hash string) (*domain.APIKey, error) {
+	var key domain.APIKey
+	if err := r.conn(ctx).Where("hash = ?", hash).First(&key).Error; err != nil {
+		return nil, translateError[domain.APIKey](err)
+	}
+	return &key, nil
+}
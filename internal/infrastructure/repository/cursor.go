@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"time"
+)
+
+// cursor marks a position in a listing ordered by (created_at, id). It is
+// handed to clients as opaque base64 so that its layout can change.
+type cursor[TKey any] struct {
+	CreatedAt time.Time `json:"t"`
+	ID        TKey      `json:"id"`
+	Backward  bool      `json:"b,omitempty"` // page towards the start of the listing
+}
+
+func encodeCursor[TKey any](c cursor[TKey]) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor[TKey any](raw string) (cursor[TKey], error) {
+	var c cursor[TKey]
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err == nil {
+		err = json.Unmarshal(data, &c)
+	}
+	if err != nil || c.CreatedAt.IsZero() {
+		return c, domain.NewError(domain.ErrorCodeValidation, "invalid cursor")
+	}
+	return c, nil
+}
+
+// cursorAt builds the cursor of an entity from its CreatedAt and ID fields.
+// Entities without them cannot be paged by cursor.
+func cursorAt[T any, TKey any](entity *T, backward bool) (string, bool) {
+	v := reflect.ValueOf(entity).Elem()
+	createdAtField := v.FieldByName("CreatedAt")
+	idField := v.FieldByName("ID")
+	if !createdAtField.IsValid() || !idField.IsValid() {
+		return "", false
+	}
+
+	createdAt, ok := createdAtField.Interface().(time.Time)
+	if !ok {
+		return "", false
+	}
+	id, ok := idField.Interface().(TKey)
+	if !ok {
+		return "", false
+	}
+
+	return encodeCursor(cursor[TKey]{CreatedAt: createdAt, ID: id, Backward: backward}), true
+}
@@ -0,0 +1,347 @@
+package repository
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"context"
+	"database/sql/driver"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var cursorTime = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func mustDecodeCursor(t *testing.T, raw string) cursor[int] {
+	t.Helper()
+	c, err := decodeCursor[int](raw)
+	if err != nil {
+		t.Fatalf("Failed to decode cursor %q: %v", raw, err)
+	}
+	return c
+}
+
+func TestDecodeCursor_ShouldRoundTripEncodedCursor(t *testing.T) {
+	// Arrange
+	original := cursor[int]{CreatedAt: cursorTime, ID: 42, Backward: true}
+
+	// Act
+	decoded, err := decodeCursor[int](encodeCursor(original))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !decoded.CreatedAt.Equal(original.CreatedAt) || decoded.ID != original.ID || decoded.Backward != original.Backward {
+		t.Errorf("Expected %+v, got %+v", original, decoded)
+	}
+}
+
+func TestDecodeCursor_ShouldRejectInvalidCursors(t *testing.T) {
+	valid := encodeCursor(cursor[int]{CreatedAt: cursorTime, ID: 42})
+
+	tests := []struct {
+		name string
+		raw  string
+	}{
+		{"garbage", "not a cursor!"},
+		{"tampered", "X" + valid[1:]},
+		{"truncated", valid[:len(valid)/2]},
+		{"padded standard base64", base64.StdEncoding.EncodeToString([]byte(`{"t":"2024-03-01T12:00:00Z","id":4}`))},
+		{"wrong id type", base64.RawURLEncoding.EncodeToString([]byte(`{"t":"2024-03-01T12:00:00Z","id":"42"}`))},
+		{"missing timestamp", base64.RawURLEncoding.EncodeToString([]byte(`{"id":42}`))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, err := decodeCursor[int](tt.raw)
+
+			// Assert
+			if !errors.Is(err, domain.ErrValidation) {
+				t.Errorf("Expected validation error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestFindPaginated_WithCursor_ShouldSeekPastCursorInListingOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     Specification[widget]
+		backward bool
+		expected string
+	}{
+		{
+			name:     "forward through newest first",
+			spec:     Specification[widget]{},
+			expected: `(created_at, id) < ($1, $2) AND "widgets"."deleted_at" IS NULL ORDER BY created_at DESC,id DESC`,
+		},
+		{
+			name:     "backward through newest first",
+			spec:     Specification[widget]{},
+			backward: true,
+			expected: `(created_at, id) > ($1, $2) AND "widgets"."deleted_at" IS NULL ORDER BY created_at ASC,id ASC`,
+		},
+		{
+			name:     "forward through oldest first",
+			spec:     Specification[widget]{}.OrderBy("created_at", false),
+			expected: `(created_at, id) > ($1, $2) AND "widgets"."deleted_at" IS NULL ORDER BY created_at ASC,id ASC`,
+		},
+		{
+			name:     "backward through oldest first",
+			spec:     Specification[widget]{}.OrderBy("created_at", false),
+			backward: true,
+			expected: `(created_at, id) < ($1, $2) AND "widgets"."deleted_at" IS NULL ORDER BY created_at DESC,id DESC`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			db, mock := newMockDB(t)
+			repo := NewGormRepository[widget, int](db)
+			req := PaginationRequest{
+				PageSize:  2,
+				Cursor:    encodeCursor(cursor[int]{CreatedAt: cursorTime, ID: 7, Backward: tt.backward}),
+				SkipTotal: true,
+			}
+			mock.ExpectQuery(`SELECT * FROM "widgets" WHERE `+tt.expected+` LIMIT $3`).
+				WithArgs(cursorTime, 7, 3).
+				WillReturnRows(sqlmock.NewRows(widgetColumns))
+
+			// Act
+			_, err := repo.FindPaginated(context.Background(), tt.spec, req)
+
+			// Assert
+			if err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestFindPaginated_WithCursorOnUnkeyedOrder_ShouldReturnValidationError(t *testing.T) {
+	// Arrange
+	db, _ := newMockDB(t)
+	repo := NewGormRepository[widget, int](db)
+	req := PaginationRequest{Cursor: encodeCursor(cursor[int]{CreatedAt: cursorTime, ID: 7})}
+
+	// Act
+	_, err := repo.FindPaginated(context.Background(), Specification[widget]{}.OrderBy("name", false), req)
+
+	// Assert
+	if !errors.Is(err, domain.ErrValidation) {
+		t.Errorf("Expected validation error, got %v", err)
+	}
+}
+
+func TestFindPaginated_FirstPage_ShouldOnlyHaveNextCursor(t *testing.T) {
+	// Arrange
+	db, mock := newMockDB(t)
+	repo := NewGormRepository[widget, int](db)
+	mock.ExpectQuery(`SELECT * FROM "widgets" WHERE "widgets"."deleted_at" IS NULL ORDER BY "created_at" DESC,"id" DESC LIMIT $1`).
+		WithArgs(3).
+		WillReturnRows(widgetRows(cursorTime, 1, 2, 3))
+
+	// Act
+	response, err := repo.FindPaginated(context.Background(), Specification[widget]{}, PaginationRequest{Page: 1, PageSize: 2, SkipTotal: true})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(response.Data) != 2 {
+		t.Fatalf("Expected 2 widgets, got %d", len(response.Data))
+	}
+	if response.PrevCursor != "" {
+		t.Errorf("Expected no previous cursor on the first page, got %q", response.PrevCursor)
+	}
+	next := mustDecodeCursor(t, response.NextCursor)
+	if next.ID != 2 || next.Backward {
+		t.Errorf("Expected forward cursor at widget 2, got %+v", next)
+	}
+}
+
+func TestFindPaginated_LastPage_ShouldOnlyHavePrevCursor(t *testing.T) {
+	// Arrange
+	db, mock := newMockDB(t)
+	repo := NewGormRepository[widget, int](db)
+	mock.ExpectQuery(`SELECT * FROM "widgets" WHERE "widgets"."deleted_at" IS NULL ORDER BY "created_at" DESC,"id" DESC LIMIT $1 OFFSET $2`).
+		WithArgs(3, 2).
+		WillReturnRows(widgetRows(cursorTime, 3))
+
+	// Act
+	response, err := repo.FindPaginated(context.Background(), Specification[widget]{}, PaginationRequest{Page: 2, PageSize: 2, SkipTotal: true})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if response.NextCursor != "" {
+		t.Errorf("Expected no next cursor on the last page, got %q", response.NextCursor)
+	}
+	prev := mustDecodeCursor(t, response.PrevCursor)
+	if prev.ID != 3 || !prev.Backward {
+		t.Errorf("Expected backward cursor at widget 3, got %+v", prev)
+	}
+}
+
+func TestFindPaginated_WithCursorReachingLastPage_ShouldOnlyHavePrevCursor(t *testing.T) {
+	// Arrange
+	db, mock := newMockDB(t)
+	repo := NewGormRepository[widget, int](db)
+	req := PaginationRequest{
+		PageSize:  2,
+		Cursor:    encodeCursor(cursor[int]{CreatedAt: cursorTime, ID: 2}),
+		SkipTotal: true,
+	}
+	mock.ExpectQuery(`SELECT * FROM "widgets" WHERE (created_at, id) < ($1, $2) AND "widgets"."deleted_at" IS NULL ORDER BY created_at DESC,id DESC LIMIT $3`).
+		WithArgs(cursorTime, 2, 3).
+		WillReturnRows(widgetRows(cursorTime, 3))
+
+	// Act
+	response, err := repo.FindPaginated(context.Background(), Specification[widget]{}, req)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if response.NextCursor != "" {
+		t.Errorf("Expected no next cursor on the last page, got %q", response.NextCursor)
+	}
+	prev := mustDecodeCursor(t, response.PrevCursor)
+	if prev.ID != 3 || !prev.Backward {
+		t.Errorf("Expected backward cursor at widget 3, got %+v", prev)
+	}
+}
+
+func TestFindPaginated_WithBackwardCursorReachingFirstPage_ShouldOnlyHaveNextCursor(t *testing.T) {
+	// Arrange
+	db, mock := newMockDB(t)
+	repo := NewGormRepository[widget, int](db)
+	req := PaginationRequest{
+		PageSize:  2,
+		Cursor:    encodeCursor(cursor[int]{CreatedAt: cursorTime, ID: 3, Backward: true}),
+		SkipTotal: true,
+	}
+	// Walking backwards the rows come closest to the cursor first.
+	mock.ExpectQuery(`SELECT * FROM "widgets" WHERE (created_at, id) > ($1, $2) AND "widgets"."deleted_at" IS NULL ORDER BY created_at ASC,id ASC LIMIT $3`).
+		WithArgs(cursorTime, 3, 3).
+		WillReturnRows(widgetRows(cursorTime, 2, 1))
+
+	// Act
+	response, err := repo.FindPaginated(context.Background(), Specification[widget]{}, req)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(response.Data) != 2 || response.Data[0].ID != 1 || response.Data[1].ID != 2 {
+		t.Fatalf("Expected widgets 1 and 2 in listing order, got %+v", response.Data)
+	}
+	if response.PrevCursor != "" {
+		t.Errorf("Expected no previous cursor on the first page, got %q", response.PrevCursor)
+	}
+	next := mustDecodeCursor(t, response.NextCursor)
+	if next.ID != 2 || next.Backward {
+		t.Errorf("Expected forward cursor at widget 2, got %+v", next)
+	}
+}
+
+func TestFindPaginated_WithCursorInTheMiddle_ShouldHaveBothCursors(t *testing.T) {
+	// Arrange
+	db, mock := newMockDB(t)
+	repo := NewGormRepository[widget, int](db)
+	req := PaginationRequest{
+		PageSize:  2,
+		Cursor:    encodeCursor(cursor[int]{CreatedAt: cursorTime, ID: 2}),
+		SkipTotal: true,
+	}
+	mock.ExpectQuery(`SELECT * FROM "widgets" WHERE (created_at, id) < ($1, $2) AND "widgets"."deleted_at" IS NULL ORDER BY created_at DESC,id DESC LIMIT $3`).
+		WithArgs(cursorTime, 2, 3).
+		WillReturnRows(widgetRows(cursorTime, 3, 4, 5))
+
+	// Act
+	response, err := repo.FindPaginated(context.Background(), Specification[widget]{}, req)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if prev := mustDecodeCursor(t, response.PrevCursor); prev.ID != 3 || !prev.Backward {
+		t.Errorf("Expected backward cursor at widget 3, got %+v", prev)
+	}
+	if next := mustDecodeCursor(t, response.NextCursor); next.ID != 4 || next.Backward {
+		t.Errorf("Expected forward cursor at widget 4, got %+v", next)
+	}
+}
+
+func TestFindPaginated_ShouldCountRows(t *testing.T) {
+	// Arrange
+	db, mock := newMockDB(t)
+	repo := NewGormRepository[widget, int](db)
+	mock.ExpectQuery(`SELECT count(*) FROM "widgets" WHERE "widgets"."deleted_at" IS NULL`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+	mock.ExpectQuery(`SELECT * FROM "widgets" WHERE "widgets"."deleted_at" IS NULL ORDER BY "created_at" DESC,"id" DESC LIMIT $1`).
+		WithArgs(3).
+		WillReturnRows(widgetRows(cursorTime, 1, 2, 3))
+
+	// Act
+	response, err := repo.FindPaginated(context.Background(), Specification[widget]{}, PaginationRequest{PageSize: 2})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if response.Total != 5 || response.TotalPages != 3 {
+		t.Errorf("Expected 5 widgets on 3 pages, got %d on %d", response.Total, response.TotalPages)
+	}
+}
+
+func TestFindPaginated_WithSkipTotal_ShouldNotCountRows(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    PaginationRequest
+		query  string
+		values []driver.Value
+	}{
+		{
+			name:   "by page",
+			req:    PaginationRequest{PageSize: 2, SkipTotal: true},
+			query:  `SELECT * FROM "widgets" WHERE "widgets"."deleted_at" IS NULL ORDER BY "created_at" DESC,"id" DESC LIMIT $1`,
+			values: []driver.Value{3},
+		},
+		{
+			name:   "by cursor",
+			req:    PaginationRequest{PageSize: 2, Cursor: encodeCursor(cursor[int]{CreatedAt: cursorTime, ID: 2}), SkipTotal: true},
+			query:  `SELECT * FROM "widgets" WHERE (created_at, id) < ($1, $2) AND "widgets"."deleted_at" IS NULL ORDER BY created_at DESC,id DESC LIMIT $3`,
+			values: []driver.Value{cursorTime, 2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			db, mock := newMockDB(t)
+			repo := NewGormRepository[widget, int](db)
+			// No COUNT(*) is expected, so sqlmock fails the test if one is issued.
+			mock.ExpectQuery(tt.query).
+				WithArgs(tt.values...).
+				WillReturnRows(widgetRows(cursorTime, 3))
+
+			// Act
+			response, err := repo.FindPaginated(context.Background(), Specification[widget]{}, tt.req)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if response.Total != unknownTotal || response.TotalPages != unknownTotal {
+				t.Errorf("Expected unknown totals, got %d on %d pages", response.Total, response.TotalPages)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"gorm.io/gorm"
+)
+
+// ErrConcurrentUpdate is returned by Update when the entity was modified by
+// someone else since it was loaded. It matches domain.ErrConflict.
+var ErrConcurrentUpdate = fmt.Errorf("%w: entity was modified concurrently", domain.ErrConflict)
+
+// translateError converts GORM errors into domain errors naming the entity T.
+// Other errors are returned unchanged.
+func translateError[T any](err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return domain.Errorf(domain.ErrorCodeNotFound, "%s not found", entityName[T]())
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return domain.Errorf(domain.ErrorCodeConflict, "%s already exists", entityName[T]())
+	case errors.Is(err, gorm.ErrCheckConstraintViolated):
+		// The domain validates the same rules, so this only catches writes that bypassed it.
+		return domain.Errorf(domain.ErrorCodeValidation, "%s violates a data integrity rule", entityName[T]())
+	case errors.Is(err, gorm.ErrForeignKeyViolated):
+		return domain.Errorf(domain.ErrorCodeValidation, "%s references a record that does not exist or is still in use", entityName[T]())
+	default:
+		return err
+	}
+}
+
+// entityName turns the type name of T into words, e.g. LedgerEntry becomes "ledger entry".
+func entityName[T any]() string {
+	name := reflect.TypeOf((*T)(nil)).Elem().Name()
+
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte(' ')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
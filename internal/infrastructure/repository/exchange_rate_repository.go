@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ExchangeRateRepository interface {
+	Repository[domain.ExchangeRate, uuid.UUID]
+	domain.ExchangeRateProvider
+}
+
+type exchangeRateRepository struct {
+	*GormRepository[domain.ExchangeRate, uuid.UUID]
+}
+
+func NewExchangeRateRepository(db *gorm.DB) ExchangeRateRepository {
+	return &exchangeRateRepository{
+		GormRepository: NewGormRepository[domain.ExchangeRate, uuid.UUID](db),
+	}
+}
+
+// GetRate returns the most recently effective rate for the pair. A rate
+// published for the opposite direction is inverted when it is the newer one.
+func (r *exchangeRateRepository) GetRate(ctx context.Context, base, quote domain.Currency) (*domain.ExchangeRate, error) {
+	var rate domain.ExchangeRate
+	err := r.conn(ctx).
+		Where("((base_currency = ? AND quote_currency = ?) OR (base_currency = ? AND quote_currency = ?)) AND effective_at <= ?",
+			base, quote, quote, base, time.Now()).
+		Order("effective_at DESC").
+		First(&rate).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domain.Errorf(domain.ErrorCodeNotFound, "no exchange rate for %s/%s", base, quote)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if rate.BaseCurrency != base {
+		return rate.Inverse(), nil
+	}
+	return &rate, nil
+}
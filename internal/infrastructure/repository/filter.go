@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SortDirection string
+
+const (
+	SortAscending  SortDirection = "asc"
+	SortDescending SortDirection = "desc"
+)
+
+// SortField orders a listing by one of the whitelisted fields of the entity.
+type SortField struct {
+	Field     string        `json:"field"`
+	Direction SortDirection `json:"direction"`
+}
+
+// AccountSortFields maps the account fields clients may sort by to their columns.
+var AccountSortFields = map[string]string{
+	"created_at":  "created_at",
+	"updated_at":  "updated_at",
+	"number":      "number",
+	"holder_name": "holder_name",
+	"balance":     "amount",
+	"status":      "status",
+}
+
+// TransactionSortFields maps the transaction fields clients may sort by to their columns.
+var TransactionSortFields = map[string]string{
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+	"amount":     "amount",
+	"type":       "type",
+	"status":     "status",
+	"reference":  "reference",
+}
+
+// AccountFilter narrows an account listing. Zero-valued fields are ignored.
+type AccountFilter struct {
+	Status         domain.AccountStatus `json:"status,omitempty"`
+	HolderName     string               `json:"holder_name,omitempty"` // case-insensitive substring match
+	Currency       domain.Currency      `json:"currency,omitempty"`
+	MinBalance     *int64               `json:"min_balance,omitempty"`
+	MaxBalance     *int64               `json:"max_balance,omitempty"`
+	CreatedFrom    *time.Time           `json:"created_from,omitempty"`
+	CreatedTo      *time.Time           `json:"created_to,omitempty"`
+	IncludeDeleted bool                 `json:"include_deleted,omitempty"`
+	OwnerID        string               `json:"owner_id,omitempty"`
+}
+
+// Spec builds the specification selecting the matching accounts in the given order.
+func (f AccountFilter) Spec(sort []SortField) (Specification[domain.Account], error) {
+	spec := CreatedBetween[domain.Account](f.CreatedFrom, f.CreatedTo).
+		And(AmountBetween[domain.Account](f.MinBalance, f.MaxBalance))
+	if f.IncludeDeleted {
+		spec = spec.WithDeleted()
+	}
+	if f.Status != "" {
+		spec = spec.And(AccountStatusIs(f.Status))
+	}
+	if f.HolderName != "" {
+		spec = spec.And(HolderNameContains(f.HolderName))
+	}
+	if f.OwnerID != "" {
+		spec = spec.And(AccountOwnedBy(f.OwnerID))
+	}
+	if f.Currency != "" {
+		spec = spec.And(InCurrency[domain.Account](f.Currency))
+	}
+
+	return sortedBy(spec, sort, AccountSortFields)
+}
+
+// TransactionFilter narrows a transaction listing. Zero-valued fields are ignored.
+type TransactionFilter struct {
+	Status      domain.TransactionStatus `json:"status,omitempty"`
+	Type        domain.TransactionType   `json:"type,omitempty"`
+	AccountID   *uuid.UUID               `json:"account_id,omitempty"` // matches either side of the transaction
+	Currency    domain.Currency          `json:"currency,omitempty"`
+	MinAmount   *int64                   `json:"min_amount,omitempty"`
+	MaxAmount   *int64                   `json:"max_amount,omitempty"`
+	CreatedFrom *time.Time               `json:"created_from,omitempty"`
+	CreatedTo   *time.Time               `json:"created_to,omitempty"`
+	OwnerID     string                   `json:"owner_id,omitempty"` // matches an account of the owner on either side
+}
+
+// Spec builds the specification selecting the matching transactions in the given order.
+func (f TransactionFilter) Spec(sort []SortField) (Specification[domain.Transaction], error) {
+	spec := CreatedBetween[domain.Transaction](f.CreatedFrom, f.CreatedTo).
+		And(AmountBetween[domain.Transaction](f.MinAmount, f.MaxAmount))
+	if f.Status != "" {
+		spec = spec.And(TransactionStatusIs(f.Status))
+	}
+	if f.Type != "" {
+		spec = spec.And(TransactionTypeIs(f.Type))
+	}
+	if f.AccountID != nil {
+		spec = spec.And(InvolvingAccount(*f.AccountID))
+	}
+	if f.OwnerID != "" {
+		spec = spec.And(InvolvingAccountOwnedBy(f.OwnerID))
+	}
+	if f.Currency != "" {
+		spec = spec.And(InCurrency[domain.Transaction](f.Currency))
+	}
+
+	return sortedBy(spec, sort, TransactionSortFields)
+}
+
+// sortedBy orders spec by the sort fields, translated through the columns whitelist.
+func sortedBy[T any](spec Specification[T], sort []SortField, columns map[string]string) (Specification[T], error) {
+	for _, s := range sort {
+		column, ok := columns[s.Field]
+		if !ok {
+			return spec, domain.Errorf(domain.ErrorCodeValidation, "cannot sort by %q", s.Field)
+		}
+
+		switch s.Direction {
+		case SortAscending, "":
+			spec = spec.OrderBy(column, false)
+		case SortDescending:
+			spec = spec.OrderBy(column, true)
+		default:
+			return spec, domain.Errorf(domain.ErrorCodeValidation, "invalid sort direction %q", s.Direction)
+		}
+	}
+	return spec, nil
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// widget is a minimal soft-deletable entity for exercising the generic repository.
+type widget struct {
+	ID        int
+	Name      string
+	Parts     []widgetPart
+	CreatedAt time.Time
+	DeletedAt gorm.DeletedAt
+}
+
+type widgetPart struct {
+	ID       int
+	WidgetID int
+}
+
+var widgetColumns = []string{"id", "name", "created_at", "deleted_at"}
+
+// newMockDB returns a postgres GORM session backed by sqlmock. Queries must
+// match the expectations exactly, and all of them must have run by the end of the test.
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+	sqlDB, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("Failed to create sql mock: %v", err)
+	}
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:         logger.Discard,
+		TranslateError: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to open gorm: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("Unmet database expectations: %v", err)
+		}
+	})
+	return db, mock
+}
+
+// widgetRows returns a widget for each id, created a minute apart starting at first.
+func widgetRows(first time.Time, ids ...int) *sqlmock.Rows {
+	rows := sqlmock.NewRows(widgetColumns)
+	for i, id := range ids {
+		rows.AddRow(id, "widget", first.Add(time.Duration(i)*time.Minute), nil)
+	}
+	return rows
+}
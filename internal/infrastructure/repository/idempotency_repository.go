@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IdempotencyRepository interface {
+	FindByKey(ctx context.Context, key string) (*domain.IdempotencyRecord, error)
+	Reserve(ctx context.Context, record *domain.IdempotencyRecord) (bool, error)
+	Complete(ctx context.Context, key string, statusCode int, contentType string, body []byte) error
+	Release(ctx context.Context, key string) error
+}
+
+type idempotencyRepository struct {
+	db *gorm.DB
+}
+
+func NewIdempotencyRepository(db *gorm.DB) IdempotencyRepository {
+	return &idempotencyRepository{db: db}
+}
+
+func (r *idempotencyRepository) FindByKey(ctx context.Context, key string) (*domain.IdempotencyRecord, error) {
+	var record domain.IdempotencyRecord
+	if err := r.db.WithContext(ctx).Where("key = ?", key).First(&record).Error; err != nil {
+		return nil, translateError[domain.IdempotencyRecord](err)
+	}
+	return &record, nil
+}
+
+// Reserve claims the key for a new request. It returns false when the key is
+// already held by an unexpired record; expired records are taken over.
+func (r *idempotencyRepository) Reserve(ctx context.Context, record *domain.IdempotencyRecord) (bool, error) {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"request_hash", "status_code", "content_type", "response_body", "created_at", "expires_at",
+		}),
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Lt{Column: clause.Column{Table: "idempotency_records", Name: "expires_at"}, Value: record.CreatedAt},
+		}},
+	}).Create(record)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *idempotencyRepository) Complete(ctx context.Context, key string, statusCode int, contentType string, body []byte) error {
+	return r.db.WithContext(ctx).Model(&domain.IdempotencyRecord{}).Where("key = ?", key).Updates(map[string]any{
+		"status_code":   statusCode,
+		"content_type":  contentType,
+		"response_body": body,
+	}).Error
+}
+
+// Release drops an in-flight reservation so the request can be retried.
+func (r *idempotencyRepository) Release(ctx context.Context, key string) error {
+	return r.db.WithContext(ctx).Where("key = ? AND status_code = 0", key).Delete(&domain.IdempotencyRecord{}).Error
+}
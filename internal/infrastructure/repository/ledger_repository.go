@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type LedgerRepository interface {
+	Repository[domain.LedgerEntry, uuid.UUID]
+	CreateEntries(ctx context.Context, entries []domain.LedgerEntry) error
+	FindByTransactionID(ctx context.Context, transactionID uuid.UUID) ([]domain.LedgerEntry, error)
+	FindByAccountIDPaginated(ctx context.Context, accountID uuid.UUID, req PaginationRequest) (*PaginationResponse[domain.LedgerEntry], error)
+	SumByAccountID(ctx context.Context, accountID uuid.UUID, currency domain.Currency) (domain.Money, error)
+}
+
+type ledgerRepository struct {
+	*GormRepository[domain.LedgerEntry, uuid.UUID]
+}
+
+func NewLedgerRepository(db *gorm.DB) LedgerRepository {
+	return &ledgerRepository{
+		GormRepository: NewGormRepository[domain.LedgerEntry, uuid.UUID](db),
+	}
+}
+
+func (r *ledgerRepository) CreateEntries(ctx context.Context, entries []domain.LedgerEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	return translateError[domain.LedgerEntry](r.conn(ctx).Create(&entries).Error)
+}
+
+func (r *ledgerRepository) FindByTransactionID(ctx context.Context, transactionID uuid.UUID) ([]domain.LedgerEntry, error) {
+	return r.FindAll(ctx, Where[domain.LedgerEntry]("transaction_id = ?", transactionID).OrderBy("created_at", false))
+}
+
+func (r *ledgerRepository) FindByAccountIDPaginated(ctx context.Context, accountID uuid.UUID, req PaginationRequest) (*PaginationResponse[domain.LedgerEntry], error) {
+	return r.FindPaginated(ctx, Where[domain.LedgerEntry]("account_id = ?", accountID), req)
+}
+
+// SumByAccountID rebuilds an account's balance in the given currency from its ledger entries.
+func (r *ledgerRepository) SumByAccountID(ctx context.Context, accountID uuid.UUID, currency domain.Currency) (domain.Money, error) {
+	var sum int64
+	err := r.conn(ctx).
+		Model(&domain.LedgerEntry{}).
+		Select("COALESCE(SUM(CASE WHEN direction = ? THEN -amount ELSE amount END), 0)", domain.EntryDirectionDebit).
+		Where("account_id = ? AND currency = ?", accountID, currency).
+		Scan(&sum).Error
+	if err != nil {
+		return domain.Money{}, err
+	}
+	return domain.NewMoney(sum, currency), nil
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type OutboxRepository interface {
+	Repository[domain.OutboxEvent, uuid.UUID]
+	// LockDue locks up to limit unpublished events due at now, oldest first,
+	// skipping events already locked by another dispatcher. It must be called
+	// within a transaction, which holds the locks.
+	LockDue(ctx context.Context, now time.Time, maxAttempts, limit int) ([]domain.OutboxEvent, error)
+}
+
+type outboxRepository struct {
+	*GormRepository[domain.OutboxEvent, uuid.UUID]
+}
+
+func NewOutboxRepository(db *gorm.DB) OutboxRepository {
+	return &outboxRepository{
+		GormRepository: NewGormRepository[domain.OutboxEvent, uuid.UUID](db),
+	}
+}
+
+func (r *outboxRepository) LockDue(ctx context.Context, now time.Time, maxAttempts, limit int) ([]domain.OutboxEvent, error) {
+	var events []domain.OutboxEvent
+	err := r.conn(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("published_at IS NULL AND next_attempt_at <= ? AND attempts < ?", now, maxAttempts).
+		Order("occurred_at, id").
+		Limit(limit).
+		Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// eventSource is implemented by aggregates raising domain events.
+type eventSource interface {
+	PendingEvents() []domain.Event
+	ClearEvents()
+}
+
+// addToOutbox stores events in the outbox using db, which must be the
+// transaction that saves the aggregate raising them.
+func addToOutbox(db *gorm.DB, events []domain.Event) error {
+	messages := make([]*domain.OutboxEvent, 0, len(events))
+	for _, event := range events {
+		message, err := domain.NewOutboxEvent(event)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, message)
+	}
+	return db.Create(messages).Error
+}
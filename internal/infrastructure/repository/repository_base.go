@@ -1,33 +1,65 @@
 package repository
 
 import (
+	"arise_tech_assessment/internal/domain"
 	"context"
+	"slices"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// PaginationRequest selects a page either by number or, when Cursor is set,
+// by continuing from a cursor returned in a previous response.
 type PaginationRequest struct {
-	Page     int `json:"page"`
-	PageSize int `json:"page_size"`
+	Page      int    `json:"page"`
+	PageSize  int    `json:"page_size"`
+	Cursor    string `json:"cursor,omitempty"`
+	SkipTotal bool   `json:"skip_total,omitempty"` // skip the COUNT(*) query
 }
 
+// PaginationResponse holds one page of results. Total and TotalPages are -1
+// when the count was skipped; Page is 0 for pages selected by cursor.
 type PaginationResponse[T any] struct {
-	Data       []T `json:"data"`
-	Page       int `json:"page"`
-	PageSize   int `json:"page_size"`
-	Total      int `json:"total"`
-	TotalPages int `json:"total_pages"`
+	Data       []T    `json:"data"`
+	Page       int    `json:"page"`
+	PageSize   int    `json:"page_size"`
+	Total      int    `json:"total"`
+	TotalPages int    `json:"total_pages"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
 }
 
+// unknownTotal is reported when the total count was skipped.
+const unknownTotal = -1
+
 type Repository[T any, TKey any] interface {
 	GetByID(ctx context.Context, id TKey) (*T, error)
+	GetByIDForUpdate(ctx context.Context, id TKey) (*T, error)
 	GetAll(ctx context.Context) ([]T, error)
 	GetPaginated(ctx context.Context, req PaginationRequest) (*PaginationResponse[T], error)
+	FindAll(ctx context.Context, spec Specification[T]) ([]T, error)
+	FindPaginated(ctx context.Context, spec Specification[T], req PaginationRequest) (*PaginationResponse[T], error)
 	Create(ctx context.Context, entity *T) error
 	Update(ctx context.Context, entity *T) error
 	Delete(ctx context.Context, id TKey) error
 }
 
+// SoftDeleteRepository is implemented by repositories of entities with a
+// gorm.DeletedAt column. Regular queries skip deleted rows; these variants
+// include them.
+type SoftDeleteRepository[T any, TKey any] interface {
+	GetByIDWithDeleted(ctx context.Context, id TKey) (*T, error)
+	GetPaginatedWithDeleted(ctx context.Context, req PaginationRequest) (*PaginationResponse[T], error)
+	Restore(ctx context.Context, id TKey) error
+}
+
+// versioned is implemented by entities carrying an optimistic concurrency token.
+type versioned interface {
+	CurrentVersion() int64
+	SetVersion(version int64)
+}
+
 type GormRepository[T any, TKey any] struct {
 	db *gorm.DB
 }
@@ -36,66 +68,264 @@ func NewGormRepository[T any, TKey any](db *gorm.DB) *GormRepository[T, TKey] {
 	return &GormRepository[T, TKey]{db: db}
 }
 
+// conn returns the session for ctx, joining the transaction opened by a
+// TxManager for it if there is one.
+func (r *GormRepository[T, TKey]) conn(ctx context.Context) *gorm.DB {
+	return dbFromContext(ctx, r.db).WithContext(ctx)
+}
+
 func (r *GormRepository[T, TKey]) GetByID(ctx context.Context, id TKey) (*T, error) {
 	var entity T
-	if err := r.db.WithContext(ctx).First(&entity, id).Error; err != nil {
-		return nil, err
+	if err := r.conn(ctx).First(&entity, id).Error; err != nil {
+		return nil, translateError[T](err)
+	}
+	return &entity, nil
+}
+
+// GetByIDForUpdate loads the entity and holds a row lock (SELECT ... FOR UPDATE)
+// until the surrounding transaction ends.
+func (r *GormRepository[T, TKey]) GetByIDForUpdate(ctx context.Context, id TKey) (*T, error) {
+	var entity T
+	if err := r.conn(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).First(&entity, id).Error; err != nil {
+		return nil, translateError[T](err)
 	}
 	return &entity, nil
 }
 
 func (r *GormRepository[T, TKey]) GetAll(ctx context.Context) ([]T, error) {
 	var entities []T
-	if err := r.db.WithContext(ctx).Find(&entities).Error; err != nil {
+	if err := r.conn(ctx).Find(&entities).Error; err != nil {
 		return nil, err
 	}
 	return entities, nil
 }
 
 func (r *GormRepository[T, TKey]) GetPaginated(ctx context.Context, req PaginationRequest) (*PaginationResponse[T], error) {
-	if req.Page <= 0 {
-		req.Page = 1
+	return r.FindPaginated(ctx, Specification[T]{}, req)
+}
+
+// FindAll returns every entity selected by spec.
+func (r *GormRepository[T, TKey]) FindAll(ctx context.Context, spec Specification[T]) ([]T, error) {
+	var entities []T
+	if err := spec.ordered(spec.where(r.conn(ctx))).Find(&entities).Error; err != nil {
+		return nil, err
 	}
+	return entities, nil
+}
+
+// FindPaginated returns one page of the entities selected by spec. Listings
+// ordered by created_at also get cursors, so clients can switch from page
+// numbers to keyset pagination at any point.
+func (r *GormRepository[T, TKey]) FindPaginated(ctx context.Context, spec Specification[T], req PaginationRequest) (*PaginationResponse[T], error) {
 	if req.PageSize <= 0 {
 		req.PageSize = 10
 	}
 
+	// Start a fresh session so the count and the page queries do not share conditions.
+	db := spec.where(r.conn(ctx)).Session(&gorm.Session{})
+
+	if req.Cursor != "" {
+		return r.paginateByCursor(db, spec, req)
+	}
+
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+
+	response, err := r.newPaginationResponse(db, req)
+	if err != nil {
+		return nil, err
+	}
+	response.Page = req.Page
+
 	var entities []T
-	var total int64
+	offset := (req.Page - 1) * req.PageSize
+	if err := spec.ordered(db).Offset(offset).Limit(req.PageSize + 1).Find(&entities).Error; err != nil {
+		return nil, err
+	}
+
+	hasMore := len(entities) > req.PageSize
+	if hasMore {
+		entities = entities[:req.PageSize]
+	}
+	response.Data = entities
+
+	if keyset, _ := spec.keysetOrder(); keyset && len(entities) > 0 {
+		if hasMore {
+			response.NextCursor, _ = cursorAt[T, TKey](&entities[len(entities)-1], false)
+		}
+		if req.Page > 1 {
+			response.PrevCursor, _ = cursorAt[T, TKey](&entities[0], true)
+		}
+	}
+
+	return response, nil
+}
+
+func (r *GormRepository[T, TKey]) GetByIDWithDeleted(ctx context.Context, id TKey) (*T, error) {
+	var entity T
+	if err := r.conn(ctx).Unscoped().First(&entity, id).Error; err != nil {
+		return nil, translateError[T](err)
+	}
+	return &entity, nil
+}
 
+func (r *GormRepository[T, TKey]) GetPaginatedWithDeleted(ctx context.Context, req PaginationRequest) (*PaginationResponse[T], error) {
+	return r.FindPaginated(ctx, Specification[T]{}.WithDeleted(), req)
+}
+
+// Restore clears the deletion mark of a soft-deleted entity.
+func (r *GormRepository[T, TKey]) Restore(ctx context.Context, id TKey) error {
 	var entity T
-	if err := r.db.WithContext(ctx).Model(&entity).Count(&total).Error; err != nil {
+	result := r.conn(ctx).Unscoped().Model(&entity).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		return translateError[T](result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.Errorf(domain.ErrorCodeNotFound, "deleted %s not found", entityName[T]())
+	}
+	return nil
+}
+
+// paginateByCursor returns the page after (or before, for backward cursors)
+// the cursor position using a (created_at, id) row comparison instead of OFFSET.
+func (r *GormRepository[T, TKey]) paginateByCursor(db *gorm.DB, spec Specification[T], req PaginationRequest) (*PaginationResponse[T], error) {
+	keyset, descending := spec.keysetOrder()
+	if !keyset {
+		return nil, domain.NewError(domain.ErrorCodeValidation, "cursor pagination only supports sorting by created_at")
+	}
+
+	c, err := decodeCursor[TKey](req.Cursor)
+	if err != nil {
 		return nil, err
 	}
 
-	offset := (req.Page - 1) * req.PageSize
-	if err := r.db.WithContext(ctx).Offset(offset).Limit(req.PageSize).Find(&entities).Error; err != nil {
+	response, err := r.newPaginationResponse(db, req)
+	if err != nil {
 		return nil, err
 	}
 
-	totalPages := int(total) / req.PageSize
-	if int(total)%req.PageSize > 0 {
-		totalPages++
+	// Walking backwards flips both the comparison and the order.
+	operator, direction := ">", "ASC"
+	if descending != c.Backward {
+		operator, direction = "<", "DESC"
+	}
+
+	var entities []T
+	err = db.Where("(created_at, id) "+operator+" (?, ?)", c.CreatedAt, c.ID).
+		Order("created_at " + direction).
+		Order("id " + direction).
+		Limit(req.PageSize + 1).
+		Find(&entities).Error
+	if err != nil {
+		return nil, err
+	}
+
+	hasMore := len(entities) > req.PageSize
+	if hasMore {
+		entities = entities[:req.PageSize]
+	}
+	if c.Backward {
+		slices.Reverse(entities)
+	}
+	response.Data = entities
+
+	if len(entities) > 0 {
+		if hasMore || !c.Backward {
+			response.PrevCursor, _ = cursorAt[T, TKey](&entities[0], true)
+		}
+		if hasMore || c.Backward {
+			response.NextCursor, _ = cursorAt[T, TKey](&entities[len(entities)-1], false)
+		}
 	}
 
-	return &PaginationResponse[T]{
-		Data:       entities,
-		Page:       req.Page,
+	return response, nil
+}
+
+func (r *GormRepository[T, TKey]) newPaginationResponse(db *gorm.DB, req PaginationRequest) (*PaginationResponse[T], error) {
+	response := &PaginationResponse[T]{
 		PageSize:   req.PageSize,
-		Total:      int(total),
-		TotalPages: totalPages,
-	}, nil
+		Total:      unknownTotal,
+		TotalPages: unknownTotal,
+	}
+	if req.SkipTotal {
+		return response, nil
+	}
+
+	var total int64
+	var entity T
+	if err := db.Model(&entity).Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	response.Total = int(total)
+	response.TotalPages = int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		response.TotalPages++
+	}
+
+	return response, nil
 }
 
+// Create inserts the entity, together with the domain events it raised.
 func (r *GormRepository[T, TKey]) Create(ctx context.Context, entity *T) error {
-	return r.db.WithContext(ctx).Create(entity).Error
+	return r.write(ctx, entity, func(db *gorm.DB) error {
+		return translateError[T](db.Create(entity).Error)
+	})
 }
 
+// Update saves the entity, together with the domain events it raised.
+// Versioned entities are only written when the stored version still matches
+// the loaded one, otherwise ErrConcurrentUpdate is returned.
 func (r *GormRepository[T, TKey]) Update(ctx context.Context, entity *T) error {
-	return r.db.WithContext(ctx).Save(entity).Error
+	return r.write(ctx, entity, func(db *gorm.DB) error {
+		v, ok := any(entity).(versioned)
+		if !ok {
+			return translateError[T](db.Save(entity).Error)
+		}
+
+		current := v.CurrentVersion()
+		v.SetVersion(current + 1)
+
+		result := db.Model(entity).Where("version = ?", current).Select("*").Updates(entity)
+		if result.Error != nil {
+			v.SetVersion(current)
+			return translateError[T](result.Error)
+		}
+		if result.RowsAffected == 0 {
+			v.SetVersion(current)
+			return ErrConcurrentUpdate
+		}
+
+		return nil
+	})
+}
+
+// write runs save and, when the entity raised domain events, adds them to the
+// outbox in the same transaction. The events are cleared once committed.
+func (r *GormRepository[T, TKey]) write(ctx context.Context, entity *T, save func(db *gorm.DB) error) error {
+	source, ok := any(entity).(eventSource)
+	if !ok || len(source.PendingEvents()) == 0 {
+		return save(r.conn(ctx))
+	}
+
+	err := r.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := save(tx); err != nil {
+			return err
+		}
+		return addToOutbox(tx, source.PendingEvents())
+	})
+	if err != nil {
+		return err
+	}
+
+	source.ClearEvents()
+	return nil
 }
 
 func (r *GormRepository[T, TKey]) Delete(ctx context.Context, id TKey) error {
 	var entity T
-	return r.db.WithContext(ctx).Delete(&entity, id).Error
+	return translateError[T](r.conn(ctx).Delete(&entity, id).Error)
 }
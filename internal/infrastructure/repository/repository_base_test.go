@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestDelete_WhenStillReferenced_ShouldReturnValidationError(t *testing.T) {
+	// Arrange
+	db, mock := newMockDB(t)
+	repo := NewGormRepository[widgetPart, int](db)
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM "widget_parts" WHERE "widget_parts"."id" = $1`).
+		WithArgs(10).
+		WillReturnError(&pgconn.PgError{Code: "23503"})
+	mock.ExpectRollback()
+
+	// Act
+	err := repo.Delete(context.Background(), 10)
+
+	// Assert
+	if !errors.Is(err, domain.ErrValidation) {
+		t.Errorf("Expected validation error, got %v", err)
+	}
+	if err == nil || err.Error() != "widget part references a record that does not exist or is still in use" {
+		t.Errorf("Expected the error to name the widget part, got %v", err)
+	}
+}
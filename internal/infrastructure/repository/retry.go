@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"errors"
+)
+
+// DefaultConflictRetries is the number of attempts used for read-modify-write
+// operations that may lose an optimistic concurrency race.
+const DefaultConflictRetries = 3
+
+// RetryOnConflict runs fn until it succeeds, fails with an error other than
+// ErrConcurrentUpdate, or the given number of attempts is exhausted. fn must
+// reload any state it modifies, as the previous attempt's data is stale.
+func RetryOnConflict(ctx context.Context, attempts int, fn func(ctx context.Context) error) error {
+	var err error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+
+		err = fn(ctx)
+		if !errors.Is(err, ErrConcurrentUpdate) {
+			return err
+		}
+	}
+	return err
+}
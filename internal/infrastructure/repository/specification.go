@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Specification describes a query over T: a predicate composed with And, Or
+// and Not, the ordering of the results and the associations to preload. The
+// zero value selects every row in the repository's default order.
+type Specification[T any] struct {
+	predicate clause.Expression
+	orders    []clause.OrderByColumn
+	preloads  []string
+	unscoped  bool
+}
+
+// Where returns a specification selecting the rows for which the SQL
+// condition holds. Conditions use ? placeholders for args.
+func Where[T any](query string, args ...any) Specification[T] {
+	return Specification[T]{predicate: clause.Expr{SQL: query, Vars: args}}
+}
+
+// And narrows s to the rows that also satisfy every one of others.
+func (s Specification[T]) And(others ...Specification[T]) Specification[T] {
+	predicates, _ := s.predicates(others)
+	combined := s.merge(others)
+	combined.predicate = clause.And(predicates...)
+	return combined
+}
+
+// Or widens s to the rows that satisfy any one of others.
+func (s Specification[T]) Or(others ...Specification[T]) Specification[T] {
+	predicates, matchesAll := s.predicates(others)
+	combined := s.merge(others)
+	combined.predicate = nil
+	if !matchesAll {
+		combined.predicate = clause.Or(predicates...)
+	}
+	return combined
+}
+
+// Not selects the rows that do not satisfy s. Negating a specification that
+// matches every row, such as the zero value, matches none.
+func Not[T any](s Specification[T]) Specification[T] {
+	if s.predicate == nil {
+		s.predicate = clause.Expr{SQL: "1 = 0"}
+		return s
+	}
+	s.predicate = clause.Not(s.predicate)
+	return s
+}
+
+// OrderBy appends a sort column. Later calls break ties of earlier ones.
+func (s Specification[T]) OrderBy(column string, desc bool) Specification[T] {
+	s.orders = append(s.orders[:len(s.orders):len(s.orders)], clause.OrderByColumn{
+		Column: clause.Column{Name: column},
+		Desc:   desc,
+	})
+	return s
+}
+
+// Preload loads the named association together with the results.
+func (s Specification[T]) Preload(association string) Specification[T] {
+	s.preloads = append(s.preloads[:len(s.preloads):len(s.preloads)], association)
+	return s
+}
+
+// WithDeleted includes soft-deleted rows.
+func (s Specification[T]) WithDeleted() Specification[T] {
+	s.unscoped = true
+	return s
+}
+
+// predicates collects the conditions of s and others. A specification without
+// a condition matches every row, which is reported through matchesAll.
+func (s Specification[T]) predicates(others []Specification[T]) (predicates []clause.Expression, matchesAll bool) {
+	for _, spec := range append([]Specification[T]{s}, others...) {
+		if spec.predicate == nil {
+			matchesAll = true
+			continue
+		}
+		predicates = append(predicates, spec.predicate)
+	}
+	return predicates, matchesAll
+}
+
+// merge combines the ordering and preloads of s and others, keeping those of s first.
+func (s Specification[T]) merge(others []Specification[T]) Specification[T] {
+	for _, other := range others {
+		s.orders = append(s.orders[:len(s.orders):len(s.orders)], other.orders...)
+		s.preloads = append(s.preloads[:len(s.preloads):len(s.preloads)], other.preloads...)
+		s.unscoped = s.unscoped || other.unscoped
+	}
+	return s
+}
+
+// where applies the predicate, preloads and soft-delete scope, but not the ordering.
+func (s Specification[T]) where(db *gorm.DB) *gorm.DB {
+	if s.unscoped {
+		db = db.Unscoped()
+	}
+	if s.predicate != nil {
+		db = db.Clauses(clause.Where{Exprs: []clause.Expression{s.predicate}})
+	}
+	for _, association := range s.preloads {
+		db = db.Preload(association)
+	}
+	return db
+}
+
+// ordered applies the ordering, falling back to newest first. The primary key
+// is appended in the direction of the last column so that pages are stable.
+func (s Specification[T]) ordered(db *gorm.DB) *gorm.DB {
+	orders := s.orders
+	if len(orders) == 0 {
+		orders = []clause.OrderByColumn{{Column: clause.Column{Name: "created_at"}, Desc: true}}
+	}
+
+	for _, order := range orders {
+		db = db.Order(order)
+	}
+
+	return db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: orders[len(orders)-1].Desc})
+}
+
+// keysetOrder reports whether results in this order can be paged by cursor,
+// and if so whether they are newest first.
+func (s Specification[T]) keysetOrder() (ok, desc bool) {
+	switch {
+	case len(s.orders) == 0:
+		return true, true
+	case len(s.orders) == 1 && s.orders[0].Column.Name == "created_at":
+		return true, s.orders[0].Desc
+	}
+	return false, false
+}
@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestFindAll_ShouldComposeSpecifications(t *testing.T) {
+	named := func(name string) Specification[widget] { return Where[widget]("name = ?", name) }
+	recent := Where[widget]("created_at > ?", cursorTime)
+
+	tests := []struct {
+		name     string
+		spec     Specification[widget]
+		expected string
+		args     []driver.Value
+	}{
+		{
+			name:     "zero value",
+			spec:     Specification[widget]{},
+			expected: `SELECT * FROM "widgets" WHERE "widgets"."deleted_at" IS NULL ORDER BY "created_at" DESC,"id" DESC`,
+		},
+		{
+			name:     "and",
+			spec:     named("a").And(recent),
+			expected: `SELECT * FROM "widgets" WHERE (name = $1 AND created_at > $2) AND "widgets"."deleted_at" IS NULL ORDER BY "created_at" DESC,"id" DESC`,
+			args:     []driver.Value{"a", cursorTime},
+		},
+		{
+			name:     "or nested in and",
+			spec:     named("a").Or(named("b")).And(recent),
+			expected: `SELECT * FROM "widgets" WHERE ((name = $1 OR name = $2) AND created_at > $3) AND "widgets"."deleted_at" IS NULL ORDER BY "created_at" DESC,"id" DESC`,
+			args:     []driver.Value{"a", "b", cursorTime},
+		},
+		{
+			name:     "not of or",
+			spec:     Not(named("a").Or(named("b"))),
+			expected: `SELECT * FROM "widgets" WHERE NOT (name = $1 OR name = $2) AND "widgets"."deleted_at" IS NULL ORDER BY "created_at" DESC,"id" DESC`,
+			args:     []driver.Value{"a", "b"},
+		},
+		{
+			name:     "and with not nested in or",
+			spec:     named("a").Or(named("b").And(Not(recent))),
+			expected: `SELECT * FROM "widgets" WHERE (name = $1 OR (name = $2 AND NOT created_at > $3)) AND "widgets"."deleted_at" IS NULL ORDER BY "created_at" DESC,"id" DESC`,
+			args:     []driver.Value{"a", "b", cursorTime},
+		},
+		{
+			name:     "or with a specification matching everything",
+			spec:     named("a").Or(Specification[widget]{}),
+			expected: `SELECT * FROM "widgets" WHERE "widgets"."deleted_at" IS NULL ORDER BY "created_at" DESC,"id" DESC`,
+		},
+		{
+			name:     "and with a specification matching everything",
+			spec:     named("a").And(Specification[widget]{}),
+			expected: `SELECT * FROM "widgets" WHERE name = $1 AND "widgets"."deleted_at" IS NULL ORDER BY "created_at" DESC,"id" DESC`,
+			args:     []driver.Value{"a"},
+		},
+		{
+			name:     "not of a specification matching everything",
+			spec:     Not(Specification[widget]{}),
+			expected: `SELECT * FROM "widgets" WHERE 1 = 0 AND "widgets"."deleted_at" IS NULL ORDER BY "created_at" DESC,"id" DESC`,
+		},
+		{
+			name:     "or with the negation of a specification matching everything",
+			spec:     named("a").Or(Not(Specification[widget]{})),
+			expected: `SELECT * FROM "widgets" WHERE (name = $1 OR 1 = 0) AND "widgets"."deleted_at" IS NULL ORDER BY "created_at" DESC,"id" DESC`,
+			args:     []driver.Value{"a"},
+		},
+		{
+			name:     "double negation of a specification matching everything",
+			spec:     Not(Not(Specification[widget]{})),
+			expected: `SELECT * FROM "widgets" WHERE NOT 1 = 0 AND "widgets"."deleted_at" IS NULL ORDER BY "created_at" DESC,"id" DESC`,
+		},
+		{
+			name:     "with deleted",
+			spec:     Not(named("a")).WithDeleted(),
+			expected: `SELECT * FROM "widgets" WHERE NOT name = $1 ORDER BY "created_at" DESC,"id" DESC`,
+			args:     []driver.Value{"a"},
+		},
+		{
+			name:     "with deleted carried through composition",
+			spec:     named("a").Or(named("b").WithDeleted()).And(recent),
+			expected: `SELECT * FROM "widgets" WHERE (name = $1 OR name = $2) AND created_at > $3 ORDER BY "created_at" DESC,"id" DESC`,
+			args:     []driver.Value{"a", "b", cursorTime},
+		},
+		{
+			name:     "ordering",
+			spec:     named("a").OrderBy("name", false).And(recent.OrderBy("created_at", true)),
+			expected: `SELECT * FROM "widgets" WHERE (name = $1 AND created_at > $2) AND "widgets"."deleted_at" IS NULL ORDER BY "name","created_at" DESC,"id" DESC`,
+			args:     []driver.Value{"a", cursorTime},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			db, mock := newMockDB(t)
+			repo := NewGormRepository[widget, int](db)
+			query := mock.ExpectQuery(tt.expected).WillReturnRows(widgetRows(cursorTime, 1))
+			if len(tt.args) > 0 {
+				query.WithArgs(tt.args...)
+			}
+
+			// Act
+			widgets, err := repo.FindAll(context.Background(), tt.spec)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if len(widgets) != 1 {
+				t.Errorf("Expected 1 widget, got %d", len(widgets))
+			}
+		})
+	}
+}
+
+func TestFindAll_ShouldPreloadAssociations(t *testing.T) {
+	// Arrange
+	db, mock := newMockDB(t)
+	repo := NewGormRepository[widget, int](db)
+	mock.ExpectQuery(`SELECT * FROM "widgets" WHERE name = $1 AND "widgets"."deleted_at" IS NULL ORDER BY "created_at" DESC,"id" DESC`).
+		WithArgs("a").
+		WillReturnRows(widgetRows(cursorTime, 1))
+	mock.ExpectQuery(`SELECT * FROM "widget_parts" WHERE "widget_parts"."widget_id" = $1`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "widget_id"}).AddRow(10, 1).AddRow(11, 1))
+
+	// Act
+	widgets, err := repo.FindAll(context.Background(), Where[widget]("name = ?", "a").Preload("Parts"))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(widgets) != 1 || len(widgets[0].Parts) != 2 {
+		t.Errorf("Expected 1 widget with 2 parts, got %+v", widgets)
+	}
+}
+
+func TestFindPaginated_ShouldApplySpecificationToCountAndPage(t *testing.T) {
+	// Arrange
+	db, mock := newMockDB(t)
+	repo := NewGormRepository[widget, int](db)
+	spec := Not(Where[widget]("name = ?", "a").Or(Where[widget]("name = ?", "b"))).OrderBy("name", true)
+	mock.ExpectQuery(`SELECT count(*) FROM "widgets" WHERE NOT (name = $1 OR name = $2) AND "widgets"."deleted_at" IS NULL`).
+		WithArgs("a", "b").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery(`SELECT * FROM "widgets" WHERE NOT (name = $1 OR name = $2) AND "widgets"."deleted_at" IS NULL ORDER BY "name" DESC,"id" DESC LIMIT $3 OFFSET $4`).
+		WithArgs("a", "b", 3, 2).
+		WillReturnRows(widgetRows(cursorTime, 3))
+
+	// Act
+	response, err := repo.FindPaginated(context.Background(), spec, PaginationRequest{Page: 2, PageSize: 2})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if response.Total != 3 || response.TotalPages != 2 || len(response.Data) != 1 {
+		t.Errorf("Expected 1 of 3 widgets on page 2 of 2, got %d of %d on %d pages", len(response.Data), response.Total, response.TotalPages)
+	}
+	if response.NextCursor != "" || response.PrevCursor != "" {
+		t.Errorf("Expected no cursors when not ordered by created_at, got %q and %q", response.NextCursor, response.PrevCursor)
+	}
+}
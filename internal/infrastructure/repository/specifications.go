@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreatedBetween selects the rows created within the range. Either bound may be nil.
+func CreatedBetween[T any](from, to *time.Time) Specification[T] {
+	var spec Specification[T]
+	if from != nil {
+		spec = spec.And(Where[T]("created_at >= ?", *from))
+	}
+	if to != nil {
+		spec = spec.And(Where[T]("created_at <= ?", *to))
+	}
+	return spec
+}
+
+// AmountBetween selects the rows whose embedded Money amount, in minor units,
+// is within the range. Either bound may be nil.
+func AmountBetween[T any](min, max *int64) Specification[T] {
+	var spec Specification[T]
+	if min != nil {
+		spec = spec.And(Where[T]("amount >= ?", *min))
+	}
+	if max != nil {
+		spec = spec.And(Where[T]("amount <= ?", *max))
+	}
+	return spec
+}
+
+// InCurrency selects the rows whose embedded Money is in the currency.
+func InCurrency[T any](currency domain.Currency) Specification[T] {
+	return Where[T]("currency = ?", currency)
+}
+
+func AccountStatusIs(status domain.AccountStatus) Specification[domain.Account] {
+	return Where[domain.Account]("status = ?", status)
+}
+
+// HolderNameContains matches a case-insensitive substring of the holder name.
+func HolderNameContains(name string) Specification[domain.Account] {
+	return Where[domain.Account]("holder_name ILIKE ?", "%"+name+"%")
+}
+
+func AccountOwnedBy(ownerID string) Specification[domain.Account] {
+	return Where[domain.Account]("owner_id = ?", ownerID)
+}
+
+func TransactionStatusIs(status domain.TransactionStatus) Specification[domain.Transaction] {
+	return Where[domain.Transaction]("status = ?", status)
+}
+
+func TransactionTypeIs(txType domain.TransactionType) Specification[domain.Transaction] {
+	return Where[domain.Transaction]("type = ?", txType)
+}
+
+// InvolvingAccount selects the transactions with the account on either side.
+func InvolvingAccount(accountID uuid.UUID) Specification[domain.Transaction] {
+	return Where[domain.Transaction]("from_account_id = ?", accountID).
+		Or(Where[domain.Transaction]("to_account_id = ?", accountID))
+}
+
+// InvolvingAccountOwnedBy selects the transactions with an account of the
+// owner on either side.
+func InvolvingAccountOwnedBy(ownerID string) Specification[domain.Transaction] {
+	const owned = "SELECT id FROM accounts WHERE owner_id = ?"
+	return Where[domain.Transaction]("from_account_id IN ("+owned+")", ownerID).
+		Or(Where[domain.Transaction]("to_account_id IN ("+owned+")", ownerID))
+}
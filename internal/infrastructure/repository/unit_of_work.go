@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// UnitOfWork hands out repositories that share a single database transaction.
+type UnitOfWork interface {
+	Accounts() AccountRepository
+	Transactions() TransactionRepository
+	Ledger() LedgerRepository
+	ExchangeRates() ExchangeRateRepository
+	Outbox() OutboxRepository
+	Webhooks() WebhookSubscriptionRepository
+	WebhookDeliveries() WebhookDeliveryRepository
+}
+
+// TxManager runs a function inside a database transaction. The transaction is
+// committed when fn returns nil and rolled back when it returns an error or panics.
+// Called again with the context it passed to fn, it nests a savepoint in the
+// same transaction instead of opening a new one.
+type TxManager interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context, uow UnitOfWork) error) error
+}
+
+type gormUnitOfWork struct {
+	accounts      AccountRepository
+	transactions  TransactionRepository
+	ledger        LedgerRepository
+	exchangeRates ExchangeRateRepository
+	outbox        OutboxRepository
+	webhooks      WebhookSubscriptionRepository
+	deliveries    WebhookDeliveryRepository
+}
+
+func newGormUnitOfWork(tx *gorm.DB) *gormUnitOfWork {
+	return &gormUnitOfWork{
+		accounts:      NewAccountRepository(tx),
+		transactions:  NewTransactionRepository(tx),
+		ledger:        NewLedgerRepository(tx),
+		exchangeRates: NewExchangeRateRepository(tx),
+		outbox:        NewOutboxRepository(tx),
+		webhooks:      NewWebhookSubscriptionRepository(tx),
+		deliveries:    NewWebhookDeliveryRepository(tx),
+	}
+}
+
+func (u *gormUnitOfWork) Accounts() AccountRepository {
+	return u.accounts
+}
+
+func (u *gormUnitOfWork) Transactions() TransactionRepository {
+	return u.transactions
+}
+
+func (u *gormUnitOfWork) Ledger() LedgerRepository {
+	return u.ledger
+}
+
+func (u *gormUnitOfWork) ExchangeRates() ExchangeRateRepository {
+	return u.exchangeRates
+}
+
+func (u *gormUnitOfWork) Outbox() OutboxRepository {
+	return u.outbox
+}
+
+func (u *gormUnitOfWork) Webhooks() WebhookSubscriptionRepository {
+	return u.webhooks
+}
+
+func (u *gormUnitOfWork) WebhookDeliveries() WebhookDeliveryRepository {
+	return u.deliveries
+}
+
+type gormTxManager struct {
+	db *gorm.DB
+}
+
+func NewTxManager(db *gorm.DB) TxManager {
+	return &gormTxManager{db: db}
+}
+
+func (m *gormTxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context, uow UnitOfWork) error) error {
+	return dbFromContext(ctx, m.db).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx), newGormUnitOfWork(tx))
+	})
+}
+
+type txKey struct{}
+
+// dbFromContext returns the transaction a TxManager opened for ctx, or db when
+// ctx is not within one.
+func dbFromContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx
+	}
+	return db
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type WebhookSubscriptionRepository interface {
+	Repository[domain.WebhookSubscription, uuid.UUID]
+	FindByEventType(ctx context.Context, eventType string) ([]domain.WebhookSubscription, error)
+}
+
+type webhookSubscriptionRepository struct {
+	*GormRepository[domain.WebhookSubscription, uuid.UUID]
+}
+
+func NewWebhookSubscriptionRepository(db *gorm.DB) WebhookSubscriptionRepository {
+	return &webhookSubscriptionRepository{
+		GormRepository: NewGormRepository[domain.WebhookSubscription, uuid.UUID](db),
+	}
+}
+
+func (r *webhookSubscriptionRepository) FindByEventType(ctx context.Context, eventType string) ([]domain.WebhookSubscription, error) {
+	contains, err := json.Marshal([]string{eventType})
+	if err != nil {
+		return nil, err
+	}
+	return r.FindAll(ctx, Where[domain.WebhookSubscription]("event_types @> ?::jsonb", string(contains)))
+}
+
+type WebhookDeliveryRepository interface {
+	Repository[domain.WebhookDelivery, uuid.UUID]
+	// Enqueue inserts the deliveries, skipping those already made for the same
+	// subscription and event.
+	Enqueue(ctx context.Context, deliveries []domain.WebhookDelivery) error
+	// LockDue locks up to limit pending deliveries due at now, skipping those
+	// already locked by another worker. It must be called within a transaction.
+	LockDue(ctx context.Context, now time.Time, limit int) ([]domain.WebhookDelivery, error)
+	FindBySubscriptionIDPaginated(ctx context.Context, subscriptionID uuid.UUID, req PaginationRequest) (*PaginationResponse[domain.WebhookDelivery], error)
+}
+
+type webhookDeliveryRepository struct {
+	*GormRepository[domain.WebhookDelivery, uuid.UUID]
+}
+
+func NewWebhookDeliveryRepository(db *gorm.DB) WebhookDeliveryRepository {
+	return &webhookDeliveryRepository{
+		GormRepository: NewGormRepository[domain.WebhookDelivery, uuid.UUID](db),
+	}
+}
+
+func (r *webhookDeliveryRepository) Enqueue(ctx context.Context, deliveries []domain.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	err := r.conn(ctx).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "subscription_id"}, {Name: "event_id"}}, DoNothing: true}).
+		Create(&deliveries).Error
+	return translateError[domain.WebhookDelivery](err)
+}
+
+func (r *webhookDeliveryRepository) LockDue(ctx context.Context, now time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	var deliveries []domain.WebhookDelivery
+	err := r.conn(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("status = ? AND next_attempt_at <= ?", domain.WebhookDeliveryStatusPending, now).
+		Order("next_attempt_at, id").
+		Limit(limit).
+		Find(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (r *webhookDeliveryRepository) FindBySubscriptionIDPaginated(ctx context.Context, subscriptionID uuid.UUID, req PaginationRequest) (*PaginationResponse[domain.WebhookDelivery], error) {
+	return r.FindPaginated(ctx, Where[domain.WebhookDelivery]("subscription_id = ?", subscriptionID), req)
+}
@@ -1,19 +1,30 @@
 package router
 
 import (
+	apihttp "arise_tech_assessment/internal/api/http"
+	"arise_tech_assessment/internal/config"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync/atomic"
+	"time"
+
 	"github.com/gin-gonic/gin"
 )
 
 type Router struct {
-	engine *gin.Engine
+	engine       *gin.Engine
+	shuttingDown atomic.Bool
 }
 
 func New() *Router {
 	gin.SetMode(gin.ReleaseMode)
 	engine := gin.New()
 	
-	engine.Use(gin.Logger())
-	engine.Use(gin.Recovery())
+	engine.Use(apihttp.RequestID())
+	engine.Use(apihttp.AccessLog())
+	engine.Use(apihttp.Recovery())
 	
 	return &Router{
 		engine: engine,
@@ -37,6 +48,48 @@ func (r *Router) RegisterGroupRoutes(relativePath string, register func(*gin.Rou
 	register(group)
 }
 
-func (r *Router) Start(addr string) error {
-	return r.engine.Run(addr)
-}
\ No newline at end of file
+// Ready reports whether the router accepts new work. It turns false as soon as
+// shutdown begins so that load balancers stop routing traffic here.
+func (r *Router) Ready() bool {
+	return !r.shuttingDown.Load()
+}
+
+// Run serves the routes on the configured address until ctx is cancelled, then
+// stops accepting connections and waits up to cfg.ShutdownTimeout for
+// in-flight requests to finish.
+func (r *Router) Run(ctx context.Context, cfg config.ServerConfig) error {
+	server := &http.Server{
+		Addr:              cfg.Address,
+		Handler:           r.engine,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		ReadTimeout:       cfg.ReadTimeout,
+		WriteTimeout:      cfg.WriteTimeout,
+		IdleTimeout:       cfg.IdleTimeout,
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
+
+	r.shuttingDown.Store(true)
+	slog.Info("Shutting down, draining in-flight requests", "timeout", cfg.ShutdownTimeout)
+
+	// Keep serving while readiness probes notice the shutdown.
+	time.Sleep(cfg.ShutdownDelay)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		_ = server.Close()
+		return fmt.Errorf("drain in-flight requests: %w", err)
+	}
+	return nil
+}
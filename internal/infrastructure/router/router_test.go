@@ -0,0 +1,123 @@
+package router
+
+import (
+	"arise_tech_assessment/internal/config"
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func freeAddress(t *testing.T) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to find a free port: %v", err)
+	}
+	defer listener.Close()
+	return listener.Addr().String()
+}
+
+func waitForServer(t *testing.T, url string) {
+	t.Helper()
+	for range 100 {
+		resp, err := http.Get(url)
+		if err == nil {
+			resp.Body.Close()
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("Server did not start")
+}
+
+func TestRouter_Run_ShouldDrainInFlightRequestsOnShutdown(t *testing.T) {
+	// Arrange
+	r := New()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	r.Engine().GET("/ping", func(c *gin.Context) { c.Status(http.StatusOK) })
+	r.Engine().GET("/slow", func(c *gin.Context) {
+		close(started)
+		<-release
+		c.Status(http.StatusOK)
+	})
+
+	cfg := config.Default().Server
+	cfg.Address = freeAddress(t)
+	cfg.ShutdownTimeout = 5 * time.Second
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	runErr := make(chan error, 1)
+	go func() { runErr <- r.Run(ctx, cfg) }()
+	waitForServer(t, "http://"+cfg.Address+"/ping")
+
+	status := make(chan int, 1)
+	go func() {
+		resp, err := http.Get("http://" + cfg.Address + "/slow")
+		if err != nil {
+			status <- 0
+			return
+		}
+		resp.Body.Close()
+		status <- resp.StatusCode
+	}()
+	<-started
+
+	// Act
+	cancel()
+	for r.Ready() {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+
+	// Assert
+	if code := <-status; code != http.StatusOK {
+		t.Errorf("Expected in-flight request to complete with 200, got %d", code)
+	}
+	if err := <-runErr; err != nil {
+		t.Errorf("Expected clean shutdown, got %v", err)
+	}
+}
+
+func TestRouter_Run_ShouldGiveUpAfterShutdownTimeout(t *testing.T) {
+	// Arrange
+	r := New()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	r.Engine().GET("/ping", func(c *gin.Context) { c.Status(http.StatusOK) })
+	r.Engine().GET("/stuck", func(c *gin.Context) {
+		close(started)
+		<-release
+	})
+
+	cfg := config.Default().Server
+	cfg.Address = freeAddress(t)
+	cfg.ShutdownTimeout = 50 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runErr := make(chan error, 1)
+	go func() { runErr <- r.Run(ctx, cfg) }()
+	waitForServer(t, "http://"+cfg.Address+"/ping")
+
+	go func() {
+		if resp, err := http.Get("http://" + cfg.Address + "/stuck"); err == nil {
+			resp.Body.Close()
+		}
+	}()
+	<-started
+
+	// Act
+	cancel()
+
+	// Assert
+	if err := <-runErr; err == nil {
+		t.Error("Expected an error when requests outlive the shutdown timeout")
+	}
+}
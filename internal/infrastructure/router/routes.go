@@ -2,38 +2,56 @@ package router
 
 import (
 	"arise_tech_assessment/internal/api/http"
+	"arise_tech_assessment/internal/config"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/health"
+	"arise_tech_assessment/internal/infrastructure/metrics"
+	"arise_tech_assessment/internal/infrastructure/repository"
 
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
 )
 
 type RouteRegistrar interface {
 	RegisterRoutes(rg *gin.RouterGroup)
 }
 
-func SetupRoutes(r *Router) {
+// SetupRoutes registers the API. Every /api/v1 route but /health requires
+// credentials checked by authenticator, unless it is nil.
+func SetupRoutes(r *Router, db *gorm.DB, cfg config.Config, readiness *health.Registry, m *metrics.Metrics, tp trace.TracerProvider, authenticator http.Authenticator) {
 	accountHandler := http.NewAccountHandler()
 	transactionHandler := http.NewTransactionHandler()
+	ledgerHandler := http.NewLedgerHandler()
+	exchangeRateHandler := http.NewExchangeRateHandler()
+	webhookHandler := http.NewWebhookHandler()
+	apiKeyHandler := http.NewAPIKeyHandler()
+	healthHandler := http.NewHealthHandler(readiness)
+
+	if tp != nil {
+		r.Engine().Use(http.Tracing(tp))
+	}
+	if m != nil {
+		r.Engine().Use(http.Metrics(m))
+		r.Engine().GET("/metrics", gin.WrapH(m.Handler()))
+	}
+	r.Engine().Use(http.ErrorHandler())
+
+	idempotent := http.Idempotency(repository.NewIdempotencyRepository(db), cfg.Server.IdempotencyKeyTTL)
+
+	r.Engine().GET("/livez", healthHandler.Live)
+	r.Engine().GET("/readyz", healthHandler.Ready)
 
 	v1 := r.Group("/api/v1")
 	{
-		health := v1.Group("/health")
-		{
-			// HealthCheck godoc
-			// @Summary Health check
-			// @Description Get the health status of the service
-			// @Tags health
-			// @Accept json
-			// @Produce json
-			// @Success 200 {object} map[string]string
-			// @Router /health [get]
-			health.GET("", func(ctx *gin.Context) {
-				ctx.JSON(200, gin.H{
-					"status":  "ok",
-					"message": "Server is running",
-				})
-			})
+		v1.GET("/health", healthHandler.Ready)
+
+		// Middleware added with Use only applies to the routes registered
+		// after it, which leaves /health public.
+		if authenticator != nil {
+			v1.Use(http.Authenticate(authenticator))
 		}
 
 		accounts := v1.Group("/accounts")
@@ -42,24 +60,60 @@ func SetupRoutes(r *Router) {
 			accounts.GET("", accountHandler.GetAccounts)
 
 			accounts.GET("/:id/transactions", transactionHandler.GetAccountTransactions)
+			accounts.GET("/:id/ledger", ledgerHandler.GetAccountLedger)
+			accounts.GET("/:id/ledger/balance", ledgerHandler.GetLedgerBalance)
 
 			accounts.GET("/:id", accountHandler.GetAccount)
 			accounts.PUT("/:id", accountHandler.UpdateAccount)
 			accounts.DELETE("/:id", accountHandler.DeleteAccount)
+			accounts.POST("/:id/restore", accountHandler.RestoreAccount)
+
+			accounts.POST("/:id/block", accountHandler.BlockAccount)
+			accounts.POST("/:id/activate", accountHandler.ActivateAccount)
+			accounts.POST("/:id/deactivate", accountHandler.DeactivateAccount)
+			accounts.POST("/:id/close", accountHandler.CloseAccount)
 
 			accounts.GET("/number/:number", accountHandler.GetAccountByNumber)
 		}
 
 		transactions := v1.Group("/transactions")
 		{
-			transactions.POST("", transactionHandler.CreateTransaction)
+			transactions.POST("", idempotent, transactionHandler.CreateTransaction)
 			transactions.GET("", transactionHandler.GetTransactions)
 
 			transactions.GET("/:id", transactionHandler.GetTransaction)
-			transactions.POST("/:id/process", transactionHandler.ProcessTransaction)
-			transactions.POST("/:id/cancel", transactionHandler.CancelTransaction)
+			transactions.POST("/:id/process", idempotent, transactionHandler.ProcessTransaction)
+			transactions.POST("/:id/cancel", idempotent, transactionHandler.CancelTransaction)
+		}
+
+		v1.GET("/exchange-rates/:base/:quote", exchangeRateHandler.GetExchangeRate)
+
+		webhooks := v1.Group("/webhooks")
+		{
+			webhooks.POST("", webhookHandler.CreateWebhook)
+			webhooks.GET("", webhookHandler.GetWebhooks)
+
+			webhooks.GET("/:id", webhookHandler.GetWebhook)
+			webhooks.DELETE("/:id", webhookHandler.DeleteWebhook)
+			webhooks.GET("/:id/deliveries", webhookHandler.GetWebhookDeliveries)
+			webhooks.POST("/:id/deliveries/:delivery_id/replay", webhookHandler.ReplayWebhookDelivery)
+		}
+
+		admin := v1.Group("/admin")
+		if authenticator != nil {
+			admin.Use(http.RequireRole(domain.RoleAdmin))
+		}
+		{
+			admin.POST("/exchange-rates", exchangeRateHandler.PublishExchangeRate)
+			admin.GET("/exchange-rates", exchangeRateHandler.GetExchangeRates)
+
+			admin.POST("/api-keys", apiKeyHandler.IssueAPIKey)
+			admin.GET("/api-keys", apiKeyHandler.GetAPIKeys)
+			admin.POST("/api-keys/:id/revoke", apiKeyHandler.RevokeAPIKey)
 		}
 	}
 
-	r.Engine().GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	if cfg.Features.Swagger {
+		r.Engine().GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	}
 }
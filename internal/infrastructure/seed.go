@@ -2,8 +2,10 @@ package infrastructure
 
 import (
 	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
 	"fmt"
-	"log"
+	"log/slog"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,7 +21,7 @@ func NewSeeder(db *gorm.DB) *Seeder {
 }
 
 func (s *Seeder) SeedData() error {
-	log.Println("Starting database seeding...")
+	slog.Info("Starting database seeding")
 
 	var accountCount int64
 	if err := s.db.Model(&domain.Account{}).Count(&accountCount).Error; err != nil {
@@ -27,30 +29,121 @@ func (s *Seeder) SeedData() error {
 	}
 
 	if accountCount > 0 {
-		log.Printf("Database already contains %d accounts. Skipping seeding.", accountCount)
+		slog.Info("Database already contains accounts, skipping seeding", "accounts", accountCount)
 		return nil
 	}
 
 	accounts := s.createSampleAccounts()
 
+	transactions, err := s.createSampleTransactions(accounts)
+	if err != nil {
+		return fmt.Errorf("failed to build sample transactions: %w", err)
+	}
+
+	openings, entries, err := s.postSampleLedger(accounts, transactions)
+	if err != nil {
+		return fmt.Errorf("failed to build sample ledger: %w", err)
+	}
+	transactions = append(openings, transactions...)
+
+	txManager := repository.NewTxManager(s.db)
+	err = txManager.WithinTransaction(context.Background(), func(ctx context.Context, uow repository.UnitOfWork) error {
+		for i := range accounts {
+			if err := uow.Accounts().Create(ctx, &accounts[i]); err != nil {
+				return fmt.Errorf("failed to create account %s: %w", accounts[i].ID, err)
+			}
+		}
+		for i := range transactions {
+			if err := uow.Transactions().Create(ctx, &transactions[i]); err != nil {
+				return fmt.Errorf("failed to create transaction %s: %w", transactions[i].ID, err)
+			}
+		}
+		if err := uow.Ledger().CreateEntries(ctx, entries); err != nil {
+			return fmt.Errorf("failed to create ledger entries: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
 	for _, account := range accounts {
-		if err := s.db.Create(&account).Error; err != nil {
-			return fmt.Errorf("failed to create account %s: %w", account.Number, err)
+		slog.Info("Created account", "id", account.ID, "number", account.Number, "holder_name", account.HolderName)
+	}
+	for _, transaction := range transactions {
+		slog.Info("Created transaction", "id", transaction.ID, "reference", *transaction.Reference, "type", transaction.Type)
+	}
+
+	slog.Info("Database seeding completed", "ledger_entries", len(entries))
+	return nil
+}
+
+// postSampleLedger records the sample balances in the ledger the way the API
+// does. Each funded account gets a completed opening deposit, and completed
+// transactions are applied to the accounts they move money between, so that
+// every balance equals the sum of its ledger entries.
+func (s *Seeder) postSampleLedger(accounts []domain.Account, transactions []domain.Transaction) ([]domain.Transaction, []domain.LedgerEntry, error) {
+	byID := make(map[uuid.UUID]*domain.Account, len(accounts))
+	for i := range accounts {
+		byID[accounts[i].ID] = &accounts[i]
+	}
+
+	var openings []domain.Transaction
+	var entries []domain.LedgerEntry
+	post := func(transaction *domain.Transaction, from, to *domain.Account) error {
+		posted, err := domain.NewLedgerEntries(transaction, from, to)
+		if err != nil {
+			return err
 		}
-		log.Printf("Created account: %s (%s)", account.Number, account.HolderName)
+		for i := range posted {
+			posted[i].CreatedAt = transaction.CreatedAt
+		}
+		entries = append(entries, posted...)
+		return nil
 	}
 
-	transactions := s.createSampleTransactions(accounts)
+	for i := range accounts {
+		account := &accounts[i]
+		if !account.Balance.IsPositive() {
+			continue
+		}
 
-	for _, transaction := range transactions {
-		if err := s.db.Create(&transaction).Error; err != nil {
-			return fmt.Errorf("failed to create transaction %s: %w", transaction.Reference, err)
+		opening := domain.NewDepositTransaction(account.ID, account.Balance, "Opening balance")
+		opening.SetReference("OPEN-" + opening.ID.String())
+		opening.CreatedAt = account.CreatedAt
+		opening.UpdatedAt = account.CreatedAt
+		opening.Complete()
+		if err := post(opening, nil, account); err != nil {
+			return nil, nil, err
 		}
-		log.Printf("Created transaction: %s (%s)", transaction.Reference, transaction.Type)
+		openings = append(openings, *opening)
 	}
 
-	log.Println("Database seeding completed successfully!")
-	return nil
+	for i := range transactions {
+		transaction := &transactions[i]
+		if transaction.Status != domain.TransactionStatusCompleted {
+			continue
+		}
+
+		var from, to *domain.Account
+		if transaction.FromAccountID != nil {
+			from = byID[*transaction.FromAccountID]
+			if err := from.Debit(transaction.Amount); err != nil {
+				return nil, nil, err
+			}
+		}
+		if transaction.ToAccountID != nil {
+			to = byID[*transaction.ToAccountID]
+			if err := to.Credit(transaction.CreditAmount()); err != nil {
+				return nil, nil, err
+			}
+		}
+		if err := post(transaction, from, to); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return openings, entries, nil
 }
 
 func (s *Seeder) createSampleAccounts() []domain.Account {
@@ -105,7 +198,7 @@ func (s *Seeder) createSampleAccounts() []domain.Account {
 	}
 }
 
-func (s *Seeder) createSampleTransactions(accounts []domain.Account) []domain.Transaction {
+func (s *Seeder) createSampleTransactions(accounts []domain.Account) ([]domain.Transaction, error) {
 	now := time.Now()
 
 	var transactions []domain.Transaction
@@ -115,7 +208,7 @@ func (s *Seeder) createSampleTransactions(accounts []domain.Account) []domain.Tr
 		domain.NewMoney(50000, domain.THB),
 		"Initial deposit",
 	)
-	depositTx.Reference = "TXN001"
+	depositTx.SetReference("TXN001")
 	depositTx.CreatedAt = now.Add(-29 * 24 * time.Hour)
 	depositTx.UpdatedAt = now.Add(-29 * 24 * time.Hour)
 	depositTx.Complete()
@@ -127,20 +220,23 @@ func (s *Seeder) createSampleTransactions(accounts []domain.Account) []domain.Tr
 		domain.NewMoney(25000, domain.THB),
 		"ATM withdrawal",
 	)
-	withdrawTx.Reference = "TXN002"
+	withdrawTx.SetReference("TXN002")
 	withdrawTx.CreatedAt = now.Add(-24 * 24 * time.Hour)
 	withdrawTx.UpdatedAt = now.Add(-24 * 24 * time.Hour)
 	withdrawTx.Complete()
 	transactions = append(transactions, *withdrawTx)
 
 	// Transfer transaction from Jane to John
-	transferTx := domain.NewTransferTransaction(
+	transferTx, err := domain.NewTransferTransaction(
 		accounts[1].ID,
 		accounts[0].ID,
 		domain.NewMoney(10000, domain.THB),
 		"Payment for services",
 	)
-	transferTx.Reference = "TXN003"
+	if err != nil {
+		return nil, err
+	}
+	transferTx.SetReference("TXN003")
 	transferTx.CreatedAt = now.Add(-20 * 24 * time.Hour)
 	transferTx.UpdatedAt = now.Add(-20 * 24 * time.Hour)
 	transferTx.Complete()
@@ -152,7 +248,7 @@ func (s *Seeder) createSampleTransactions(accounts []domain.Account) []domain.Tr
 		domain.NewMoney(15000, domain.USD),
 		"Pending deposit",
 	)
-	pendingTx.Reference = "TXN004"
+	pendingTx.SetReference("TXN004")
 	pendingTx.CreatedAt = now.Add(-2 * 24 * time.Hour)
 	pendingTx.UpdatedAt = now.Add(-2 * 24 * time.Hour)
 	transactions = append(transactions, *pendingTx)
@@ -163,24 +259,27 @@ func (s *Seeder) createSampleTransactions(accounts []domain.Account) []domain.Tr
 		domain.NewMoney(100000, domain.THB),
 		"Failed withdrawal attempt",
 	)
-	failedTx.Reference = "TXN005"
+	failedTx.SetReference("TXN005")
 	failedTx.CreatedAt = now.Add(-5 * 24 * time.Hour)
 	failedTx.UpdatedAt = now.Add(-5 * 24 * time.Hour)
 	failedTx.Fail()
 	transactions = append(transactions, *failedTx)
 
 	// Cancelled transaction
-	cancelledTx := domain.NewTransferTransaction(
+	cancelledTx, err := domain.NewTransferTransaction(
 		accounts[0].ID,
 		accounts[1].ID,
 		domain.NewMoney(5000, domain.THB),
 		"Cancelled transfer",
 	)
-	cancelledTx.Reference = "TXN006"
+	if err != nil {
+		return nil, err
+	}
+	cancelledTx.SetReference("TXN006")
 	cancelledTx.CreatedAt = now.Add(-7 * 24 * time.Hour)
 	cancelledTx.UpdatedAt = now.Add(-7 * 24 * time.Hour)
 	cancelledTx.Cancel()
 	transactions = append(transactions, *cancelledTx)
 
-	return transactions
+	return transactions, nil
 }
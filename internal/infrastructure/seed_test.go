@@ -0,0 +1,49 @@
+package infrastructure
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestSeeder_PostSampleLedger_ShouldBackEveryBalanceWithLedgerEntries(t *testing.T) {
+	// Arrange
+	seeder := NewSeeder(nil)
+	accounts := seeder.createSampleAccounts()
+	transactions, err := seeder.createSampleTransactions(accounts)
+	if err != nil {
+		t.Fatalf("Failed to build sample transactions: %v", err)
+	}
+
+	// Act
+	openings, entries, err := seeder.postSampleLedger(accounts, transactions)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := domain.ValidateLedgerEntries(entries); err != nil {
+		t.Errorf("Expected balanced entries, got %v", err)
+	}
+
+	sums := make(map[uuid.UUID]int64)
+	for _, entry := range entries {
+		sums[entry.AccountID] += entry.SignedAmount()
+	}
+	for _, account := range accounts {
+		if sums[account.ID] != account.Balance.Amount {
+			t.Errorf("Expected ledger sum %d for %s, got %d", account.Balance.Amount, account.Number, sums[account.ID])
+		}
+	}
+
+	funded := 0
+	for _, account := range accounts {
+		if account.Balance.IsPositive() {
+			funded++
+		}
+	}
+	if len(openings) != funded {
+		t.Errorf("Expected %d opening deposits, got %d", funded, len(openings))
+	}
+}
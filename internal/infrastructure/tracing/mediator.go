@@ -0,0 +1,36 @@
+package tracing
+
+import (
+	"arise_tech_assessment/internal/application/behaviors"
+	"context"
+
+	"github.com/mehdihadeli/go-mediatr"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// MediatorBehavior is a mediatr pipeline behavior wrapping every request in a
+// span named after the request type.
+type MediatorBehavior struct {
+	tracer trace.Tracer
+}
+
+func NewMediatorBehavior(provider trace.TracerProvider) *MediatorBehavior {
+	return &MediatorBehavior{tracer: provider.Tracer(InstrumentationName)}
+}
+
+func (b *MediatorBehavior) Handle(ctx context.Context, request interface{}, next mediatr.RequestHandlerFunc) (interface{}, error) {
+	name := behaviors.RequestName(request)
+
+	ctx, span := b.tracer.Start(ctx, "mediatr.Send "+name,
+		trace.WithAttributes(attribute.String("mediatr.request", name)))
+	defer span.End()
+
+	response, err := next(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return response, err
+}
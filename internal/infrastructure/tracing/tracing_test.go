@@ -0,0 +1,106 @@
+package tracing
+
+import (
+	"arise_tech_assessment/internal/application/commands"
+	"arise_tech_assessment/internal/config"
+	"arise_tech_assessment/internal/domain"
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newTestProvider() (*sdktrace.TracerProvider, *tracetest.InMemoryExporter) {
+	exporter := tracetest.NewInMemoryExporter()
+	return sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)), exporter
+}
+
+func newDryRunDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=127.0.0.1 port=1 user=test dbname=test"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+		Logger:               logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	return db
+}
+
+func TestMediatorBehavior_ShouldNestStatementSpansUnderRequestSpan(t *testing.T) {
+	// Arrange
+	provider, exporter := newTestProvider()
+	db := newDryRunDB(t)
+	if err := InstrumentDB(db, provider); err != nil {
+		t.Fatalf("Failed to instrument database: %v", err)
+	}
+	behavior := NewMediatorBehavior(provider)
+
+	// Act
+	_, err := behavior.Handle(context.Background(), &commands.ProcessTransactionCommand{}, func(ctx context.Context) (interface{}, error) {
+		var accounts []domain.Account
+		db.WithContext(ctx).Where("number = ?", "1234567890").Find(&accounts)
+		return nil, nil
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("Expected a statement and a request span, got %d", len(spans))
+	}
+	statement, request := spans[0], spans[1]
+	if request.Name != "mediatr.Send ProcessTransactionCommand" {
+		t.Errorf("Expected request span name, got %s", request.Name)
+	}
+	if statement.Name != "query accounts" {
+		t.Errorf("Expected statement span name, got %s", statement.Name)
+	}
+	if statement.Parent.SpanID() != request.SpanContext.SpanID() {
+		t.Error("Expected the statement span to be a child of the request span")
+	}
+	for _, attr := range statement.Attributes {
+		if attr.Key == "db.query.text" && attr.Value.AsString() != `SELECT * FROM "accounts" WHERE number = $1 AND "accounts"."deleted_at" IS NULL` {
+			t.Errorf("Expected SQL with placeholders, got %s", attr.Value.AsString())
+		}
+	}
+}
+
+func TestMediatorBehavior_ShouldMarkFailedRequests(t *testing.T) {
+	// Arrange
+	provider, exporter := newTestProvider()
+	behavior := NewMediatorBehavior(provider)
+
+	// Act
+	_, err := behavior.Handle(context.Background(), &commands.ProcessTransactionCommand{}, func(ctx context.Context) (interface{}, error) {
+		return nil, domain.ErrInsufficientFunds
+	})
+
+	// Assert
+	if err != domain.ErrInsufficientFunds {
+		t.Errorf("Expected the handler error to pass through, got %v", err)
+	}
+	span := exporter.GetSpans()[0]
+	if span.Status.Code != codes.Error || span.Status.Description != domain.ErrInsufficientFunds.Error() {
+		t.Errorf("Expected error status, got %+v", span.Status)
+	}
+}
+
+func TestNewProvider_ShouldReturnNilWhenDisabled(t *testing.T) {
+	// Act
+	provider, err := NewProvider(context.Background(), config.TracingConfig{Exporter: "none"})
+
+	// Assert
+	if err != nil || provider != nil {
+		t.Errorf("Expected no provider and no error, got %v, %v", provider, err)
+	}
+}
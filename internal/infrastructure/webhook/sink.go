@@ -0,0 +1,41 @@
+package webhook
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+)
+
+// Sink queues a delivery of every published outbox event to each subscription
+// listening for its type. The deliveries are sent by the Worker, so a slow or
+// failing receiver never holds up the outbox or the other subscriptions.
+type Sink struct {
+	txManager repository.TxManager
+}
+
+func NewSink(txManager repository.TxManager) *Sink {
+	return &Sink{txManager: txManager}
+}
+
+// Publish joins the dispatcher's transaction, so the deliveries are stored if
+// and only if the event is marked published. Republishing an event queues no
+// duplicates.
+func (s *Sink) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context, uow repository.UnitOfWork) error {
+		subscriptions, err := uow.Webhooks().FindByEventType(ctx, event.EventType)
+		if err != nil {
+			return err
+		}
+
+		deliveries := make([]domain.WebhookDelivery, 0, len(subscriptions))
+		for _, subscription := range subscriptions {
+			delivery, err := domain.NewWebhookDelivery(subscription.ID, event)
+			if err != nil {
+				return err
+			}
+			deliveries = append(deliveries, *delivery)
+		}
+
+		return uow.WebhookDeliveries().Enqueue(ctx, deliveries)
+	})
+}
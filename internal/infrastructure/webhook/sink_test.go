@@ -0,0 +1,56 @@
+package webhook
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"arise_tech_assessment/mocks"
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSink_Publish_ShouldQueueDeliveryPerSubscription(t *testing.T) {
+	// Arrange
+	first, _ := domain.NewWebhookSubscription("https://one.example.com/hook", []string{"account.blocked"}, testSecret)
+	second, _ := domain.NewWebhookSubscription("https://two.example.com/hook", []string{"account.blocked", "account.closed"}, testSecret)
+	event := domain.OutboxEvent{ID: uuid.New(), EventType: "account.blocked", Payload: []byte(`{}`)}
+
+	webhooks := mocks.NewMockWebhookSubscriptionRepository(t)
+	webhooks.EXPECT().FindByEventType(mock.Anything, "account.blocked").Return([]domain.WebhookSubscription{*first, *second}, nil)
+
+	var queued []domain.WebhookDelivery
+	deliveries := mocks.NewMockWebhookDeliveryRepository(t)
+	deliveries.EXPECT().Enqueue(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, d []domain.WebhookDelivery) error {
+			queued = d
+			return nil
+		})
+
+	uow := mocks.NewMockUnitOfWork(t)
+	uow.EXPECT().Webhooks().Return(webhooks)
+	uow.EXPECT().WebhookDeliveries().Return(deliveries)
+	txManager := mocks.NewMockTxManager(t)
+	txManager.EXPECT().
+		WithinTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(ctx context.Context, uow repository.UnitOfWork) error) error {
+			return fn(ctx, uow)
+		})
+
+	// Act
+	err := NewSink(txManager).Publish(context.Background(), event)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(queued) != 2 || queued[0].SubscriptionID != first.ID || queued[1].SubscriptionID != second.ID {
+		t.Fatalf("Expected one delivery per subscription, got %+v", queued)
+	}
+	for _, delivery := range queued {
+		if delivery.EventID != event.ID || delivery.Status != domain.WebhookDeliveryStatusPending {
+			t.Errorf("Expected a pending delivery of event %s, got %+v", event.ID, delivery)
+		}
+	}
+}
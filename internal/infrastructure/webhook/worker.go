@@ -0,0 +1,178 @@
+package webhook
+
+import (
+	"arise_tech_assessment/internal/config"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/outbox"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxResponseBody bounds how much of a receiver's response is read before the
+// connection is reused.
+const maxResponseBody = 64 << 10
+
+// claim pairs a claimed delivery with the subscription it is sent to.
+type claim struct {
+	delivery     domain.WebhookDelivery
+	subscription *domain.WebhookSubscription
+}
+
+// Worker sends the pending webhook deliveries. Each delivery is POSTed with an
+// HMAC-SHA256 signature and retried with an exponential backoff until the
+// receiver answers 2xx or MaxAttempts is reached.
+type Worker struct {
+	txManager repository.TxManager
+	client    *http.Client
+	cfg       config.WebhookConfig
+	now       func() time.Time
+}
+
+func NewWorker(txManager repository.TxManager, cfg config.WebhookConfig) *Worker {
+	return &Worker{
+		txManager: txManager,
+		client:    &http.Client{Timeout: cfg.Timeout},
+		cfg:       cfg,
+		now:       time.Now,
+	}
+}
+
+// Run sends deliveries every PollInterval until ctx is cancelled. A full batch
+// is followed immediately by the next one to drain a backlog.
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		sent, err := w.DeliverBatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "Failed to deliver webhooks", "error", err)
+		}
+
+		if err == nil && sent == w.cfg.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeliverBatch claims up to BatchSize due deliveries, sends them and records
+// the outcome of each, returning how many were attempted.
+func (w *Worker) DeliverBatch(ctx context.Context) (int, error) {
+	claims, err := w.claim(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range claims {
+		delivery := &claims[i].delivery
+		w.deliver(ctx, claims[i].subscription, delivery)
+
+		err := w.txManager.WithinTransaction(ctx, func(ctx context.Context, uow repository.UnitOfWork) error {
+			return uow.WebhookDeliveries().Update(ctx, delivery)
+		})
+		if err != nil {
+			return i, fmt.Errorf("record webhook delivery %s: %w", delivery.ID, err)
+		}
+	}
+
+	return len(claims), nil
+}
+
+// claim locks the due deliveries just long enough to push their next attempt
+// past the time it takes to send them, so that no other worker picks them up
+// meanwhile and no transaction stays open during the requests. Deliveries
+// claimed by a worker that then crashed are retried once the claim lapses.
+func (w *Worker) claim(ctx context.Context) ([]claim, error) {
+	var claims []claim
+
+	err := w.txManager.WithinTransaction(ctx, func(ctx context.Context, uow repository.UnitOfWork) error {
+		now := w.now()
+		deliveries, err := uow.WebhookDeliveries().LockDue(ctx, now, w.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		subscriptions := map[uuid.UUID]*domain.WebhookSubscription{}
+		claims = make([]claim, 0, len(deliveries))
+		for _, delivery := range deliveries {
+			subscription, ok := subscriptions[delivery.SubscriptionID]
+			if !ok {
+				subscription, err = uow.Webhooks().GetByID(ctx, delivery.SubscriptionID)
+				if err != nil {
+					return err
+				}
+				subscriptions[delivery.SubscriptionID] = subscription
+			}
+
+			delivery.NextAttemptAt = now.Add(2 * w.cfg.Timeout)
+			if err := uow.WebhookDeliveries().Update(ctx, &delivery); err != nil {
+				return err
+			}
+			claims = append(claims, claim{delivery: delivery, subscription: subscription})
+		}
+		return nil
+	})
+
+	return claims, err
+}
+
+func (w *Worker) deliver(ctx context.Context, subscription *domain.WebhookSubscription, delivery *domain.WebhookDelivery) {
+	status, err := w.send(ctx, subscription, delivery)
+	if err == nil {
+		delivery.MarkSucceeded(status, w.now())
+		return
+	}
+
+	nextAttemptAt := w.now().Add(outbox.Backoff(w.cfg.MinBackoff, w.cfg.MaxBackoff, delivery.Attempts+1))
+	delivery.MarkFailed(status, err, nextAttemptAt, w.cfg.MaxAttempts)
+	if delivery.Status == domain.WebhookDeliveryStatusFailed {
+		slog.ErrorContext(ctx, "Giving up on webhook delivery",
+			"delivery_id", delivery.ID, "subscription_id", subscription.ID, "attempts", delivery.Attempts, "error", err)
+		return
+	}
+	slog.WarnContext(ctx, "Failed to deliver webhook",
+		"delivery_id", delivery.ID, "subscription_id", subscription.ID, "attempts", delivery.Attempts, "error", err)
+}
+
+// send POSTs the delivery and returns the response status, which is zero when
+// no response was received.
+func (w *Worker) send(ctx context.Context, subscription *domain.WebhookSubscription, delivery *domain.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := w.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderDeliveryID, delivery.ID.String())
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(subscription.Secret, timestamp, delivery.Payload))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBody))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("receiver answered %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,228 @@
+package webhook
+
+import (
+	"arise_tech_assessment/internal/config"
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"arise_tech_assessment/mocks"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+const testSecret = "0123456789abcdef"
+
+var testConfig = config.WebhookConfig{
+	PollInterval: time.Second,
+	BatchSize:    10,
+	Timeout:      time.Second,
+	MaxAttempts:  3,
+	MinBackoff:   10 * time.Second,
+	MaxBackoff:   time.Minute,
+}
+
+// received is a request captured by the test receiver.
+type received struct {
+	header http.Header
+	body   []byte
+}
+
+// newReceiver starts an HTTP server answering status and recording the requests it gets.
+func newReceiver(t *testing.T, status int) (*httptest.Server, *[]received) {
+	t.Helper()
+	var requests []received
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, received{header: r.Header.Clone(), body: body})
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func newTestDelivery(t *testing.T, subscription *domain.WebhookSubscription) *domain.WebhookDelivery {
+	t.Helper()
+	transaction := domain.NewDepositTransaction(uuid.New(), domain.NewMoney(5000, domain.USD), "Deposit")
+	transaction.Complete()
+	event, err := domain.NewOutboxEvent(transaction.PendingEvents()[1])
+	if err != nil {
+		t.Fatalf("Failed to create outbox event: %v", err)
+	}
+	delivery, err := domain.NewWebhookDelivery(subscription.ID, *event)
+	if err != nil {
+		t.Fatalf("Failed to create delivery: %v", err)
+	}
+	return delivery
+}
+
+// newTestWorker returns a worker at a fixed time whose store holds the
+// delivery, and the list the worker saves the delivery's states to.
+func newTestWorker(t *testing.T, subscription *domain.WebhookSubscription, delivery *domain.WebhookDelivery, now time.Time) (*Worker, *[]domain.WebhookDelivery) {
+	t.Helper()
+	var saved []domain.WebhookDelivery
+
+	deliveries := mocks.NewMockWebhookDeliveryRepository(t)
+	deliveries.EXPECT().LockDue(mock.Anything, now, testConfig.BatchSize).Return([]domain.WebhookDelivery{*delivery}, nil)
+	deliveries.EXPECT().Update(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, d *domain.WebhookDelivery) error {
+			saved = append(saved, *d)
+			return nil
+		})
+
+	webhooks := mocks.NewMockWebhookSubscriptionRepository(t)
+	webhooks.EXPECT().GetByID(mock.Anything, subscription.ID).Return(subscription, nil)
+
+	uow := mocks.NewMockUnitOfWork(t)
+	uow.EXPECT().WebhookDeliveries().Return(deliveries)
+	uow.EXPECT().Webhooks().Return(webhooks)
+
+	txManager := mocks.NewMockTxManager(t)
+	txManager.EXPECT().
+		WithinTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(ctx context.Context, uow repository.UnitOfWork) error) error {
+			return fn(ctx, uow)
+		})
+
+	w := NewWorker(txManager, testConfig)
+	w.now = func() time.Time { return now }
+	return w, &saved
+}
+
+func TestWorker_DeliverBatch_ShouldSendSignedPayload(t *testing.T) {
+	// Arrange
+	server, requests := newReceiver(t, http.StatusNoContent)
+	subscription, _ := domain.NewWebhookSubscription(server.URL, []string{"transaction.completed"}, testSecret)
+	delivery := newTestDelivery(t, subscription)
+	now := time.Now()
+	worker, saved := newTestWorker(t, subscription, delivery, now)
+
+	// Act
+	sent, err := worker.DeliverBatch(context.Background())
+
+	// Assert
+	if err != nil || sent != 1 {
+		t.Fatalf("Expected 1 delivery sent, got %d (%v)", sent, err)
+	}
+	if len(*requests) != 1 {
+		t.Fatalf("Expected 1 request received, got %d", len(*requests))
+	}
+	request := (*requests)[0]
+	if request.header.Get(HeaderEvent) != "transaction.completed" || request.header.Get(HeaderDeliveryID) != delivery.ID.String() {
+		t.Errorf("Expected event and delivery headers, got %v", request.header)
+	}
+	timestamp, _ := strconv.ParseInt(request.header.Get(HeaderTimestamp), 10, 64)
+	if timestamp != now.Unix() || !Verify(testSecret, timestamp, request.body, request.header.Get(HeaderSignature)) {
+		t.Errorf("Expected a valid signature for timestamp %d, got %q", now.Unix(), request.header.Get(HeaderSignature))
+	}
+
+	var payload struct {
+		ID   uuid.UUID `json:"id"`
+		Type string    `json:"type"`
+		Data struct {
+			CreditAmount domain.Money `json:"credit_amount"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(request.body, &payload); err != nil {
+		t.Fatalf("Failed to decode payload: %v", err)
+	}
+	if payload.ID != delivery.EventID || payload.Data.CreditAmount != domain.NewMoney(5000, domain.USD) {
+		t.Errorf("Expected the completed deposit event, got %+v", payload)
+	}
+
+	last := (*saved)[len(*saved)-1]
+	if last.Status != domain.WebhookDeliveryStatusSucceeded || last.ResponseStatus != http.StatusNoContent || last.Attempts != 1 {
+		t.Errorf("Expected a successful first attempt, got %+v", last)
+	}
+}
+
+func TestWorker_DeliverBatch_ShouldClaimBeforeSending(t *testing.T) {
+	// Arrange
+	server, _ := newReceiver(t, http.StatusOK)
+	subscription, _ := domain.NewWebhookSubscription(server.URL, []string{"transaction.completed"}, testSecret)
+	now := time.Now()
+	worker, saved := newTestWorker(t, subscription, newTestDelivery(t, subscription), now)
+
+	// Act
+	_, _ = worker.DeliverBatch(context.Background())
+
+	// Assert
+	if len(*saved) != 2 {
+		t.Fatalf("Expected the claim and the outcome to be saved, got %d saves", len(*saved))
+	}
+	if claimed := (*saved)[0]; !claimed.NextAttemptAt.Equal(now.Add(2*testConfig.Timeout)) || claimed.Attempts != 0 {
+		t.Errorf("Expected the delivery to be claimed until %s, got %+v", now.Add(2*testConfig.Timeout), claimed)
+	}
+}
+
+func TestWorker_DeliverBatch_ShouldRetryWithBackoffOnServerError(t *testing.T) {
+	// Arrange
+	server, _ := newReceiver(t, http.StatusServiceUnavailable)
+	subscription, _ := domain.NewWebhookSubscription(server.URL, []string{"transaction.completed"}, testSecret)
+	delivery := newTestDelivery(t, subscription)
+	delivery.Attempts = 1
+	now := time.Now()
+	worker, saved := newTestWorker(t, subscription, delivery, now)
+
+	// Act
+	_, err := worker.DeliverBatch(context.Background())
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	last := (*saved)[len(*saved)-1]
+	if last.Status != domain.WebhookDeliveryStatusPending || last.Attempts != 2 || last.ResponseStatus != http.StatusServiceUnavailable {
+		t.Errorf("Expected a second failed attempt still pending, got %+v", last)
+	}
+	if expected := now.Add(20 * time.Second); !last.NextAttemptAt.Equal(expected) {
+		t.Errorf("Expected next attempt at %s, got %s", expected, last.NextAttemptAt)
+	}
+	if last.LastError != "receiver answered 503 Service Unavailable" {
+		t.Errorf("Expected the response status as error, got %q", last.LastError)
+	}
+}
+
+func TestWorker_DeliverBatch_ShouldGiveUpAfterMaxAttempts(t *testing.T) {
+	// Arrange
+	server, _ := newReceiver(t, http.StatusInternalServerError)
+	subscription, _ := domain.NewWebhookSubscription(server.URL, []string{"transaction.completed"}, testSecret)
+	delivery := newTestDelivery(t, subscription)
+	delivery.Attempts = testConfig.MaxAttempts - 1
+	worker, saved := newTestWorker(t, subscription, delivery, time.Now())
+
+	// Act
+	_, _ = worker.DeliverBatch(context.Background())
+
+	// Assert
+	if last := (*saved)[len(*saved)-1]; last.Status != domain.WebhookDeliveryStatusFailed || last.Attempts != testConfig.MaxAttempts {
+		t.Errorf("Expected the delivery to be failed after %d attempts, got %+v", testConfig.MaxAttempts, last)
+	}
+}
+
+func TestSign_ShouldDependOnSecretTimestampAndBody(t *testing.T) {
+	// Arrange
+	body := []byte(`{"type":"account.blocked"}`)
+	signature := Sign(testSecret, 1700000000, body)
+
+	// Assert
+	if !Verify(testSecret, 1700000000, body, signature) {
+		t.Errorf("Expected signature %s to verify", signature)
+	}
+	if Verify("another-secret-value", 1700000000, body, signature) {
+		t.Error("Expected a different secret to fail verification")
+	}
+	if Verify(testSecret, 1700000001, body, signature) {
+		t.Error("Expected a different timestamp to fail verification")
+	}
+	if Verify(testSecret, 1700000000, []byte(`{"type":"account.closed"}`), signature) {
+		t.Error("Expected a different body to fail verification")
+	}
+}
@@ -14,41 +14,192 @@
 // @BasePath /api/v1
 // @schemes http https
 
+// @securityDefinitions.apikey ApiKeyAuth
+// @in header
+// @name X-API-Key
+
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description A JWT as "Bearer <token>"
+
 package main
 
 import (
-	"fmt"
-	"log"
+	"context"
+	"errors"
+	"flag"
+	"log/slog"
 	"os"
+	"os/signal"
+	"slices"
+	"sync"
+	"syscall"
 
+	apihttp "arise_tech_assessment/internal/api/http"
 	"arise_tech_assessment/internal/application"
+	"arise_tech_assessment/internal/config"
 	"arise_tech_assessment/internal/infrastructure"
+	"arise_tech_assessment/internal/infrastructure/auth"
+	"arise_tech_assessment/internal/infrastructure/health"
+	"arise_tech_assessment/internal/infrastructure/logging"
+	"arise_tech_assessment/internal/infrastructure/metrics"
+	"arise_tech_assessment/internal/infrastructure/outbox"
+	"arise_tech_assessment/internal/infrastructure/repository"
 	"arise_tech_assessment/internal/infrastructure/router"
+	"arise_tech_assessment/internal/infrastructure/tracing"
+	"arise_tech_assessment/internal/infrastructure/webhook"
 
 	_ "arise_tech_assessment/docs"
+
+	"github.com/mehdihadeli/go-mediatr"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func main() {
-	dsn := os.Getenv("CONNECTION_STRINGS_DEFAULT")
-	initializer := infrastructure.CreateDbInitializer(dsn)
+	configPath := flag.String("config", "", "path to a YAML configuration file")
+	flag.Parse()
 
-	if err := initializer.Init(); err != nil {
-		panic(fmt.Errorf("failed to initialize database: %w", err))
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		logging.Fatal("Invalid configuration", "error", err)
+	}
+	slog.SetDefault(logging.New(os.Stdout, cfg.Log))
+
+	initializer := infrastructure.CreateDbInitializer(cfg.Database)
+
+	pending, err := initializer.PendingMigrations(context.Background())
+	if err != nil {
+		logging.Fatal("Failed to check database migrations", "error", err)
+	}
+	if len(pending) > 0 {
+		slog.Warn("Database migrations are pending, run `go run ./cmd/migrate up`", "pending", len(pending))
 	}
 
-	if err := initializer.Seed(); err != nil {
-		log.Printf("Warning: Failed to seed database: %v", err)
+	if cfg.Features.SeedOnStartup {
+		if err := initializer.Seed(); err != nil {
+			slog.Warn("Failed to seed database", "error", err)
+		}
 	}
 
 	application.RegisterHandlers(initializer.DB)
 
+	provider, err := tracing.NewProvider(context.Background(), cfg.Tracing)
+	if err != nil {
+		logging.Fatal("Failed to set up tracing", "error", err)
+	}
+	var tp trace.TracerProvider
+	if provider != nil {
+		tp = provider
+		if err := tracing.InstrumentDB(initializer.DB, tp); err != nil {
+			logging.Fatal("Failed to instrument database for tracing", "error", err)
+		}
+		if err := mediatr.RegisterRequestPipelineBehaviors(tracing.NewMediatorBehavior(tp)); err != nil {
+			logging.Fatal("Failed to register mediator tracing", "error", err)
+		}
+	}
+
+	var m *metrics.Metrics
+	if cfg.Features.Metrics {
+		m = metrics.New()
+		if err := m.InstrumentDB(initializer.DB, initializer.DB.Migrator().CurrentDatabase()); err != nil {
+			logging.Fatal("Failed to instrument database", "error", err)
+		}
+		if err := mediatr.RegisterRequestPipelineBehaviors(metrics.NewMediatorBehavior(m)); err != nil {
+			logging.Fatal("Failed to register mediator metrics", "error", err)
+		}
+	}
+
+	if err := application.RegisterBehaviors(initializer.DB, cfg.Pipeline.Behaviors); err != nil {
+		logging.Fatal("Failed to register mediator pipeline behaviors", "error", err)
+	}
+
 	r := router.New()
 
-	router.SetupRoutes(r)
+	sqlDB, err := initializer.DB.DB()
+	if err != nil {
+		logging.Fatal("Failed to get database connection pool", "error", err)
+	}
+
+	readiness := health.NewRegistry(cfg.Health.CheckTimeout)
+	readiness.Register(
+		health.NewCheck("shutdown", func(ctx context.Context) error {
+			if !r.Ready() {
+				return errors.New("server is shutting down")
+			}
+			return nil
+		}),
+		health.Database(sqlDB),
+		health.Migrations(initializer.PendingMigrations),
+		health.ConnectionPool(sqlDB, cfg.Health.PoolSaturation),
+	)
+
+	var authenticator apihttp.Authenticator
+	if cfg.Auth.Enabled {
+		if !slices.Contains(cfg.Pipeline.Behaviors, application.BehaviorAuthorization) {
+			logging.Fatal("The authorization pipeline behavior is required when authentication is enabled")
+		}
+		a, err := auth.NewAuthenticator(repository.NewAPIKeyRepository(initializer.DB), cfg.Auth)
+		if err != nil {
+			logging.Fatal("Failed to set up authentication", "error", err)
+		}
+		authenticator = a
+	} else {
+		slog.Warn("Authentication is disabled, the API is open to anyone")
+	}
 
-	log.Println("Starting server on :8080")
+	router.SetupRoutes(r, initializer.DB, cfg, readiness, m, tp, authenticator)
 
-	if err := r.Start(":8080"); err != nil {
-		log.Fatal("Failed to start server:", err)
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	txManager := repository.NewTxManager(initializer.DB)
+	var workers sync.WaitGroup
+	if cfg.Outbox.Enabled {
+		if m != nil {
+			if err := outbox.Subscribe(m.ObserveDomainEvent); err != nil {
+				logging.Fatal("Failed to subscribe to domain events", "error", err)
+			}
+		}
+		sinks := outbox.Sinks{webhook.NewSink(txManager)}
+		if cfg.Outbox.Sink == "log" {
+			sinks = append(sinks, outbox.NewLogSink(slog.Default()))
+		}
+		d := outbox.NewDispatcher(txManager, sinks, cfg.Outbox)
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			d.Run(ctx)
+		}()
+	}
+	if cfg.Webhooks.Enabled {
+		w := webhook.NewWorker(txManager, cfg.Webhooks)
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			w.Run(ctx)
+		}()
+	}
+
+	slog.Info("Starting server", "address", cfg.Server.Address)
+
+	runErr := r.Run(ctx, cfg.Server)
+
+	stop()
+	workers.Wait()
+
+	if provider != nil {
+		flushCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+		if err := provider.Shutdown(flushCtx); err != nil {
+			slog.Warn("Failed to flush traces", "error", err)
+		}
+		cancel()
+	}
+	if err := initializer.Close(); err != nil {
+		slog.Warn("Failed to close database connections", "error", err)
+	}
+	if runErr != nil {
+		logging.Fatal("Server stopped with error", "error", runErr)
 	}
+	slog.Info("Server stopped")
 }
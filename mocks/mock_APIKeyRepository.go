@@ -0,0 +1,688 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockAPIKeyRepository creates a new instance of MockAPIKeyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAPIKeyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAPIKeyRepository {
+	mock := &MockAPIKeyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAPIKeyRepository is an autogenerated mock type for the APIKeyRepository type
+type MockAPIKeyRepository struct {
+	mock.Mock
+}
+
+type MockAPIKeyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAPIKeyRepository) EXPECT() *MockAPIKeyRepository_Expecter {
+	return &MockAPIKeyRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockAPIKeyRepository
+func (_mock *MockAPIKeyRepository) Create(ctx context.Context, entity *domain.APIKey) error {
+	ret := _mock.Called(ctx, entity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.APIKey) error); ok {
+		r0 = returnFunc(ctx, entity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAPIKeyRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockAPIKeyRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entity *domain.APIKey
+func (_e *MockAPIKeyRepository_Expecter) Create(ctx interface{}, entity interface{}) *MockAPIKeyRepository_Create_Call {
+	return &MockAPIKeyRepository_Create_Call{Call: _e.mock.On("Create", ctx, entity)}
+}
+
+func (_c *MockAPIKeyRepository_Create_Call) Run(run func(ctx context.Context, entity *domain.APIKey)) *MockAPIKeyRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.APIKey
+		if args[1] != nil {
+			arg1 = args[1].(*domain.APIKey)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_Create_Call) Return(err error) *MockAPIKeyRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_Create_Call) RunAndReturn(run func(ctx context.Context, entity *domain.APIKey) error) *MockAPIKeyRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockAPIKeyRepository
+func (_mock *MockAPIKeyRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAPIKeyRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockAPIKeyRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockAPIKeyRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockAPIKeyRepository_Delete_Call {
+	return &MockAPIKeyRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockAPIKeyRepository_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockAPIKeyRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_Delete_Call) Return(err error) *MockAPIKeyRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockAPIKeyRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function for the type MockAPIKeyRepository
+func (_mock *MockAPIKeyRepository) FindAll(ctx context.Context, spec repository.Specification[domain.APIKey]) ([]domain.APIKey, error) {
+	ret := _mock.Called(ctx, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []domain.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.APIKey]) ([]domain.APIKey, error)); ok {
+		return returnFunc(ctx, spec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.APIKey]) []domain.APIKey); ok {
+		r0 = returnFunc(ctx, spec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.APIKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.Specification[domain.APIKey]) error); ok {
+		r1 = returnFunc(ctx, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKeyRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockAPIKeyRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec repository.Specification[domain.APIKey]
+func (_e *MockAPIKeyRepository_Expecter) FindAll(ctx interface{}, spec interface{}) *MockAPIKeyRepository_FindAll_Call {
+	return &MockAPIKeyRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx, spec)}
+}
+
+func (_c *MockAPIKeyRepository_FindAll_Call) Run(run func(ctx context.Context, spec repository.Specification[domain.APIKey])) *MockAPIKeyRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.Specification[domain.APIKey]
+		if args[1] != nil {
+			arg1 = args[1].(repository.Specification[domain.APIKey])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_FindAll_Call) Return(aPIKeys []domain.APIKey, err error) *MockAPIKeyRepository_FindAll_Call {
+	_c.Call.Return(aPIKeys, err)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_FindAll_Call) RunAndReturn(run func(ctx context.Context, spec repository.Specification[domain.APIKey]) ([]domain.APIKey, error)) *MockAPIKeyRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByHash provides a mock function for the type MockAPIKeyRepository
+func (_mock *MockAPIKeyRepository) FindByHash(ctx context.Context, hash string) (*domain.APIKey, error) {
+	ret := _mock.Called(ctx, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByHash")
+	}
+
+	var r0 *domain.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.APIKey, error)); ok {
+		return returnFunc(ctx, hash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.APIKey); ok {
+		r0 = returnFunc(ctx, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.APIKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKeyRepository_FindByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByHash'
+type MockAPIKeyRepository_FindByHash_Call struct {
+	*mock.Call
+}
+
+// FindByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hash string
+func (_e *MockAPIKeyRepository_Expecter) FindByHash(ctx interface{}, hash interface{}) *MockAPIKeyRepository_FindByHash_Call {
+	return &MockAPIKeyRepository_FindByHash_Call{Call: _e.mock.On("FindByHash", ctx, hash)}
+}
+
+func (_c *MockAPIKeyRepository_FindByHash_Call) Run(run func(ctx context.Context, hash string)) *MockAPIKeyRepository_FindByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_FindByHash_Call) Return(aPIKey *domain.APIKey, err error) *MockAPIKeyRepository_FindByHash_Call {
+	_c.Call.Return(aPIKey, err)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_FindByHash_Call) RunAndReturn(run func(ctx context.Context, hash string) (*domain.APIKey, error)) *MockAPIKeyRepository_FindByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPaginated provides a mock function for the type MockAPIKeyRepository
+func (_mock *MockAPIKeyRepository) FindPaginated(ctx context.Context, spec repository.Specification[domain.APIKey], req repository.PaginationRequest) (*repository.PaginationResponse[domain.APIKey], error) {
+	ret := _mock.Called(ctx, spec, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPaginated")
+	}
+
+	var r0 *repository.PaginationResponse[domain.APIKey]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.APIKey], repository.PaginationRequest) (*repository.PaginationResponse[domain.APIKey], error)); ok {
+		return returnFunc(ctx, spec, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.APIKey], repository.PaginationRequest) *repository.PaginationResponse[domain.APIKey]); ok {
+		r0 = returnFunc(ctx, spec, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.PaginationResponse[domain.APIKey])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.Specification[domain.APIKey], repository.PaginationRequest) error); ok {
+		r1 = returnFunc(ctx, spec, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKeyRepository_FindPaginated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPaginated'
+type MockAPIKeyRepository_FindPaginated_Call struct {
+	*mock.Call
+}
+
+// FindPaginated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec repository.Specification[domain.APIKey]
+//   - req repository.PaginationRequest
+func (_e *MockAPIKeyRepository_Expecter) FindPaginated(ctx interface{}, spec interface{}, req interface{}) *MockAPIKeyRepository_FindPaginated_Call {
+	return &MockAPIKeyRepository_FindPaginated_Call{Call: _e.mock.On("FindPaginated", ctx, spec, req)}
+}
+
+func (_c *MockAPIKeyRepository_FindPaginated_Call) Run(run func(ctx context.Context, spec repository.Specification[domain.APIKey], req repository.PaginationRequest)) *MockAPIKeyRepository_FindPaginated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.Specification[domain.APIKey]
+		if args[1] != nil {
+			arg1 = args[1].(repository.Specification[domain.APIKey])
+		}
+		var arg2 repository.PaginationRequest
+		if args[2] != nil {
+			arg2 = args[2].(repository.PaginationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_FindPaginated_Call) Return(paginationResponse *repository.PaginationResponse[domain.APIKey], err error) *MockAPIKeyRepository_FindPaginated_Call {
+	_c.Call.Return(paginationResponse, err)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_FindPaginated_Call) RunAndReturn(run func(ctx context.Context, spec repository.Specification[domain.APIKey], req repository.PaginationRequest) (*repository.PaginationResponse[domain.APIKey], error)) *MockAPIKeyRepository_FindPaginated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function for the type MockAPIKeyRepository
+func (_mock *MockAPIKeyRepository) GetAll(ctx context.Context) ([]domain.APIKey, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []domain.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.APIKey, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.APIKey); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.APIKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKeyRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type MockAPIKeyRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPIKeyRepository_Expecter) GetAll(ctx interface{}) *MockAPIKeyRepository_GetAll_Call {
+	return &MockAPIKeyRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *MockAPIKeyRepository_GetAll_Call) Run(run func(ctx context.Context)) *MockAPIKeyRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_GetAll_Call) Return(aPIKeys []domain.APIKey, err error) *MockAPIKeyRepository_GetAll_Call {
+	_c.Call.Return(aPIKeys, err)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_GetAll_Call) RunAndReturn(run func(ctx context.Context) ([]domain.APIKey, error)) *MockAPIKeyRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockAPIKeyRepository
+func (_mock *MockAPIKeyRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.APIKey, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.APIKey, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.APIKey); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.APIKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKeyRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockAPIKeyRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockAPIKeyRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockAPIKeyRepository_GetByID_Call {
+	return &MockAPIKeyRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockAPIKeyRepository_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockAPIKeyRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_GetByID_Call) Return(aPIKey *domain.APIKey, err error) *MockAPIKeyRepository_GetByID_Call {
+	_c.Call.Return(aPIKey, err)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.APIKey, error)) *MockAPIKeyRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDForUpdate provides a mock function for the type MockAPIKeyRepository
+func (_mock *MockAPIKeyRepository) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*domain.APIKey, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDForUpdate")
+	}
+
+	var r0 *domain.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.APIKey, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.APIKey); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.APIKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKeyRepository_GetByIDForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDForUpdate'
+type MockAPIKeyRepository_GetByIDForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetByIDForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockAPIKeyRepository_Expecter) GetByIDForUpdate(ctx interface{}, id interface{}) *MockAPIKeyRepository_GetByIDForUpdate_Call {
+	return &MockAPIKeyRepository_GetByIDForUpdate_Call{Call: _e.mock.On("GetByIDForUpdate", ctx, id)}
+}
+
+func (_c *MockAPIKeyRepository_GetByIDForUpdate_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockAPIKeyRepository_GetByIDForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_GetByIDForUpdate_Call) Return(aPIKey *domain.APIKey, err error) *MockAPIKeyRepository_GetByIDForUpdate_Call {
+	_c.Call.Return(aPIKey, err)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_GetByIDForUpdate_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.APIKey, error)) *MockAPIKeyRepository_GetByIDForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPaginated provides a mock function for the type MockAPIKeyRepository
+func (_mock *MockAPIKeyRepository) GetPaginated(ctx context.Context, req repository.PaginationRequest) (*repository.PaginationResponse[domain.APIKey], error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaginated")
+	}
+
+	var r0 *repository.PaginationResponse[domain.APIKey]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.PaginationRequest) (*repository.PaginationResponse[domain.APIKey], error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.PaginationRequest) *repository.PaginationResponse[domain.APIKey]); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.PaginationResponse[domain.APIKey])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.PaginationRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKeyRepository_GetPaginated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaginated'
+type MockAPIKeyRepository_GetPaginated_Call struct {
+	*mock.Call
+}
+
+// GetPaginated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req repository.PaginationRequest
+func (_e *MockAPIKeyRepository_Expecter) GetPaginated(ctx interface{}, req interface{}) *MockAPIKeyRepository_GetPaginated_Call {
+	return &MockAPIKeyRepository_GetPaginated_Call{Call: _e.mock.On("GetPaginated", ctx, req)}
+}
+
+func (_c *MockAPIKeyRepository_GetPaginated_Call) Run(run func(ctx context.Context, req repository.PaginationRequest)) *MockAPIKeyRepository_GetPaginated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.PaginationRequest
+		if args[1] != nil {
+			arg1 = args[1].(repository.PaginationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_GetPaginated_Call) Return(paginationResponse *repository.PaginationResponse[domain.APIKey], err error) *MockAPIKeyRepository_GetPaginated_Call {
+	_c.Call.Return(paginationResponse, err)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_GetPaginated_Call) RunAndReturn(run func(ctx context.Context, req repository.PaginationRequest) (*repository.PaginationResponse[domain.APIKey], error)) *MockAPIKeyRepository_GetPaginated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockAPIKeyRepository
+func (_mock *MockAPIKeyRepository) Update(ctx context.Context, entity *domain.APIKey) error {
+	ret := _mock.Called(ctx, entity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.APIKey) error); ok {
+		r0 = returnFunc(ctx, entity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAPIKeyRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockAPIKeyRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entity *domain.APIKey
+func (_e *MockAPIKeyRepository_Expecter) Update(ctx interface{}, entity interface{}) *MockAPIKeyRepository_Update_Call {
+	return &MockAPIKeyRepository_Update_Call{Call: _e.mock.On("Update", ctx, entity)}
+}
+
+func (_c *MockAPIKeyRepository_Update_Call) Run(run func(ctx context.Context, entity *domain.APIKey)) *MockAPIKeyRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.APIKey
+		if args[1] != nil {
+			arg1 = args[1].(*domain.APIKey)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_Update_Call) Return(err error) *MockAPIKeyRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_Update_Call) RunAndReturn(run func(ctx context.Context, entity *domain.APIKey) error) *MockAPIKeyRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
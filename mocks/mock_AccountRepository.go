@@ -154,55 +154,55 @@ func (_c *MockAccountRepository_Delete_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
-// FindByHolderName provides a mock function for the type MockAccountRepository
-func (_mock *MockAccountRepository) FindByHolderName(ctx context.Context, holderName string) ([]domain.Account, error) {
-	ret := _mock.Called(ctx, holderName)
+// FindAll provides a mock function for the type MockAccountRepository
+func (_mock *MockAccountRepository) FindAll(ctx context.Context, spec repository.Specification[domain.Account]) ([]domain.Account, error) {
+	ret := _mock.Called(ctx, spec)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByHolderName")
+		panic("no return value specified for FindAll")
 	}
 
 	var r0 []domain.Account
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.Account, error)); ok {
-		return returnFunc(ctx, holderName)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.Account]) ([]domain.Account, error)); ok {
+		return returnFunc(ctx, spec)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.Account); ok {
-		r0 = returnFunc(ctx, holderName)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.Account]) []domain.Account); ok {
+		r0 = returnFunc(ctx, spec)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.Account)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, holderName)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.Specification[domain.Account]) error); ok {
+		r1 = returnFunc(ctx, spec)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockAccountRepository_FindByHolderName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByHolderName'
-type MockAccountRepository_FindByHolderName_Call struct {
+// MockAccountRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockAccountRepository_FindAll_Call struct {
 	*mock.Call
 }
 
-// FindByHolderName is a helper method to define mock.On call
+// FindAll is a helper method to define mock.On call
 //   - ctx context.Context
-//   - holderName string
-func (_e *MockAccountRepository_Expecter) FindByHolderName(ctx interface{}, holderName interface{}) *MockAccountRepository_FindByHolderName_Call {
-	return &MockAccountRepository_FindByHolderName_Call{Call: _e.mock.On("FindByHolderName", ctx, holderName)}
+//   - spec repository.Specification[domain.Account]
+func (_e *MockAccountRepository_Expecter) FindAll(ctx interface{}, spec interface{}) *MockAccountRepository_FindAll_Call {
+	return &MockAccountRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx, spec)}
 }
 
-func (_c *MockAccountRepository_FindByHolderName_Call) Run(run func(ctx context.Context, holderName string)) *MockAccountRepository_FindByHolderName_Call {
+func (_c *MockAccountRepository_FindAll_Call) Run(run func(ctx context.Context, spec repository.Specification[domain.Account])) *MockAccountRepository_FindAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 repository.Specification[domain.Account]
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(repository.Specification[domain.Account])
 		}
 		run(
 			arg0,
@@ -212,58 +212,57 @@ func (_c *MockAccountRepository_FindByHolderName_Call) Run(run func(ctx context.
 	return _c
 }
 
-func (_c *MockAccountRepository_FindByHolderName_Call) Return(accounts []domain.Account, err error) *MockAccountRepository_FindByHolderName_Call {
+func (_c *MockAccountRepository_FindAll_Call) Return(accounts []domain.Account, err error) *MockAccountRepository_FindAll_Call {
 	_c.Call.Return(accounts, err)
 	return _c
 }
 
-func (_c *MockAccountRepository_FindByHolderName_Call) RunAndReturn(run func(ctx context.Context, holderName string) ([]domain.Account, error)) *MockAccountRepository_FindByHolderName_Call {
+func (_c *MockAccountRepository_FindAll_Call) RunAndReturn(run func(ctx context.Context, spec repository.Specification[domain.Account]) ([]domain.Account, error)) *MockAccountRepository_FindAll_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindByHolderNamePaginated provides a mock function for the type MockAccountRepository
-func (_mock *MockAccountRepository) FindByHolderNamePaginated(ctx context.Context, holderName string, req repository.PaginationRequest) (*repository.PaginationResponse[domain.Account], error) {
-	ret := _mock.Called(ctx, holderName, req)
+// FindByHolderName provides a mock function for the type MockAccountRepository
+func (_mock *MockAccountRepository) FindByHolderName(ctx context.Context, holderName string) ([]domain.Account, error) {
+	ret := _mock.Called(ctx, holderName)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByHolderNamePaginated")
+		panic("no return value specified for FindByHolderName")
 	}
 
-	var r0 *repository.PaginationResponse[domain.Account]
+	var r0 []domain.Account
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, repository.PaginationRequest) (*repository.PaginationResponse[domain.Account], error)); ok {
-		return returnFunc(ctx, holderName, req)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.Account, error)); ok {
+		return returnFunc(ctx, holderName)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, repository.PaginationRequest) *repository.PaginationResponse[domain.Account]); ok {
-		r0 = returnFunc(ctx, holderName, req)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.Account); ok {
+		r0 = returnFunc(ctx, holderName)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*repository.PaginationResponse[domain.Account])
+			r0 = ret.Get(0).([]domain.Account)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, repository.PaginationRequest) error); ok {
-		r1 = returnFunc(ctx, holderName, req)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, holderName)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockAccountRepository_FindByHolderNamePaginated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByHolderNamePaginated'
-type MockAccountRepository_FindByHolderNamePaginated_Call struct {
+// MockAccountRepository_FindByHolderName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByHolderName'
+type MockAccountRepository_FindByHolderName_Call struct {
 	*mock.Call
 }
 
-// FindByHolderNamePaginated is a helper method to define mock.On call
+// FindByHolderName is a helper method to define mock.On call
 //   - ctx context.Context
 //   - holderName string
-//   - req repository.PaginationRequest
-func (_e *MockAccountRepository_Expecter) FindByHolderNamePaginated(ctx interface{}, holderName interface{}, req interface{}) *MockAccountRepository_FindByHolderNamePaginated_Call {
-	return &MockAccountRepository_FindByHolderNamePaginated_Call{Call: _e.mock.On("FindByHolderNamePaginated", ctx, holderName, req)}
+func (_e *MockAccountRepository_Expecter) FindByHolderName(ctx interface{}, holderName interface{}) *MockAccountRepository_FindByHolderName_Call {
+	return &MockAccountRepository_FindByHolderName_Call{Call: _e.mock.On("FindByHolderName", ctx, holderName)}
 }
 
-func (_c *MockAccountRepository_FindByHolderNamePaginated_Call) Run(run func(ctx context.Context, holderName string, req repository.PaginationRequest)) *MockAccountRepository_FindByHolderNamePaginated_Call {
+func (_c *MockAccountRepository_FindByHolderName_Call) Run(run func(ctx context.Context, holderName string)) *MockAccountRepository_FindByHolderName_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -273,25 +272,20 @@ func (_c *MockAccountRepository_FindByHolderNamePaginated_Call) Run(run func(ctx
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 repository.PaginationRequest
-		if args[2] != nil {
-			arg2 = args[2].(repository.PaginationRequest)
-		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockAccountRepository_FindByHolderNamePaginated_Call) Return(paginationResponse *repository.PaginationResponse[domain.Account], err error) *MockAccountRepository_FindByHolderNamePaginated_Call {
-	_c.Call.Return(paginationResponse, err)
+func (_c *MockAccountRepository_FindByHolderName_Call) Return(accounts []domain.Account, err error) *MockAccountRepository_FindByHolderName_Call {
+	_c.Call.Return(accounts, err)
 	return _c
 }
 
-func (_c *MockAccountRepository_FindByHolderNamePaginated_Call) RunAndReturn(run func(ctx context.Context, holderName string, req repository.PaginationRequest) (*repository.PaginationResponse[domain.Account], error)) *MockAccountRepository_FindByHolderNamePaginated_Call {
+func (_c *MockAccountRepository_FindByHolderName_Call) RunAndReturn(run func(ctx context.Context, holderName string) ([]domain.Account, error)) *MockAccountRepository_FindByHolderName_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -432,56 +426,56 @@ func (_c *MockAccountRepository_FindByStatus_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
-// FindByStatusPaginated provides a mock function for the type MockAccountRepository
-func (_mock *MockAccountRepository) FindByStatusPaginated(ctx context.Context, status domain.AccountStatus, req repository.PaginationRequest) (*repository.PaginationResponse[domain.Account], error) {
-	ret := _mock.Called(ctx, status, req)
+// FindPaginated provides a mock function for the type MockAccountRepository
+func (_mock *MockAccountRepository) FindPaginated(ctx context.Context, spec repository.Specification[domain.Account], req repository.PaginationRequest) (*repository.PaginationResponse[domain.Account], error) {
+	ret := _mock.Called(ctx, spec, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByStatusPaginated")
+		panic("no return value specified for FindPaginated")
 	}
 
 	var r0 *repository.PaginationResponse[domain.Account]
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AccountStatus, repository.PaginationRequest) (*repository.PaginationResponse[domain.Account], error)); ok {
-		return returnFunc(ctx, status, req)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.Account], repository.PaginationRequest) (*repository.PaginationResponse[domain.Account], error)); ok {
+		return returnFunc(ctx, spec, req)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AccountStatus, repository.PaginationRequest) *repository.PaginationResponse[domain.Account]); ok {
-		r0 = returnFunc(ctx, status, req)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.Account], repository.PaginationRequest) *repository.PaginationResponse[domain.Account]); ok {
+		r0 = returnFunc(ctx, spec, req)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*repository.PaginationResponse[domain.Account])
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AccountStatus, repository.PaginationRequest) error); ok {
-		r1 = returnFunc(ctx, status, req)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.Specification[domain.Account], repository.PaginationRequest) error); ok {
+		r1 = returnFunc(ctx, spec, req)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockAccountRepository_FindByStatusPaginated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByStatusPaginated'
-type MockAccountRepository_FindByStatusPaginated_Call struct {
+// MockAccountRepository_FindPaginated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPaginated'
+type MockAccountRepository_FindPaginated_Call struct {
 	*mock.Call
 }
 
-// FindByStatusPaginated is a helper method to define mock.On call
+// FindPaginated is a helper method to define mock.On call
 //   - ctx context.Context
-//   - status domain.AccountStatus
+//   - spec repository.Specification[domain.Account]
 //   - req repository.PaginationRequest
-func (_e *MockAccountRepository_Expecter) FindByStatusPaginated(ctx interface{}, status interface{}, req interface{}) *MockAccountRepository_FindByStatusPaginated_Call {
-	return &MockAccountRepository_FindByStatusPaginated_Call{Call: _e.mock.On("FindByStatusPaginated", ctx, status, req)}
+func (_e *MockAccountRepository_Expecter) FindPaginated(ctx interface{}, spec interface{}, req interface{}) *MockAccountRepository_FindPaginated_Call {
+	return &MockAccountRepository_FindPaginated_Call{Call: _e.mock.On("FindPaginated", ctx, spec, req)}
 }
 
-func (_c *MockAccountRepository_FindByStatusPaginated_Call) Run(run func(ctx context.Context, status domain.AccountStatus, req repository.PaginationRequest)) *MockAccountRepository_FindByStatusPaginated_Call {
+func (_c *MockAccountRepository_FindPaginated_Call) Run(run func(ctx context.Context, spec repository.Specification[domain.Account], req repository.PaginationRequest)) *MockAccountRepository_FindPaginated_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 domain.AccountStatus
+		var arg1 repository.Specification[domain.Account]
 		if args[1] != nil {
-			arg1 = args[1].(domain.AccountStatus)
+			arg1 = args[1].(repository.Specification[domain.Account])
 		}
 		var arg2 repository.PaginationRequest
 		if args[2] != nil {
@@ -496,12 +490,12 @@ func (_c *MockAccountRepository_FindByStatusPaginated_Call) Run(run func(ctx con
 	return _c
 }
 
-func (_c *MockAccountRepository_FindByStatusPaginated_Call) Return(paginationResponse *repository.PaginationResponse[domain.Account], err error) *MockAccountRepository_FindByStatusPaginated_Call {
+func (_c *MockAccountRepository_FindPaginated_Call) Return(paginationResponse *repository.PaginationResponse[domain.Account], err error) *MockAccountRepository_FindPaginated_Call {
 	_c.Call.Return(paginationResponse, err)
 	return _c
 }
 
-func (_c *MockAccountRepository_FindByStatusPaginated_Call) RunAndReturn(run func(ctx context.Context, status domain.AccountStatus, req repository.PaginationRequest) (*repository.PaginationResponse[domain.Account], error)) *MockAccountRepository_FindByStatusPaginated_Call {
+func (_c *MockAccountRepository_FindPaginated_Call) RunAndReturn(run func(ctx context.Context, spec repository.Specification[domain.Account], req repository.PaginationRequest) (*repository.PaginationResponse[domain.Account], error)) *MockAccountRepository_FindPaginated_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -636,6 +630,142 @@ func (_c *MockAccountRepository_GetByID_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// GetByIDForUpdate provides a mock function for the type MockAccountRepository
+func (_mock *MockAccountRepository) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*domain.Account, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDForUpdate")
+	}
+
+	var r0 *domain.Account
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Account, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Account); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Account)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAccountRepository_GetByIDForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDForUpdate'
+type MockAccountRepository_GetByIDForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetByIDForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockAccountRepository_Expecter) GetByIDForUpdate(ctx interface{}, id interface{}) *MockAccountRepository_GetByIDForUpdate_Call {
+	return &MockAccountRepository_GetByIDForUpdate_Call{Call: _e.mock.On("GetByIDForUpdate", ctx, id)}
+}
+
+func (_c *MockAccountRepository_GetByIDForUpdate_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockAccountRepository_GetByIDForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAccountRepository_GetByIDForUpdate_Call) Return(account *domain.Account, err error) *MockAccountRepository_GetByIDForUpdate_Call {
+	_c.Call.Return(account, err)
+	return _c
+}
+
+func (_c *MockAccountRepository_GetByIDForUpdate_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.Account, error)) *MockAccountRepository_GetByIDForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDWithDeleted provides a mock function for the type MockAccountRepository
+func (_mock *MockAccountRepository) GetByIDWithDeleted(ctx context.Context, id uuid.UUID) (*domain.Account, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDWithDeleted")
+	}
+
+	var r0 *domain.Account
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Account, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Account); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Account)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAccountRepository_GetByIDWithDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDWithDeleted'
+type MockAccountRepository_GetByIDWithDeleted_Call struct {
+	*mock.Call
+}
+
+// GetByIDWithDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockAccountRepository_Expecter) GetByIDWithDeleted(ctx interface{}, id interface{}) *MockAccountRepository_GetByIDWithDeleted_Call {
+	return &MockAccountRepository_GetByIDWithDeleted_Call{Call: _e.mock.On("GetByIDWithDeleted", ctx, id)}
+}
+
+func (_c *MockAccountRepository_GetByIDWithDeleted_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockAccountRepository_GetByIDWithDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAccountRepository_GetByIDWithDeleted_Call) Return(account *domain.Account, err error) *MockAccountRepository_GetByIDWithDeleted_Call {
+	_c.Call.Return(account, err)
+	return _c
+}
+
+func (_c *MockAccountRepository_GetByIDWithDeleted_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.Account, error)) *MockAccountRepository_GetByIDWithDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPaginated provides a mock function for the type MockAccountRepository
 func (_mock *MockAccountRepository) GetPaginated(ctx context.Context, req repository.PaginationRequest) (*repository.PaginationResponse[domain.Account], error) {
 	ret := _mock.Called(ctx, req)
@@ -704,6 +834,131 @@ func (_c *MockAccountRepository_GetPaginated_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// GetPaginatedWithDeleted provides a mock function for the type MockAccountRepository
+func (_mock *MockAccountRepository) GetPaginatedWithDeleted(ctx context.Context, req repository.PaginationRequest) (*repository.PaginationResponse[domain.Account], error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaginatedWithDeleted")
+	}
+
+	var r0 *repository.PaginationResponse[domain.Account]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.PaginationRequest) (*repository.PaginationResponse[domain.Account], error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.PaginationRequest) *repository.PaginationResponse[domain.Account]); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.PaginationResponse[domain.Account])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.PaginationRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAccountRepository_GetPaginatedWithDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaginatedWithDeleted'
+type MockAccountRepository_GetPaginatedWithDeleted_Call struct {
+	*mock.Call
+}
+
+// GetPaginatedWithDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req repository.PaginationRequest
+func (_e *MockAccountRepository_Expecter) GetPaginatedWithDeleted(ctx interface{}, req interface{}) *MockAccountRepository_GetPaginatedWithDeleted_Call {
+	return &MockAccountRepository_GetPaginatedWithDeleted_Call{Call: _e.mock.On("GetPaginatedWithDeleted", ctx, req)}
+}
+
+func (_c *MockAccountRepository_GetPaginatedWithDeleted_Call) Run(run func(ctx context.Context, req repository.PaginationRequest)) *MockAccountRepository_GetPaginatedWithDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.PaginationRequest
+		if args[1] != nil {
+			arg1 = args[1].(repository.PaginationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAccountRepository_GetPaginatedWithDeleted_Call) Return(paginationResponse *repository.PaginationResponse[domain.Account], err error) *MockAccountRepository_GetPaginatedWithDeleted_Call {
+	_c.Call.Return(paginationResponse, err)
+	return _c
+}
+
+func (_c *MockAccountRepository_GetPaginatedWithDeleted_Call) RunAndReturn(run func(ctx context.Context, req repository.PaginationRequest) (*repository.PaginationResponse[domain.Account], error)) *MockAccountRepository_GetPaginatedWithDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockAccountRepository
+func (_mock *MockAccountRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAccountRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockAccountRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockAccountRepository_Expecter) Restore(ctx interface{}, id interface{}) *MockAccountRepository_Restore_Call {
+	return &MockAccountRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
+}
+
+func (_c *MockAccountRepository_Restore_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockAccountRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAccountRepository_Restore_Call) Return(err error) *MockAccountRepository_Restore_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAccountRepository_Restore_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockAccountRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockAccountRepository
 func (_mock *MockAccountRepository) Update(ctx context.Context, entity *domain.Account) error {
 	ret := _mock.Called(ctx, entity)
@@ -0,0 +1,694 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockExchangeRateRepository creates a new instance of MockExchangeRateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExchangeRateRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExchangeRateRepository {
+	mock := &MockExchangeRateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockExchangeRateRepository is an autogenerated mock type for the ExchangeRateRepository type
+type MockExchangeRateRepository struct {
+	mock.Mock
+}
+
+type MockExchangeRateRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExchangeRateRepository) EXPECT() *MockExchangeRateRepository_Expecter {
+	return &MockExchangeRateRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockExchangeRateRepository
+func (_mock *MockExchangeRateRepository) Create(ctx context.Context, entity *domain.ExchangeRate) error {
+	ret := _mock.Called(ctx, entity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ExchangeRate) error); ok {
+		r0 = returnFunc(ctx, entity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockExchangeRateRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockExchangeRateRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entity *domain.ExchangeRate
+func (_e *MockExchangeRateRepository_Expecter) Create(ctx interface{}, entity interface{}) *MockExchangeRateRepository_Create_Call {
+	return &MockExchangeRateRepository_Create_Call{Call: _e.mock.On("Create", ctx, entity)}
+}
+
+func (_c *MockExchangeRateRepository_Create_Call) Run(run func(ctx context.Context, entity *domain.ExchangeRate)) *MockExchangeRateRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ExchangeRate
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ExchangeRate)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExchangeRateRepository_Create_Call) Return(err error) *MockExchangeRateRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockExchangeRateRepository_Create_Call) RunAndReturn(run func(ctx context.Context, entity *domain.ExchangeRate) error) *MockExchangeRateRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockExchangeRateRepository
+func (_mock *MockExchangeRateRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockExchangeRateRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockExchangeRateRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockExchangeRateRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockExchangeRateRepository_Delete_Call {
+	return &MockExchangeRateRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockExchangeRateRepository_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockExchangeRateRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExchangeRateRepository_Delete_Call) Return(err error) *MockExchangeRateRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockExchangeRateRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockExchangeRateRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function for the type MockExchangeRateRepository
+func (_mock *MockExchangeRateRepository) FindAll(ctx context.Context, spec repository.Specification[domain.ExchangeRate]) ([]domain.ExchangeRate, error) {
+	ret := _mock.Called(ctx, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []domain.ExchangeRate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.ExchangeRate]) ([]domain.ExchangeRate, error)); ok {
+		return returnFunc(ctx, spec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.ExchangeRate]) []domain.ExchangeRate); ok {
+		r0 = returnFunc(ctx, spec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ExchangeRate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.Specification[domain.ExchangeRate]) error); ok {
+		r1 = returnFunc(ctx, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExchangeRateRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockExchangeRateRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec repository.Specification[domain.ExchangeRate]
+func (_e *MockExchangeRateRepository_Expecter) FindAll(ctx interface{}, spec interface{}) *MockExchangeRateRepository_FindAll_Call {
+	return &MockExchangeRateRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx, spec)}
+}
+
+func (_c *MockExchangeRateRepository_FindAll_Call) Run(run func(ctx context.Context, spec repository.Specification[domain.ExchangeRate])) *MockExchangeRateRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.Specification[domain.ExchangeRate]
+		if args[1] != nil {
+			arg1 = args[1].(repository.Specification[domain.ExchangeRate])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExchangeRateRepository_FindAll_Call) Return(exchangeRates []domain.ExchangeRate, err error) *MockExchangeRateRepository_FindAll_Call {
+	_c.Call.Return(exchangeRates, err)
+	return _c
+}
+
+func (_c *MockExchangeRateRepository_FindAll_Call) RunAndReturn(run func(ctx context.Context, spec repository.Specification[domain.ExchangeRate]) ([]domain.ExchangeRate, error)) *MockExchangeRateRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPaginated provides a mock function for the type MockExchangeRateRepository
+func (_mock *MockExchangeRateRepository) FindPaginated(ctx context.Context, spec repository.Specification[domain.ExchangeRate], req repository.PaginationRequest) (*repository.PaginationResponse[domain.ExchangeRate], error) {
+	ret := _mock.Called(ctx, spec, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPaginated")
+	}
+
+	var r0 *repository.PaginationResponse[domain.ExchangeRate]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.ExchangeRate], repository.PaginationRequest) (*repository.PaginationResponse[domain.ExchangeRate], error)); ok {
+		return returnFunc(ctx, spec, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.ExchangeRate], repository.PaginationRequest) *repository.PaginationResponse[domain.ExchangeRate]); ok {
+		r0 = returnFunc(ctx, spec, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.PaginationResponse[domain.ExchangeRate])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.Specification[domain.ExchangeRate], repository.PaginationRequest) error); ok {
+		r1 = returnFunc(ctx, spec, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExchangeRateRepository_FindPaginated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPaginated'
+type MockExchangeRateRepository_FindPaginated_Call struct {
+	*mock.Call
+}
+
+// FindPaginated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec repository.Specification[domain.ExchangeRate]
+//   - req repository.PaginationRequest
+func (_e *MockExchangeRateRepository_Expecter) FindPaginated(ctx interface{}, spec interface{}, req interface{}) *MockExchangeRateRepository_FindPaginated_Call {
+	return &MockExchangeRateRepository_FindPaginated_Call{Call: _e.mock.On("FindPaginated", ctx, spec, req)}
+}
+
+func (_c *MockExchangeRateRepository_FindPaginated_Call) Run(run func(ctx context.Context, spec repository.Specification[domain.ExchangeRate], req repository.PaginationRequest)) *MockExchangeRateRepository_FindPaginated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.Specification[domain.ExchangeRate]
+		if args[1] != nil {
+			arg1 = args[1].(repository.Specification[domain.ExchangeRate])
+		}
+		var arg2 repository.PaginationRequest
+		if args[2] != nil {
+			arg2 = args[2].(repository.PaginationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExchangeRateRepository_FindPaginated_Call) Return(paginationResponse *repository.PaginationResponse[domain.ExchangeRate], err error) *MockExchangeRateRepository_FindPaginated_Call {
+	_c.Call.Return(paginationResponse, err)
+	return _c
+}
+
+func (_c *MockExchangeRateRepository_FindPaginated_Call) RunAndReturn(run func(ctx context.Context, spec repository.Specification[domain.ExchangeRate], req repository.PaginationRequest) (*repository.PaginationResponse[domain.ExchangeRate], error)) *MockExchangeRateRepository_FindPaginated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function for the type MockExchangeRateRepository
+func (_mock *MockExchangeRateRepository) GetAll(ctx context.Context) ([]domain.ExchangeRate, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []domain.ExchangeRate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.ExchangeRate, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.ExchangeRate); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ExchangeRate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExchangeRateRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type MockExchangeRateRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockExchangeRateRepository_Expecter) GetAll(ctx interface{}) *MockExchangeRateRepository_GetAll_Call {
+	return &MockExchangeRateRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *MockExchangeRateRepository_GetAll_Call) Run(run func(ctx context.Context)) *MockExchangeRateRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExchangeRateRepository_GetAll_Call) Return(exchangeRates []domain.ExchangeRate, err error) *MockExchangeRateRepository_GetAll_Call {
+	_c.Call.Return(exchangeRates, err)
+	return _c
+}
+
+func (_c *MockExchangeRateRepository_GetAll_Call) RunAndReturn(run func(ctx context.Context) ([]domain.ExchangeRate, error)) *MockExchangeRateRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockExchangeRateRepository
+func (_mock *MockExchangeRateRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.ExchangeRate, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.ExchangeRate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.ExchangeRate, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.ExchangeRate); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ExchangeRate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExchangeRateRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockExchangeRateRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockExchangeRateRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockExchangeRateRepository_GetByID_Call {
+	return &MockExchangeRateRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockExchangeRateRepository_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockExchangeRateRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExchangeRateRepository_GetByID_Call) Return(exchangeRate *domain.ExchangeRate, err error) *MockExchangeRateRepository_GetByID_Call {
+	_c.Call.Return(exchangeRate, err)
+	return _c
+}
+
+func (_c *MockExchangeRateRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.ExchangeRate, error)) *MockExchangeRateRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDForUpdate provides a mock function for the type MockExchangeRateRepository
+func (_mock *MockExchangeRateRepository) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*domain.ExchangeRate, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDForUpdate")
+	}
+
+	var r0 *domain.ExchangeRate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.ExchangeRate, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.ExchangeRate); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ExchangeRate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExchangeRateRepository_GetByIDForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDForUpdate'
+type MockExchangeRateRepository_GetByIDForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetByIDForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockExchangeRateRepository_Expecter) GetByIDForUpdate(ctx interface{}, id interface{}) *MockExchangeRateRepository_GetByIDForUpdate_Call {
+	return &MockExchangeRateRepository_GetByIDForUpdate_Call{Call: _e.mock.On("GetByIDForUpdate", ctx, id)}
+}
+
+func (_c *MockExchangeRateRepository_GetByIDForUpdate_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockExchangeRateRepository_GetByIDForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExchangeRateRepository_GetByIDForUpdate_Call) Return(exchangeRate *domain.ExchangeRate, err error) *MockExchangeRateRepository_GetByIDForUpdate_Call {
+	_c.Call.Return(exchangeRate, err)
+	return _c
+}
+
+func (_c *MockExchangeRateRepository_GetByIDForUpdate_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.ExchangeRate, error)) *MockExchangeRateRepository_GetByIDForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPaginated provides a mock function for the type MockExchangeRateRepository
+func (_mock *MockExchangeRateRepository) GetPaginated(ctx context.Context, req repository.PaginationRequest) (*repository.PaginationResponse[domain.ExchangeRate], error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaginated")
+	}
+
+	var r0 *repository.PaginationResponse[domain.ExchangeRate]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.PaginationRequest) (*repository.PaginationResponse[domain.ExchangeRate], error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.PaginationRequest) *repository.PaginationResponse[domain.ExchangeRate]); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.PaginationResponse[domain.ExchangeRate])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.PaginationRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExchangeRateRepository_GetPaginated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaginated'
+type MockExchangeRateRepository_GetPaginated_Call struct {
+	*mock.Call
+}
+
+// GetPaginated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req repository.PaginationRequest
+func (_e *MockExchangeRateRepository_Expecter) GetPaginated(ctx interface{}, req interface{}) *MockExchangeRateRepository_GetPaginated_Call {
+	return &MockExchangeRateRepository_GetPaginated_Call{Call: _e.mock.On("GetPaginated", ctx, req)}
+}
+
+func (_c *MockExchangeRateRepository_GetPaginated_Call) Run(run func(ctx context.Context, req repository.PaginationRequest)) *MockExchangeRateRepository_GetPaginated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.PaginationRequest
+		if args[1] != nil {
+			arg1 = args[1].(repository.PaginationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExchangeRateRepository_GetPaginated_Call) Return(paginationResponse *repository.PaginationResponse[domain.ExchangeRate], err error) *MockExchangeRateRepository_GetPaginated_Call {
+	_c.Call.Return(paginationResponse, err)
+	return _c
+}
+
+func (_c *MockExchangeRateRepository_GetPaginated_Call) RunAndReturn(run func(ctx context.Context, req repository.PaginationRequest) (*repository.PaginationResponse[domain.ExchangeRate], error)) *MockExchangeRateRepository_GetPaginated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRate provides a mock function for the type MockExchangeRateRepository
+func (_mock *MockExchangeRateRepository) GetRate(ctx context.Context, base domain.Currency, quote domain.Currency) (*domain.ExchangeRate, error) {
+	ret := _mock.Called(ctx, base, quote)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRate")
+	}
+
+	var r0 *domain.ExchangeRate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Currency, domain.Currency) (*domain.ExchangeRate, error)); ok {
+		return returnFunc(ctx, base, quote)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Currency, domain.Currency) *domain.ExchangeRate); ok {
+		r0 = returnFunc(ctx, base, quote)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ExchangeRate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Currency, domain.Currency) error); ok {
+		r1 = returnFunc(ctx, base, quote)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExchangeRateRepository_GetRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRate'
+type MockExchangeRateRepository_GetRate_Call struct {
+	*mock.Call
+}
+
+// GetRate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - base domain.Currency
+//   - quote domain.Currency
+func (_e *MockExchangeRateRepository_Expecter) GetRate(ctx interface{}, base interface{}, quote interface{}) *MockExchangeRateRepository_GetRate_Call {
+	return &MockExchangeRateRepository_GetRate_Call{Call: _e.mock.On("GetRate", ctx, base, quote)}
+}
+
+func (_c *MockExchangeRateRepository_GetRate_Call) Run(run func(ctx context.Context, base domain.Currency, quote domain.Currency)) *MockExchangeRateRepository_GetRate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Currency
+		if args[1] != nil {
+			arg1 = args[1].(domain.Currency)
+		}
+		var arg2 domain.Currency
+		if args[2] != nil {
+			arg2 = args[2].(domain.Currency)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExchangeRateRepository_GetRate_Call) Return(exchangeRate *domain.ExchangeRate, err error) *MockExchangeRateRepository_GetRate_Call {
+	_c.Call.Return(exchangeRate, err)
+	return _c
+}
+
+func (_c *MockExchangeRateRepository_GetRate_Call) RunAndReturn(run func(ctx context.Context, base domain.Currency, quote domain.Currency) (*domain.ExchangeRate, error)) *MockExchangeRateRepository_GetRate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockExchangeRateRepository
+func (_mock *MockExchangeRateRepository) Update(ctx context.Context, entity *domain.ExchangeRate) error {
+	ret := _mock.Called(ctx, entity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ExchangeRate) error); ok {
+		r0 = returnFunc(ctx, entity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockExchangeRateRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockExchangeRateRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entity *domain.ExchangeRate
+func (_e *MockExchangeRateRepository_Expecter) Update(ctx interface{}, entity interface{}) *MockExchangeRateRepository_Update_Call {
+	return &MockExchangeRateRepository_Update_Call{Call: _e.mock.On("Update", ctx, entity)}
+}
+
+func (_c *MockExchangeRateRepository_Update_Call) Run(run func(ctx context.Context, entity *domain.ExchangeRate)) *MockExchangeRateRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ExchangeRate
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ExchangeRate)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExchangeRateRepository_Update_Call) Return(err error) *MockExchangeRateRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockExchangeRateRepository_Update_Call) RunAndReturn(run func(ctx context.Context, entity *domain.ExchangeRate) error) *MockExchangeRateRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
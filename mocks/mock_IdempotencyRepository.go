@@ -0,0 +1,305 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIdempotencyRepository creates a new instance of MockIdempotencyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIdempotencyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIdempotencyRepository {
+	mock := &MockIdempotencyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIdempotencyRepository is an autogenerated mock type for the IdempotencyRepository type
+type MockIdempotencyRepository struct {
+	mock.Mock
+}
+
+type MockIdempotencyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIdempotencyRepository) EXPECT() *MockIdempotencyRepository_Expecter {
+	return &MockIdempotencyRepository_Expecter{mock: &_m.Mock}
+}
+
+// Complete provides a mock function for the type MockIdempotencyRepository
+func (_mock *MockIdempotencyRepository) Complete(ctx context.Context, key string, statusCode int, contentType string, body []byte) error {
+	ret := _mock.Called(ctx, key, statusCode, contentType, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Complete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, string, []byte) error); ok {
+		r0 = returnFunc(ctx, key, statusCode, contentType, body)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIdempotencyRepository_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type MockIdempotencyRepository_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - statusCode int
+//   - contentType string
+//   - body []byte
+func (_e *MockIdempotencyRepository_Expecter) Complete(ctx interface{}, key interface{}, statusCode interface{}, contentType interface{}, body interface{}) *MockIdempotencyRepository_Complete_Call {
+	return &MockIdempotencyRepository_Complete_Call{Call: _e.mock.On("Complete", ctx, key, statusCode, contentType, body)}
+}
+
+func (_c *MockIdempotencyRepository_Complete_Call) Run(run func(ctx context.Context, key string, statusCode int, contentType string, body []byte)) *MockIdempotencyRepository_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 []byte
+		if args[4] != nil {
+			arg4 = args[4].([]byte)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyRepository_Complete_Call) Return(err error) *MockIdempotencyRepository_Complete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIdempotencyRepository_Complete_Call) RunAndReturn(run func(ctx context.Context, key string, statusCode int, contentType string, body []byte) error) *MockIdempotencyRepository_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByKey provides a mock function for the type MockIdempotencyRepository
+func (_mock *MockIdempotencyRepository) FindByKey(ctx context.Context, key string) (*domain.IdempotencyRecord, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByKey")
+	}
+
+	var r0 *domain.IdempotencyRecord
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.IdempotencyRecord, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.IdempotencyRecord); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.IdempotencyRecord)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIdempotencyRepository_FindByKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByKey'
+type MockIdempotencyRepository_FindByKey_Call struct {
+	*mock.Call
+}
+
+// FindByKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockIdempotencyRepository_Expecter) FindByKey(ctx interface{}, key interface{}) *MockIdempotencyRepository_FindByKey_Call {
+	return &MockIdempotencyRepository_FindByKey_Call{Call: _e.mock.On("FindByKey", ctx, key)}
+}
+
+func (_c *MockIdempotencyRepository_FindByKey_Call) Run(run func(ctx context.Context, key string)) *MockIdempotencyRepository_FindByKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyRepository_FindByKey_Call) Return(idempotencyRecord *domain.IdempotencyRecord, err error) *MockIdempotencyRepository_FindByKey_Call {
+	_c.Call.Return(idempotencyRecord, err)
+	return _c
+}
+
+func (_c *MockIdempotencyRepository_FindByKey_Call) RunAndReturn(run func(ctx context.Context, key string) (*domain.IdempotencyRecord, error)) *MockIdempotencyRepository_FindByKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Release provides a mock function for the type MockIdempotencyRepository
+func (_mock *MockIdempotencyRepository) Release(ctx context.Context, key string) error {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Release")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIdempotencyRepository_Release_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Release'
+type MockIdempotencyRepository_Release_Call struct {
+	*mock.Call
+}
+
+// Release is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockIdempotencyRepository_Expecter) Release(ctx interface{}, key interface{}) *MockIdempotencyRepository_Release_Call {
+	return &MockIdempotencyRepository_Release_Call{Call: _e.mock.On("Release", ctx, key)}
+}
+
+func (_c *MockIdempotencyRepository_Release_Call) Run(run func(ctx context.Context, key string)) *MockIdempotencyRepository_Release_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyRepository_Release_Call) Return(err error) *MockIdempotencyRepository_Release_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIdempotencyRepository_Release_Call) RunAndReturn(run func(ctx context.Context, key string) error) *MockIdempotencyRepository_Release_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reserve provides a mock function for the type MockIdempotencyRepository
+func (_mock *MockIdempotencyRepository) Reserve(ctx context.Context, record *domain.IdempotencyRecord) (bool, error) {
+	ret := _mock.Called(ctx, record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reserve")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.IdempotencyRecord) (bool, error)); ok {
+		return returnFunc(ctx, record)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.IdempotencyRecord) bool); ok {
+		r0 = returnFunc(ctx, record)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.IdempotencyRecord) error); ok {
+		r1 = returnFunc(ctx, record)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIdempotencyRepository_Reserve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reserve'
+type MockIdempotencyRepository_Reserve_Call struct {
+	*mock.Call
+}
+
+// Reserve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - record *domain.IdempotencyRecord
+func (_e *MockIdempotencyRepository_Expecter) Reserve(ctx interface{}, record interface{}) *MockIdempotencyRepository_Reserve_Call {
+	return &MockIdempotencyRepository_Reserve_Call{Call: _e.mock.On("Reserve", ctx, record)}
+}
+
+func (_c *MockIdempotencyRepository_Reserve_Call) Run(run func(ctx context.Context, record *domain.IdempotencyRecord)) *MockIdempotencyRepository_Reserve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.IdempotencyRecord
+		if args[1] != nil {
+			arg1 = args[1].(*domain.IdempotencyRecord)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyRepository_Reserve_Call) Return(b bool, err error) *MockIdempotencyRepository_Reserve_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockIdempotencyRepository_Reserve_Call) RunAndReturn(run func(ctx context.Context, record *domain.IdempotencyRecord) (bool, error)) *MockIdempotencyRepository_Reserve_Call {
+	_c.Call.Return(run)
+	return _c
+}
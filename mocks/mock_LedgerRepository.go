@@ -0,0 +1,891 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockLedgerRepository creates a new instance of MockLedgerRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLedgerRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLedgerRepository {
+	mock := &MockLedgerRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLedgerRepository is an autogenerated mock type for the LedgerRepository type
+type MockLedgerRepository struct {
+	mock.Mock
+}
+
+type MockLedgerRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLedgerRepository) EXPECT() *MockLedgerRepository_Expecter {
+	return &MockLedgerRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockLedgerRepository
+func (_mock *MockLedgerRepository) Create(ctx context.Context, entity *domain.LedgerEntry) error {
+	ret := _mock.Called(ctx, entity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.LedgerEntry) error); ok {
+		r0 = returnFunc(ctx, entity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLedgerRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockLedgerRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entity *domain.LedgerEntry
+func (_e *MockLedgerRepository_Expecter) Create(ctx interface{}, entity interface{}) *MockLedgerRepository_Create_Call {
+	return &MockLedgerRepository_Create_Call{Call: _e.mock.On("Create", ctx, entity)}
+}
+
+func (_c *MockLedgerRepository_Create_Call) Run(run func(ctx context.Context, entity *domain.LedgerEntry)) *MockLedgerRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.LedgerEntry
+		if args[1] != nil {
+			arg1 = args[1].(*domain.LedgerEntry)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_Create_Call) Return(err error) *MockLedgerRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLedgerRepository_Create_Call) RunAndReturn(run func(ctx context.Context, entity *domain.LedgerEntry) error) *MockLedgerRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateEntries provides a mock function for the type MockLedgerRepository
+func (_mock *MockLedgerRepository) CreateEntries(ctx context.Context, entries []domain.LedgerEntry) error {
+	ret := _mock.Called(ctx, entries)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEntries")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.LedgerEntry) error); ok {
+		r0 = returnFunc(ctx, entries)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLedgerRepository_CreateEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEntries'
+type MockLedgerRepository_CreateEntries_Call struct {
+	*mock.Call
+}
+
+// CreateEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entries []domain.LedgerEntry
+func (_e *MockLedgerRepository_Expecter) CreateEntries(ctx interface{}, entries interface{}) *MockLedgerRepository_CreateEntries_Call {
+	return &MockLedgerRepository_CreateEntries_Call{Call: _e.mock.On("CreateEntries", ctx, entries)}
+}
+
+func (_c *MockLedgerRepository_CreateEntries_Call) Run(run func(ctx context.Context, entries []domain.LedgerEntry)) *MockLedgerRepository_CreateEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.LedgerEntry
+		if args[1] != nil {
+			arg1 = args[1].([]domain.LedgerEntry)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_CreateEntries_Call) Return(err error) *MockLedgerRepository_CreateEntries_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLedgerRepository_CreateEntries_Call) RunAndReturn(run func(ctx context.Context, entries []domain.LedgerEntry) error) *MockLedgerRepository_CreateEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockLedgerRepository
+func (_mock *MockLedgerRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLedgerRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockLedgerRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockLedgerRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockLedgerRepository_Delete_Call {
+	return &MockLedgerRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockLedgerRepository_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockLedgerRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_Delete_Call) Return(err error) *MockLedgerRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLedgerRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockLedgerRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function for the type MockLedgerRepository
+func (_mock *MockLedgerRepository) FindAll(ctx context.Context, spec repository.Specification[domain.LedgerEntry]) ([]domain.LedgerEntry, error) {
+	ret := _mock.Called(ctx, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []domain.LedgerEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.LedgerEntry]) ([]domain.LedgerEntry, error)); ok {
+		return returnFunc(ctx, spec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.LedgerEntry]) []domain.LedgerEntry); ok {
+		r0 = returnFunc(ctx, spec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.LedgerEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.Specification[domain.LedgerEntry]) error); ok {
+		r1 = returnFunc(ctx, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLedgerRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockLedgerRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec repository.Specification[domain.LedgerEntry]
+func (_e *MockLedgerRepository_Expecter) FindAll(ctx interface{}, spec interface{}) *MockLedgerRepository_FindAll_Call {
+	return &MockLedgerRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx, spec)}
+}
+
+func (_c *MockLedgerRepository_FindAll_Call) Run(run func(ctx context.Context, spec repository.Specification[domain.LedgerEntry])) *MockLedgerRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.Specification[domain.LedgerEntry]
+		if args[1] != nil {
+			arg1 = args[1].(repository.Specification[domain.LedgerEntry])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_FindAll_Call) Return(ledgerEntrys []domain.LedgerEntry, err error) *MockLedgerRepository_FindAll_Call {
+	_c.Call.Return(ledgerEntrys, err)
+	return _c
+}
+
+func (_c *MockLedgerRepository_FindAll_Call) RunAndReturn(run func(ctx context.Context, spec repository.Specification[domain.LedgerEntry]) ([]domain.LedgerEntry, error)) *MockLedgerRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByAccountIDPaginated provides a mock function for the type MockLedgerRepository
+func (_mock *MockLedgerRepository) FindByAccountIDPaginated(ctx context.Context, accountID uuid.UUID, req repository.PaginationRequest) (*repository.PaginationResponse[domain.LedgerEntry], error) {
+	ret := _mock.Called(ctx, accountID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByAccountIDPaginated")
+	}
+
+	var r0 *repository.PaginationResponse[domain.LedgerEntry]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.PaginationRequest) (*repository.PaginationResponse[domain.LedgerEntry], error)); ok {
+		return returnFunc(ctx, accountID, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.PaginationRequest) *repository.PaginationResponse[domain.LedgerEntry]); ok {
+		r0 = returnFunc(ctx, accountID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.PaginationResponse[domain.LedgerEntry])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, repository.PaginationRequest) error); ok {
+		r1 = returnFunc(ctx, accountID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLedgerRepository_FindByAccountIDPaginated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByAccountIDPaginated'
+type MockLedgerRepository_FindByAccountIDPaginated_Call struct {
+	*mock.Call
+}
+
+// FindByAccountIDPaginated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accountID uuid.UUID
+//   - req repository.PaginationRequest
+func (_e *MockLedgerRepository_Expecter) FindByAccountIDPaginated(ctx interface{}, accountID interface{}, req interface{}) *MockLedgerRepository_FindByAccountIDPaginated_Call {
+	return &MockLedgerRepository_FindByAccountIDPaginated_Call{Call: _e.mock.On("FindByAccountIDPaginated", ctx, accountID, req)}
+}
+
+func (_c *MockLedgerRepository_FindByAccountIDPaginated_Call) Run(run func(ctx context.Context, accountID uuid.UUID, req repository.PaginationRequest)) *MockLedgerRepository_FindByAccountIDPaginated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 repository.PaginationRequest
+		if args[2] != nil {
+			arg2 = args[2].(repository.PaginationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_FindByAccountIDPaginated_Call) Return(paginationResponse *repository.PaginationResponse[domain.LedgerEntry], err error) *MockLedgerRepository_FindByAccountIDPaginated_Call {
+	_c.Call.Return(paginationResponse, err)
+	return _c
+}
+
+func (_c *MockLedgerRepository_FindByAccountIDPaginated_Call) RunAndReturn(run func(ctx context.Context, accountID uuid.UUID, req repository.PaginationRequest) (*repository.PaginationResponse[domain.LedgerEntry], error)) *MockLedgerRepository_FindByAccountIDPaginated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByTransactionID provides a mock function for the type MockLedgerRepository
+func (_mock *MockLedgerRepository) FindByTransactionID(ctx context.Context, transactionID uuid.UUID) ([]domain.LedgerEntry, error) {
+	ret := _mock.Called(ctx, transactionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByTransactionID")
+	}
+
+	var r0 []domain.LedgerEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]domain.LedgerEntry, error)); ok {
+		return returnFunc(ctx, transactionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []domain.LedgerEntry); ok {
+		r0 = returnFunc(ctx, transactionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.LedgerEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, transactionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLedgerRepository_FindByTransactionID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByTransactionID'
+type MockLedgerRepository_FindByTransactionID_Call struct {
+	*mock.Call
+}
+
+// FindByTransactionID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionID uuid.UUID
+func (_e *MockLedgerRepository_Expecter) FindByTransactionID(ctx interface{}, transactionID interface{}) *MockLedgerRepository_FindByTransactionID_Call {
+	return &MockLedgerRepository_FindByTransactionID_Call{Call: _e.mock.On("FindByTransactionID", ctx, transactionID)}
+}
+
+func (_c *MockLedgerRepository_FindByTransactionID_Call) Run(run func(ctx context.Context, transactionID uuid.UUID)) *MockLedgerRepository_FindByTransactionID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_FindByTransactionID_Call) Return(ledgerEntrys []domain.LedgerEntry, err error) *MockLedgerRepository_FindByTransactionID_Call {
+	_c.Call.Return(ledgerEntrys, err)
+	return _c
+}
+
+func (_c *MockLedgerRepository_FindByTransactionID_Call) RunAndReturn(run func(ctx context.Context, transactionID uuid.UUID) ([]domain.LedgerEntry, error)) *MockLedgerRepository_FindByTransactionID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPaginated provides a mock function for the type MockLedgerRepository
+func (_mock *MockLedgerRepository) FindPaginated(ctx context.Context, spec repository.Specification[domain.LedgerEntry], req repository.PaginationRequest) (*repository.PaginationResponse[domain.LedgerEntry], error) {
+	ret := _mock.Called(ctx, spec, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPaginated")
+	}
+
+	var r0 *repository.PaginationResponse[domain.LedgerEntry]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.LedgerEntry], repository.PaginationRequest) (*repository.PaginationResponse[domain.LedgerEntry], error)); ok {
+		return returnFunc(ctx, spec, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.LedgerEntry], repository.PaginationRequest) *repository.PaginationResponse[domain.LedgerEntry]); ok {
+		r0 = returnFunc(ctx, spec, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.PaginationResponse[domain.LedgerEntry])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.Specification[domain.LedgerEntry], repository.PaginationRequest) error); ok {
+		r1 = returnFunc(ctx, spec, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLedgerRepository_FindPaginated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPaginated'
+type MockLedgerRepository_FindPaginated_Call struct {
+	*mock.Call
+}
+
+// FindPaginated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec repository.Specification[domain.LedgerEntry]
+//   - req repository.PaginationRequest
+func (_e *MockLedgerRepository_Expecter) FindPaginated(ctx interface{}, spec interface{}, req interface{}) *MockLedgerRepository_FindPaginated_Call {
+	return &MockLedgerRepository_FindPaginated_Call{Call: _e.mock.On("FindPaginated", ctx, spec, req)}
+}
+
+func (_c *MockLedgerRepository_FindPaginated_Call) Run(run func(ctx context.Context, spec repository.Specification[domain.LedgerEntry], req repository.PaginationRequest)) *MockLedgerRepository_FindPaginated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.Specification[domain.LedgerEntry]
+		if args[1] != nil {
+			arg1 = args[1].(repository.Specification[domain.LedgerEntry])
+		}
+		var arg2 repository.PaginationRequest
+		if args[2] != nil {
+			arg2 = args[2].(repository.PaginationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_FindPaginated_Call) Return(paginationResponse *repository.PaginationResponse[domain.LedgerEntry], err error) *MockLedgerRepository_FindPaginated_Call {
+	_c.Call.Return(paginationResponse, err)
+	return _c
+}
+
+func (_c *MockLedgerRepository_FindPaginated_Call) RunAndReturn(run func(ctx context.Context, spec repository.Specification[domain.LedgerEntry], req repository.PaginationRequest) (*repository.PaginationResponse[domain.LedgerEntry], error)) *MockLedgerRepository_FindPaginated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function for the type MockLedgerRepository
+func (_mock *MockLedgerRepository) GetAll(ctx context.Context) ([]domain.LedgerEntry, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []domain.LedgerEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.LedgerEntry, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.LedgerEntry); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.LedgerEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLedgerRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type MockLedgerRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockLedgerRepository_Expecter) GetAll(ctx interface{}) *MockLedgerRepository_GetAll_Call {
+	return &MockLedgerRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *MockLedgerRepository_GetAll_Call) Run(run func(ctx context.Context)) *MockLedgerRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_GetAll_Call) Return(ledgerEntrys []domain.LedgerEntry, err error) *MockLedgerRepository_GetAll_Call {
+	_c.Call.Return(ledgerEntrys, err)
+	return _c
+}
+
+func (_c *MockLedgerRepository_GetAll_Call) RunAndReturn(run func(ctx context.Context) ([]domain.LedgerEntry, error)) *MockLedgerRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockLedgerRepository
+func (_mock *MockLedgerRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.LedgerEntry, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.LedgerEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.LedgerEntry, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.LedgerEntry); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LedgerEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLedgerRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockLedgerRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockLedgerRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockLedgerRepository_GetByID_Call {
+	return &MockLedgerRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockLedgerRepository_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockLedgerRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_GetByID_Call) Return(ledgerEntry *domain.LedgerEntry, err error) *MockLedgerRepository_GetByID_Call {
+	_c.Call.Return(ledgerEntry, err)
+	return _c
+}
+
+func (_c *MockLedgerRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.LedgerEntry, error)) *MockLedgerRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDForUpdate provides a mock function for the type MockLedgerRepository
+func (_mock *MockLedgerRepository) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*domain.LedgerEntry, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDForUpdate")
+	}
+
+	var r0 *domain.LedgerEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.LedgerEntry, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.LedgerEntry); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LedgerEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLedgerRepository_GetByIDForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDForUpdate'
+type MockLedgerRepository_GetByIDForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetByIDForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockLedgerRepository_Expecter) GetByIDForUpdate(ctx interface{}, id interface{}) *MockLedgerRepository_GetByIDForUpdate_Call {
+	return &MockLedgerRepository_GetByIDForUpdate_Call{Call: _e.mock.On("GetByIDForUpdate", ctx, id)}
+}
+
+func (_c *MockLedgerRepository_GetByIDForUpdate_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockLedgerRepository_GetByIDForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_GetByIDForUpdate_Call) Return(ledgerEntry *domain.LedgerEntry, err error) *MockLedgerRepository_GetByIDForUpdate_Call {
+	_c.Call.Return(ledgerEntry, err)
+	return _c
+}
+
+func (_c *MockLedgerRepository_GetByIDForUpdate_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.LedgerEntry, error)) *MockLedgerRepository_GetByIDForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPaginated provides a mock function for the type MockLedgerRepository
+func (_mock *MockLedgerRepository) GetPaginated(ctx context.Context, req repository.PaginationRequest) (*repository.PaginationResponse[domain.LedgerEntry], error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaginated")
+	}
+
+	var r0 *repository.PaginationResponse[domain.LedgerEntry]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.PaginationRequest) (*repository.PaginationResponse[domain.LedgerEntry], error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.PaginationRequest) *repository.PaginationResponse[domain.LedgerEntry]); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.PaginationResponse[domain.LedgerEntry])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.PaginationRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLedgerRepository_GetPaginated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaginated'
+type MockLedgerRepository_GetPaginated_Call struct {
+	*mock.Call
+}
+
+// GetPaginated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req repository.PaginationRequest
+func (_e *MockLedgerRepository_Expecter) GetPaginated(ctx interface{}, req interface{}) *MockLedgerRepository_GetPaginated_Call {
+	return &MockLedgerRepository_GetPaginated_Call{Call: _e.mock.On("GetPaginated", ctx, req)}
+}
+
+func (_c *MockLedgerRepository_GetPaginated_Call) Run(run func(ctx context.Context, req repository.PaginationRequest)) *MockLedgerRepository_GetPaginated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.PaginationRequest
+		if args[1] != nil {
+			arg1 = args[1].(repository.PaginationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_GetPaginated_Call) Return(paginationResponse *repository.PaginationResponse[domain.LedgerEntry], err error) *MockLedgerRepository_GetPaginated_Call {
+	_c.Call.Return(paginationResponse, err)
+	return _c
+}
+
+func (_c *MockLedgerRepository_GetPaginated_Call) RunAndReturn(run func(ctx context.Context, req repository.PaginationRequest) (*repository.PaginationResponse[domain.LedgerEntry], error)) *MockLedgerRepository_GetPaginated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SumByAccountID provides a mock function for the type MockLedgerRepository
+func (_mock *MockLedgerRepository) SumByAccountID(ctx context.Context, accountID uuid.UUID, currency domain.Currency) (domain.Money, error) {
+	ret := _mock.Called(ctx, accountID, currency)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SumByAccountID")
+	}
+
+	var r0 domain.Money
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, domain.Currency) (domain.Money, error)); ok {
+		return returnFunc(ctx, accountID, currency)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, domain.Currency) domain.Money); ok {
+		r0 = returnFunc(ctx, accountID, currency)
+	} else {
+		r0 = ret.Get(0).(domain.Money)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, domain.Currency) error); ok {
+		r1 = returnFunc(ctx, accountID, currency)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLedgerRepository_SumByAccountID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SumByAccountID'
+type MockLedgerRepository_SumByAccountID_Call struct {
+	*mock.Call
+}
+
+// SumByAccountID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accountID uuid.UUID
+//   - currency domain.Currency
+func (_e *MockLedgerRepository_Expecter) SumByAccountID(ctx interface{}, accountID interface{}, currency interface{}) *MockLedgerRepository_SumByAccountID_Call {
+	return &MockLedgerRepository_SumByAccountID_Call{Call: _e.mock.On("SumByAccountID", ctx, accountID, currency)}
+}
+
+func (_c *MockLedgerRepository_SumByAccountID_Call) Run(run func(ctx context.Context, accountID uuid.UUID, currency domain.Currency)) *MockLedgerRepository_SumByAccountID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 domain.Currency
+		if args[2] != nil {
+			arg2 = args[2].(domain.Currency)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_SumByAccountID_Call) Return(money domain.Money, err error) *MockLedgerRepository_SumByAccountID_Call {
+	_c.Call.Return(money, err)
+	return _c
+}
+
+func (_c *MockLedgerRepository_SumByAccountID_Call) RunAndReturn(run func(ctx context.Context, accountID uuid.UUID, currency domain.Currency) (domain.Money, error)) *MockLedgerRepository_SumByAccountID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockLedgerRepository
+func (_mock *MockLedgerRepository) Update(ctx context.Context, entity *domain.LedgerEntry) error {
+	ret := _mock.Called(ctx, entity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.LedgerEntry) error); ok {
+		r0 = returnFunc(ctx, entity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLedgerRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockLedgerRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entity *domain.LedgerEntry
+func (_e *MockLedgerRepository_Expecter) Update(ctx interface{}, entity interface{}) *MockLedgerRepository_Update_Call {
+	return &MockLedgerRepository_Update_Call{Call: _e.mock.On("Update", ctx, entity)}
+}
+
+func (_c *MockLedgerRepository_Update_Call) Run(run func(ctx context.Context, entity *domain.LedgerEntry)) *MockLedgerRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.LedgerEntry
+		if args[1] != nil {
+			arg1 = args[1].(*domain.LedgerEntry)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_Update_Call) Return(err error) *MockLedgerRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLedgerRepository_Update_Call) RunAndReturn(run func(ctx context.Context, entity *domain.LedgerEntry) error) *MockLedgerRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
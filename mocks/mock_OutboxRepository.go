@@ -0,0 +1,701 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockOutboxRepository creates a new instance of MockOutboxRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOutboxRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOutboxRepository {
+	mock := &MockOutboxRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOutboxRepository is an autogenerated mock type for the OutboxRepository type
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+type MockOutboxRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOutboxRepository) EXPECT() *MockOutboxRepository_Expecter {
+	return &MockOutboxRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockOutboxRepository
+func (_mock *MockOutboxRepository) Create(ctx context.Context, entity *domain.OutboxEvent) error {
+	ret := _mock.Called(ctx, entity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.OutboxEvent) error); ok {
+		r0 = returnFunc(ctx, entity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOutboxRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockOutboxRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entity *domain.OutboxEvent
+func (_e *MockOutboxRepository_Expecter) Create(ctx interface{}, entity interface{}) *MockOutboxRepository_Create_Call {
+	return &MockOutboxRepository_Create_Call{Call: _e.mock.On("Create", ctx, entity)}
+}
+
+func (_c *MockOutboxRepository_Create_Call) Run(run func(ctx context.Context, entity *domain.OutboxEvent)) *MockOutboxRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.OutboxEvent
+		if args[1] != nil {
+			arg1 = args[1].(*domain.OutboxEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutboxRepository_Create_Call) Return(err error) *MockOutboxRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOutboxRepository_Create_Call) RunAndReturn(run func(ctx context.Context, entity *domain.OutboxEvent) error) *MockOutboxRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockOutboxRepository
+func (_mock *MockOutboxRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOutboxRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockOutboxRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockOutboxRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockOutboxRepository_Delete_Call {
+	return &MockOutboxRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockOutboxRepository_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockOutboxRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutboxRepository_Delete_Call) Return(err error) *MockOutboxRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOutboxRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockOutboxRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function for the type MockOutboxRepository
+func (_mock *MockOutboxRepository) FindAll(ctx context.Context, spec repository.Specification[domain.OutboxEvent]) ([]domain.OutboxEvent, error) {
+	ret := _mock.Called(ctx, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []domain.OutboxEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.OutboxEvent]) ([]domain.OutboxEvent, error)); ok {
+		return returnFunc(ctx, spec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.OutboxEvent]) []domain.OutboxEvent); ok {
+		r0 = returnFunc(ctx, spec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.OutboxEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.Specification[domain.OutboxEvent]) error); ok {
+		r1 = returnFunc(ctx, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOutboxRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockOutboxRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec repository.Specification[domain.OutboxEvent]
+func (_e *MockOutboxRepository_Expecter) FindAll(ctx interface{}, spec interface{}) *MockOutboxRepository_FindAll_Call {
+	return &MockOutboxRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx, spec)}
+}
+
+func (_c *MockOutboxRepository_FindAll_Call) Run(run func(ctx context.Context, spec repository.Specification[domain.OutboxEvent])) *MockOutboxRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.Specification[domain.OutboxEvent]
+		if args[1] != nil {
+			arg1 = args[1].(repository.Specification[domain.OutboxEvent])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutboxRepository_FindAll_Call) Return(outboxEvents []domain.OutboxEvent, err error) *MockOutboxRepository_FindAll_Call {
+	_c.Call.Return(outboxEvents, err)
+	return _c
+}
+
+func (_c *MockOutboxRepository_FindAll_Call) RunAndReturn(run func(ctx context.Context, spec repository.Specification[domain.OutboxEvent]) ([]domain.OutboxEvent, error)) *MockOutboxRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPaginated provides a mock function for the type MockOutboxRepository
+func (_mock *MockOutboxRepository) FindPaginated(ctx context.Context, spec repository.Specification[domain.OutboxEvent], req repository.PaginationRequest) (*repository.PaginationResponse[domain.OutboxEvent], error) {
+	ret := _mock.Called(ctx, spec, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPaginated")
+	}
+
+	var r0 *repository.PaginationResponse[domain.OutboxEvent]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.OutboxEvent], repository.PaginationRequest) (*repository.PaginationResponse[domain.OutboxEvent], error)); ok {
+		return returnFunc(ctx, spec, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.OutboxEvent], repository.PaginationRequest) *repository.PaginationResponse[domain.OutboxEvent]); ok {
+		r0 = returnFunc(ctx, spec, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.PaginationResponse[domain.OutboxEvent])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.Specification[domain.OutboxEvent], repository.PaginationRequest) error); ok {
+		r1 = returnFunc(ctx, spec, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOutboxRepository_FindPaginated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPaginated'
+type MockOutboxRepository_FindPaginated_Call struct {
+	*mock.Call
+}
+
+// FindPaginated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec repository.Specification[domain.OutboxEvent]
+//   - req repository.PaginationRequest
+func (_e *MockOutboxRepository_Expecter) FindPaginated(ctx interface{}, spec interface{}, req interface{}) *MockOutboxRepository_FindPaginated_Call {
+	return &MockOutboxRepository_FindPaginated_Call{Call: _e.mock.On("FindPaginated", ctx, spec, req)}
+}
+
+func (_c *MockOutboxRepository_FindPaginated_Call) Run(run func(ctx context.Context, spec repository.Specification[domain.OutboxEvent], req repository.PaginationRequest)) *MockOutboxRepository_FindPaginated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.Specification[domain.OutboxEvent]
+		if args[1] != nil {
+			arg1 = args[1].(repository.Specification[domain.OutboxEvent])
+		}
+		var arg2 repository.PaginationRequest
+		if args[2] != nil {
+			arg2 = args[2].(repository.PaginationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutboxRepository_FindPaginated_Call) Return(paginationResponse *repository.PaginationResponse[domain.OutboxEvent], err error) *MockOutboxRepository_FindPaginated_Call {
+	_c.Call.Return(paginationResponse, err)
+	return _c
+}
+
+func (_c *MockOutboxRepository_FindPaginated_Call) RunAndReturn(run func(ctx context.Context, spec repository.Specification[domain.OutboxEvent], req repository.PaginationRequest) (*repository.PaginationResponse[domain.OutboxEvent], error)) *MockOutboxRepository_FindPaginated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function for the type MockOutboxRepository
+func (_mock *MockOutboxRepository) GetAll(ctx context.Context) ([]domain.OutboxEvent, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []domain.OutboxEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.OutboxEvent, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.OutboxEvent); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.OutboxEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOutboxRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type MockOutboxRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockOutboxRepository_Expecter) GetAll(ctx interface{}) *MockOutboxRepository_GetAll_Call {
+	return &MockOutboxRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *MockOutboxRepository_GetAll_Call) Run(run func(ctx context.Context)) *MockOutboxRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutboxRepository_GetAll_Call) Return(outboxEvents []domain.OutboxEvent, err error) *MockOutboxRepository_GetAll_Call {
+	_c.Call.Return(outboxEvents, err)
+	return _c
+}
+
+func (_c *MockOutboxRepository_GetAll_Call) RunAndReturn(run func(ctx context.Context) ([]domain.OutboxEvent, error)) *MockOutboxRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockOutboxRepository
+func (_mock *MockOutboxRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.OutboxEvent, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.OutboxEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.OutboxEvent, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.OutboxEvent); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.OutboxEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOutboxRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockOutboxRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockOutboxRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockOutboxRepository_GetByID_Call {
+	return &MockOutboxRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockOutboxRepository_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockOutboxRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutboxRepository_GetByID_Call) Return(outboxEvent *domain.OutboxEvent, err error) *MockOutboxRepository_GetByID_Call {
+	_c.Call.Return(outboxEvent, err)
+	return _c
+}
+
+func (_c *MockOutboxRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.OutboxEvent, error)) *MockOutboxRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDForUpdate provides a mock function for the type MockOutboxRepository
+func (_mock *MockOutboxRepository) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*domain.OutboxEvent, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDForUpdate")
+	}
+
+	var r0 *domain.OutboxEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.OutboxEvent, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.OutboxEvent); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.OutboxEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOutboxRepository_GetByIDForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDForUpdate'
+type MockOutboxRepository_GetByIDForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetByIDForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockOutboxRepository_Expecter) GetByIDForUpdate(ctx interface{}, id interface{}) *MockOutboxRepository_GetByIDForUpdate_Call {
+	return &MockOutboxRepository_GetByIDForUpdate_Call{Call: _e.mock.On("GetByIDForUpdate", ctx, id)}
+}
+
+func (_c *MockOutboxRepository_GetByIDForUpdate_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockOutboxRepository_GetByIDForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutboxRepository_GetByIDForUpdate_Call) Return(outboxEvent *domain.OutboxEvent, err error) *MockOutboxRepository_GetByIDForUpdate_Call {
+	_c.Call.Return(outboxEvent, err)
+	return _c
+}
+
+func (_c *MockOutboxRepository_GetByIDForUpdate_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.OutboxEvent, error)) *MockOutboxRepository_GetByIDForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPaginated provides a mock function for the type MockOutboxRepository
+func (_mock *MockOutboxRepository) GetPaginated(ctx context.Context, req repository.PaginationRequest) (*repository.PaginationResponse[domain.OutboxEvent], error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaginated")
+	}
+
+	var r0 *repository.PaginationResponse[domain.OutboxEvent]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.PaginationRequest) (*repository.PaginationResponse[domain.OutboxEvent], error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.PaginationRequest) *repository.PaginationResponse[domain.OutboxEvent]); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.PaginationResponse[domain.OutboxEvent])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.PaginationRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOutboxRepository_GetPaginated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaginated'
+type MockOutboxRepository_GetPaginated_Call struct {
+	*mock.Call
+}
+
+// GetPaginated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req repository.PaginationRequest
+func (_e *MockOutboxRepository_Expecter) GetPaginated(ctx interface{}, req interface{}) *MockOutboxRepository_GetPaginated_Call {
+	return &MockOutboxRepository_GetPaginated_Call{Call: _e.mock.On("GetPaginated", ctx, req)}
+}
+
+func (_c *MockOutboxRepository_GetPaginated_Call) Run(run func(ctx context.Context, req repository.PaginationRequest)) *MockOutboxRepository_GetPaginated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.PaginationRequest
+		if args[1] != nil {
+			arg1 = args[1].(repository.PaginationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutboxRepository_GetPaginated_Call) Return(paginationResponse *repository.PaginationResponse[domain.OutboxEvent], err error) *MockOutboxRepository_GetPaginated_Call {
+	_c.Call.Return(paginationResponse, err)
+	return _c
+}
+
+func (_c *MockOutboxRepository_GetPaginated_Call) RunAndReturn(run func(ctx context.Context, req repository.PaginationRequest) (*repository.PaginationResponse[domain.OutboxEvent], error)) *MockOutboxRepository_GetPaginated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockDue provides a mock function for the type MockOutboxRepository
+func (_mock *MockOutboxRepository) LockDue(ctx context.Context, now time.Time, maxAttempts int, limit int) ([]domain.OutboxEvent, error) {
+	ret := _mock.Called(ctx, now, maxAttempts, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockDue")
+	}
+
+	var r0 []domain.OutboxEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int, int) ([]domain.OutboxEvent, error)); ok {
+		return returnFunc(ctx, now, maxAttempts, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int, int) []domain.OutboxEvent); ok {
+		r0 = returnFunc(ctx, now, maxAttempts, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.OutboxEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, int, int) error); ok {
+		r1 = returnFunc(ctx, now, maxAttempts, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOutboxRepository_LockDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockDue'
+type MockOutboxRepository_LockDue_Call struct {
+	*mock.Call
+}
+
+// LockDue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - maxAttempts int
+//   - limit int
+func (_e *MockOutboxRepository_Expecter) LockDue(ctx interface{}, now interface{}, maxAttempts interface{}, limit interface{}) *MockOutboxRepository_LockDue_Call {
+	return &MockOutboxRepository_LockDue_Call{Call: _e.mock.On("LockDue", ctx, now, maxAttempts, limit)}
+}
+
+func (_c *MockOutboxRepository_LockDue_Call) Run(run func(ctx context.Context, now time.Time, maxAttempts int, limit int)) *MockOutboxRepository_LockDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutboxRepository_LockDue_Call) Return(outboxEvents []domain.OutboxEvent, err error) *MockOutboxRepository_LockDue_Call {
+	_c.Call.Return(outboxEvents, err)
+	return _c
+}
+
+func (_c *MockOutboxRepository_LockDue_Call) RunAndReturn(run func(ctx context.Context, now time.Time, maxAttempts int, limit int) ([]domain.OutboxEvent, error)) *MockOutboxRepository_LockDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockOutboxRepository
+func (_mock *MockOutboxRepository) Update(ctx context.Context, entity *domain.OutboxEvent) error {
+	ret := _mock.Called(ctx, entity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.OutboxEvent) error); ok {
+		r0 = returnFunc(ctx, entity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOutboxRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockOutboxRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entity *domain.OutboxEvent
+func (_e *MockOutboxRepository_Expecter) Update(ctx interface{}, entity interface{}) *MockOutboxRepository_Update_Call {
+	return &MockOutboxRepository_Update_Call{Call: _e.mock.On("Update", ctx, entity)}
+}
+
+func (_c *MockOutboxRepository_Update_Call) Run(run func(ctx context.Context, entity *domain.OutboxEvent)) *MockOutboxRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.OutboxEvent
+		if args[1] != nil {
+			arg1 = args[1].(*domain.OutboxEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutboxRepository_Update_Call) Return(err error) *MockOutboxRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOutboxRepository_Update_Call) RunAndReturn(run func(ctx context.Context, entity *domain.OutboxEvent) error) *MockOutboxRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -152,6 +152,148 @@ func (_c *MockRepository_Delete_Call[T, TKey]) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// FindAll provides a mock function for the type MockRepository
+func (_mock *MockRepository[T, TKey]) FindAll(ctx context.Context, spec repository.Specification[T]) ([]T, error) {
+	ret := _mock.Called(ctx, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []T
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[T]) ([]T, error)); ok {
+		return returnFunc(ctx, spec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[T]) []T); ok {
+		r0 = returnFunc(ctx, spec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]T)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.Specification[T]) error); ok {
+		r1 = returnFunc(ctx, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockRepository_FindAll_Call[T any, TKey any] struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec repository.Specification[T]
+func (_e *MockRepository_Expecter[T, TKey]) FindAll(ctx interface{}, spec interface{}) *MockRepository_FindAll_Call[T, TKey] {
+	return &MockRepository_FindAll_Call[T, TKey]{Call: _e.mock.On("FindAll", ctx, spec)}
+}
+
+func (_c *MockRepository_FindAll_Call[T, TKey]) Run(run func(ctx context.Context, spec repository.Specification[T])) *MockRepository_FindAll_Call[T, TKey] {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.Specification[T]
+		if args[1] != nil {
+			arg1 = args[1].(repository.Specification[T])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindAll_Call[T, TKey]) Return(vs []T, err error) *MockRepository_FindAll_Call[T, TKey] {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockRepository_FindAll_Call[T, TKey]) RunAndReturn(run func(ctx context.Context, spec repository.Specification[T]) ([]T, error)) *MockRepository_FindAll_Call[T, TKey] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPaginated provides a mock function for the type MockRepository
+func (_mock *MockRepository[T, TKey]) FindPaginated(ctx context.Context, spec repository.Specification[T], req repository.PaginationRequest) (*repository.PaginationResponse[T], error) {
+	ret := _mock.Called(ctx, spec, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPaginated")
+	}
+
+	var r0 *repository.PaginationResponse[T]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[T], repository.PaginationRequest) (*repository.PaginationResponse[T], error)); ok {
+		return returnFunc(ctx, spec, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[T], repository.PaginationRequest) *repository.PaginationResponse[T]); ok {
+		r0 = returnFunc(ctx, spec, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.PaginationResponse[T])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.Specification[T], repository.PaginationRequest) error); ok {
+		r1 = returnFunc(ctx, spec, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindPaginated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPaginated'
+type MockRepository_FindPaginated_Call[T any, TKey any] struct {
+	*mock.Call
+}
+
+// FindPaginated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec repository.Specification[T]
+//   - req repository.PaginationRequest
+func (_e *MockRepository_Expecter[T, TKey]) FindPaginated(ctx interface{}, spec interface{}, req interface{}) *MockRepository_FindPaginated_Call[T, TKey] {
+	return &MockRepository_FindPaginated_Call[T, TKey]{Call: _e.mock.On("FindPaginated", ctx, spec, req)}
+}
+
+func (_c *MockRepository_FindPaginated_Call[T, TKey]) Run(run func(ctx context.Context, spec repository.Specification[T], req repository.PaginationRequest)) *MockRepository_FindPaginated_Call[T, TKey] {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.Specification[T]
+		if args[1] != nil {
+			arg1 = args[1].(repository.Specification[T])
+		}
+		var arg2 repository.PaginationRequest
+		if args[2] != nil {
+			arg2 = args[2].(repository.PaginationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindPaginated_Call[T, TKey]) Return(paginationResponse *repository.PaginationResponse[T], err error) *MockRepository_FindPaginated_Call[T, TKey] {
+	_c.Call.Return(paginationResponse, err)
+	return _c
+}
+
+func (_c *MockRepository_FindPaginated_Call[T, TKey]) RunAndReturn(run func(ctx context.Context, spec repository.Specification[T], req repository.PaginationRequest) (*repository.PaginationResponse[T], error)) *MockRepository_FindPaginated_Call[T, TKey] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAll provides a mock function for the type MockRepository
 func (_mock *MockRepository[T, TKey]) GetAll(ctx context.Context) ([]T, error) {
 	ret := _mock.Called(ctx)
@@ -282,6 +424,74 @@ func (_c *MockRepository_GetByID_Call[T, TKey]) RunAndReturn(run func(ctx contex
 	return _c
 }
 
+// GetByIDForUpdate provides a mock function for the type MockRepository
+func (_mock *MockRepository[T, TKey]) GetByIDForUpdate(ctx context.Context, id TKey) (*T, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDForUpdate")
+	}
+
+	var r0 *T
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TKey) (*T, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TKey) *T); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*T)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, TKey) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_GetByIDForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDForUpdate'
+type MockRepository_GetByIDForUpdate_Call[T any, TKey any] struct {
+	*mock.Call
+}
+
+// GetByIDForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id TKey
+func (_e *MockRepository_Expecter[T, TKey]) GetByIDForUpdate(ctx interface{}, id interface{}) *MockRepository_GetByIDForUpdate_Call[T, TKey] {
+	return &MockRepository_GetByIDForUpdate_Call[T, TKey]{Call: _e.mock.On("GetByIDForUpdate", ctx, id)}
+}
+
+func (_c *MockRepository_GetByIDForUpdate_Call[T, TKey]) Run(run func(ctx context.Context, id TKey)) *MockRepository_GetByIDForUpdate_Call[T, TKey] {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 TKey
+		if args[1] != nil {
+			arg1 = args[1].(TKey)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetByIDForUpdate_Call[T, TKey]) Return(v *T, err error) *MockRepository_GetByIDForUpdate_Call[T, TKey] {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockRepository_GetByIDForUpdate_Call[T, TKey]) RunAndReturn(run func(ctx context.Context, id TKey) (*T, error)) *MockRepository_GetByIDForUpdate_Call[T, TKey] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPaginated provides a mock function for the type MockRepository
 func (_mock *MockRepository[T, TKey]) GetPaginated(ctx context.Context, req repository.PaginationRequest) (*repository.PaginationResponse[T], error) {
 	ret := _mock.Called(ctx, req)
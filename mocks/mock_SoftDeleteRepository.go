@@ -0,0 +1,232 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockSoftDeleteRepository creates a new instance of MockSoftDeleteRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSoftDeleteRepository[T any, TKey any](t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSoftDeleteRepository[T, TKey] {
+	mock := &MockSoftDeleteRepository[T, TKey]{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSoftDeleteRepository is an autogenerated mock type for the SoftDeleteRepository type
+type MockSoftDeleteRepository[T any, TKey any] struct {
+	mock.Mock
+}
+
+type MockSoftDeleteRepository_Expecter[T any, TKey any] struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSoftDeleteRepository[T, TKey]) EXPECT() *MockSoftDeleteRepository_Expecter[T, TKey] {
+	return &MockSoftDeleteRepository_Expecter[T, TKey]{mock: &_m.Mock}
+}
+
+// GetByIDWithDeleted provides a mock function for the type MockSoftDeleteRepository
+func (_mock *MockSoftDeleteRepository[T, TKey]) GetByIDWithDeleted(ctx context.Context, id TKey) (*T, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDWithDeleted")
+	}
+
+	var r0 *T
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TKey) (*T, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TKey) *T); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*T)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, TKey) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSoftDeleteRepository_GetByIDWithDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDWithDeleted'
+type MockSoftDeleteRepository_GetByIDWithDeleted_Call[T any, TKey any] struct {
+	*mock.Call
+}
+
+// GetByIDWithDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id TKey
+func (_e *MockSoftDeleteRepository_Expecter[T, TKey]) GetByIDWithDeleted(ctx interface{}, id interface{}) *MockSoftDeleteRepository_GetByIDWithDeleted_Call[T, TKey] {
+	return &MockSoftDeleteRepository_GetByIDWithDeleted_Call[T, TKey]{Call: _e.mock.On("GetByIDWithDeleted", ctx, id)}
+}
+
+func (_c *MockSoftDeleteRepository_GetByIDWithDeleted_Call[T, TKey]) Run(run func(ctx context.Context, id TKey)) *MockSoftDeleteRepository_GetByIDWithDeleted_Call[T, TKey] {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 TKey
+		if args[1] != nil {
+			arg1 = args[1].(TKey)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSoftDeleteRepository_GetByIDWithDeleted_Call[T, TKey]) Return(v *T, err error) *MockSoftDeleteRepository_GetByIDWithDeleted_Call[T, TKey] {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockSoftDeleteRepository_GetByIDWithDeleted_Call[T, TKey]) RunAndReturn(run func(ctx context.Context, id TKey) (*T, error)) *MockSoftDeleteRepository_GetByIDWithDeleted_Call[T, TKey] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPaginatedWithDeleted provides a mock function for the type MockSoftDeleteRepository
+func (_mock *MockSoftDeleteRepository[T, TKey]) GetPaginatedWithDeleted(ctx context.Context, req repository.PaginationRequest) (*repository.PaginationResponse[T], error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaginatedWithDeleted")
+	}
+
+	var r0 *repository.PaginationResponse[T]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.PaginationRequest) (*repository.PaginationResponse[T], error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.PaginationRequest) *repository.PaginationResponse[T]); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.PaginationResponse[T])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.PaginationRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSoftDeleteRepository_GetPaginatedWithDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaginatedWithDeleted'
+type MockSoftDeleteRepository_GetPaginatedWithDeleted_Call[T any, TKey any] struct {
+	*mock.Call
+}
+
+// GetPaginatedWithDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req repository.PaginationRequest
+func (_e *MockSoftDeleteRepository_Expecter[T, TKey]) GetPaginatedWithDeleted(ctx interface{}, req interface{}) *MockSoftDeleteRepository_GetPaginatedWithDeleted_Call[T, TKey] {
+	return &MockSoftDeleteRepository_GetPaginatedWithDeleted_Call[T, TKey]{Call: _e.mock.On("GetPaginatedWithDeleted", ctx, req)}
+}
+
+func (_c *MockSoftDeleteRepository_GetPaginatedWithDeleted_Call[T, TKey]) Run(run func(ctx context.Context, req repository.PaginationRequest)) *MockSoftDeleteRepository_GetPaginatedWithDeleted_Call[T, TKey] {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.PaginationRequest
+		if args[1] != nil {
+			arg1 = args[1].(repository.PaginationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSoftDeleteRepository_GetPaginatedWithDeleted_Call[T, TKey]) Return(paginationResponse *repository.PaginationResponse[T], err error) *MockSoftDeleteRepository_GetPaginatedWithDeleted_Call[T, TKey] {
+	_c.Call.Return(paginationResponse, err)
+	return _c
+}
+
+func (_c *MockSoftDeleteRepository_GetPaginatedWithDeleted_Call[T, TKey]) RunAndReturn(run func(ctx context.Context, req repository.PaginationRequest) (*repository.PaginationResponse[T], error)) *MockSoftDeleteRepository_GetPaginatedWithDeleted_Call[T, TKey] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockSoftDeleteRepository
+func (_mock *MockSoftDeleteRepository[T, TKey]) Restore(ctx context.Context, id TKey) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TKey) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSoftDeleteRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockSoftDeleteRepository_Restore_Call[T any, TKey any] struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id TKey
+func (_e *MockSoftDeleteRepository_Expecter[T, TKey]) Restore(ctx interface{}, id interface{}) *MockSoftDeleteRepository_Restore_Call[T, TKey] {
+	return &MockSoftDeleteRepository_Restore_Call[T, TKey]{Call: _e.mock.On("Restore", ctx, id)}
+}
+
+func (_c *MockSoftDeleteRepository_Restore_Call[T, TKey]) Run(run func(ctx context.Context, id TKey)) *MockSoftDeleteRepository_Restore_Call[T, TKey] {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 TKey
+		if args[1] != nil {
+			arg1 = args[1].(TKey)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSoftDeleteRepository_Restore_Call[T, TKey]) Return(err error) *MockSoftDeleteRepository_Restore_Call[T, TKey] {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSoftDeleteRepository_Restore_Call[T, TKey]) RunAndReturn(run func(ctx context.Context, id TKey) error) *MockSoftDeleteRepository_Restore_Call[T, TKey] {
+	_c.Call.Return(run)
+	return _c
+}
@@ -41,6 +41,72 @@ func (_m *MockTransactionRepository) EXPECT() *MockTransactionRepository_Expecte
 	return &MockTransactionRepository_Expecter{mock: &_m.Mock}
 }
 
+// CountPendingByAccountID provides a mock function for the type MockTransactionRepository
+func (_mock *MockTransactionRepository) CountPendingByAccountID(ctx context.Context, accountID uuid.UUID) (int64, error) {
+	ret := _mock.Called(ctx, accountID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountPendingByAccountID")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return returnFunc(ctx, accountID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = returnFunc(ctx, accountID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, accountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTransactionRepository_CountPendingByAccountID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountPendingByAccountID'
+type MockTransactionRepository_CountPendingByAccountID_Call struct {
+	*mock.Call
+}
+
+// CountPendingByAccountID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accountID uuid.UUID
+func (_e *MockTransactionRepository_Expecter) CountPendingByAccountID(ctx interface{}, accountID interface{}) *MockTransactionRepository_CountPendingByAccountID_Call {
+	return &MockTransactionRepository_CountPendingByAccountID_Call{Call: _e.mock.On("CountPendingByAccountID", ctx, accountID)}
+}
+
+func (_c *MockTransactionRepository_CountPendingByAccountID_Call) Run(run func(ctx context.Context, accountID uuid.UUID)) *MockTransactionRepository_CountPendingByAccountID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_CountPendingByAccountID_Call) Return(n int64, err error) *MockTransactionRepository_CountPendingByAccountID_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockTransactionRepository_CountPendingByAccountID_Call) RunAndReturn(run func(ctx context.Context, accountID uuid.UUID) (int64, error)) *MockTransactionRepository_CountPendingByAccountID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockTransactionRepository
 func (_mock *MockTransactionRepository) Create(ctx context.Context, entity *domain.Transaction) error {
 	ret := _mock.Called(ctx, entity)
@@ -155,6 +221,74 @@ func (_c *MockTransactionRepository_Delete_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// FindAll provides a mock function for the type MockTransactionRepository
+func (_mock *MockTransactionRepository) FindAll(ctx context.Context, spec repository.Specification[domain.Transaction]) ([]domain.Transaction, error) {
+	ret := _mock.Called(ctx, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []domain.Transaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.Transaction]) ([]domain.Transaction, error)); ok {
+		return returnFunc(ctx, spec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.Transaction]) []domain.Transaction); ok {
+		r0 = returnFunc(ctx, spec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Transaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.Specification[domain.Transaction]) error); ok {
+		r1 = returnFunc(ctx, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTransactionRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockTransactionRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec repository.Specification[domain.Transaction]
+func (_e *MockTransactionRepository_Expecter) FindAll(ctx interface{}, spec interface{}) *MockTransactionRepository_FindAll_Call {
+	return &MockTransactionRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx, spec)}
+}
+
+func (_c *MockTransactionRepository_FindAll_Call) Run(run func(ctx context.Context, spec repository.Specification[domain.Transaction])) *MockTransactionRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.Specification[domain.Transaction]
+		if args[1] != nil {
+			arg1 = args[1].(repository.Specification[domain.Transaction])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_FindAll_Call) Return(transactions []domain.Transaction, err error) *MockTransactionRepository_FindAll_Call {
+	_c.Call.Return(transactions, err)
+	return _c
+}
+
+func (_c *MockTransactionRepository_FindAll_Call) RunAndReturn(run func(ctx context.Context, spec repository.Specification[domain.Transaction]) ([]domain.Transaction, error)) *MockTransactionRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByAccountID provides a mock function for the type MockTransactionRepository
 func (_mock *MockTransactionRepository) FindByAccountID(ctx context.Context, accountID uuid.UUID) ([]domain.Transaction, error) {
 	ret := _mock.Called(ctx, accountID)
@@ -371,86 +505,6 @@ func (_c *MockTransactionRepository_FindByDateRange_Call) RunAndReturn(run func(
 	return _c
 }
 
-// FindByDateRangePaginated provides a mock function for the type MockTransactionRepository
-func (_mock *MockTransactionRepository) FindByDateRangePaginated(ctx context.Context, from time.Time, to time.Time, req repository.PaginationRequest) (*repository.PaginationResponse[domain.Transaction], error) {
-	ret := _mock.Called(ctx, from, to, req)
-
-	if len(ret) == 0 {
-		panic("no return value specified for FindByDateRangePaginated")
-	}
-
-	var r0 *repository.PaginationResponse[domain.Transaction]
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, repository.PaginationRequest) (*repository.PaginationResponse[domain.Transaction], error)); ok {
-		return returnFunc(ctx, from, to, req)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, repository.PaginationRequest) *repository.PaginationResponse[domain.Transaction]); ok {
-		r0 = returnFunc(ctx, from, to, req)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*repository.PaginationResponse[domain.Transaction])
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, repository.PaginationRequest) error); ok {
-		r1 = returnFunc(ctx, from, to, req)
-	} else {
-		r1 = ret.Error(1)
-	}
-	return r0, r1
-}
-
-// MockTransactionRepository_FindByDateRangePaginated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByDateRangePaginated'
-type MockTransactionRepository_FindByDateRangePaginated_Call struct {
-	*mock.Call
-}
-
-// FindByDateRangePaginated is a helper method to define mock.On call
-//   - ctx context.Context
-//   - from time.Time
-//   - to time.Time
-//   - req repository.PaginationRequest
-func (_e *MockTransactionRepository_Expecter) FindByDateRangePaginated(ctx interface{}, from interface{}, to interface{}, req interface{}) *MockTransactionRepository_FindByDateRangePaginated_Call {
-	return &MockTransactionRepository_FindByDateRangePaginated_Call{Call: _e.mock.On("FindByDateRangePaginated", ctx, from, to, req)}
-}
-
-func (_c *MockTransactionRepository_FindByDateRangePaginated_Call) Run(run func(ctx context.Context, from time.Time, to time.Time, req repository.PaginationRequest)) *MockTransactionRepository_FindByDateRangePaginated_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 context.Context
-		if args[0] != nil {
-			arg0 = args[0].(context.Context)
-		}
-		var arg1 time.Time
-		if args[1] != nil {
-			arg1 = args[1].(time.Time)
-		}
-		var arg2 time.Time
-		if args[2] != nil {
-			arg2 = args[2].(time.Time)
-		}
-		var arg3 repository.PaginationRequest
-		if args[3] != nil {
-			arg3 = args[3].(repository.PaginationRequest)
-		}
-		run(
-			arg0,
-			arg1,
-			arg2,
-			arg3,
-		)
-	})
-	return _c
-}
-
-func (_c *MockTransactionRepository_FindByDateRangePaginated_Call) Return(paginationResponse *repository.PaginationResponse[domain.Transaction], err error) *MockTransactionRepository_FindByDateRangePaginated_Call {
-	_c.Call.Return(paginationResponse, err)
-	return _c
-}
-
-func (_c *MockTransactionRepository_FindByDateRangePaginated_Call) RunAndReturn(run func(ctx context.Context, from time.Time, to time.Time, req repository.PaginationRequest) (*repository.PaginationResponse[domain.Transaction], error)) *MockTransactionRepository_FindByDateRangePaginated_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
 // FindByReference provides a mock function for the type MockTransactionRepository
 func (_mock *MockTransactionRepository) FindByReference(ctx context.Context, reference string) (*domain.Transaction, error) {
 	ret := _mock.Called(ctx, reference)
@@ -587,80 +641,6 @@ func (_c *MockTransactionRepository_FindByStatus_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
-// FindByStatusPaginated provides a mock function for the type MockTransactionRepository
-func (_mock *MockTransactionRepository) FindByStatusPaginated(ctx context.Context, status domain.TransactionStatus, req repository.PaginationRequest) (*repository.PaginationResponse[domain.Transaction], error) {
-	ret := _mock.Called(ctx, status, req)
-
-	if len(ret) == 0 {
-		panic("no return value specified for FindByStatusPaginated")
-	}
-
-	var r0 *repository.PaginationResponse[domain.Transaction]
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.TransactionStatus, repository.PaginationRequest) (*repository.PaginationResponse[domain.Transaction], error)); ok {
-		return returnFunc(ctx, status, req)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.TransactionStatus, repository.PaginationRequest) *repository.PaginationResponse[domain.Transaction]); ok {
-		r0 = returnFunc(ctx, status, req)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*repository.PaginationResponse[domain.Transaction])
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.TransactionStatus, repository.PaginationRequest) error); ok {
-		r1 = returnFunc(ctx, status, req)
-	} else {
-		r1 = ret.Error(1)
-	}
-	return r0, r1
-}
-
-// MockTransactionRepository_FindByStatusPaginated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByStatusPaginated'
-type MockTransactionRepository_FindByStatusPaginated_Call struct {
-	*mock.Call
-}
-
-// FindByStatusPaginated is a helper method to define mock.On call
-//   - ctx context.Context
-//   - status domain.TransactionStatus
-//   - req repository.PaginationRequest
-func (_e *MockTransactionRepository_Expecter) FindByStatusPaginated(ctx interface{}, status interface{}, req interface{}) *MockTransactionRepository_FindByStatusPaginated_Call {
-	return &MockTransactionRepository_FindByStatusPaginated_Call{Call: _e.mock.On("FindByStatusPaginated", ctx, status, req)}
-}
-
-func (_c *MockTransactionRepository_FindByStatusPaginated_Call) Run(run func(ctx context.Context, status domain.TransactionStatus, req repository.PaginationRequest)) *MockTransactionRepository_FindByStatusPaginated_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 context.Context
-		if args[0] != nil {
-			arg0 = args[0].(context.Context)
-		}
-		var arg1 domain.TransactionStatus
-		if args[1] != nil {
-			arg1 = args[1].(domain.TransactionStatus)
-		}
-		var arg2 repository.PaginationRequest
-		if args[2] != nil {
-			arg2 = args[2].(repository.PaginationRequest)
-		}
-		run(
-			arg0,
-			arg1,
-			arg2,
-		)
-	})
-	return _c
-}
-
-func (_c *MockTransactionRepository_FindByStatusPaginated_Call) Return(paginationResponse *repository.PaginationResponse[domain.Transaction], err error) *MockTransactionRepository_FindByStatusPaginated_Call {
-	_c.Call.Return(paginationResponse, err)
-	return _c
-}
-
-func (_c *MockTransactionRepository_FindByStatusPaginated_Call) RunAndReturn(run func(ctx context.Context, status domain.TransactionStatus, req repository.PaginationRequest) (*repository.PaginationResponse[domain.Transaction], error)) *MockTransactionRepository_FindByStatusPaginated_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
 // FindByType provides a mock function for the type MockTransactionRepository
 func (_mock *MockTransactionRepository) FindByType(ctx context.Context, txType domain.TransactionType) ([]domain.Transaction, error) {
 	ret := _mock.Called(ctx, txType)
@@ -729,56 +709,56 @@ func (_c *MockTransactionRepository_FindByType_Call) RunAndReturn(run func(ctx c
 	return _c
 }
 
-// FindByTypePaginated provides a mock function for the type MockTransactionRepository
-func (_mock *MockTransactionRepository) FindByTypePaginated(ctx context.Context, txType domain.TransactionType, req repository.PaginationRequest) (*repository.PaginationResponse[domain.Transaction], error) {
-	ret := _mock.Called(ctx, txType, req)
+// FindPaginated provides a mock function for the type MockTransactionRepository
+func (_mock *MockTransactionRepository) FindPaginated(ctx context.Context, spec repository.Specification[domain.Transaction], req repository.PaginationRequest) (*repository.PaginationResponse[domain.Transaction], error) {
+	ret := _mock.Called(ctx, spec, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByTypePaginated")
+		panic("no return value specified for FindPaginated")
 	}
 
 	var r0 *repository.PaginationResponse[domain.Transaction]
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.TransactionType, repository.PaginationRequest) (*repository.PaginationResponse[domain.Transaction], error)); ok {
-		return returnFunc(ctx, txType, req)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.Transaction], repository.PaginationRequest) (*repository.PaginationResponse[domain.Transaction], error)); ok {
+		return returnFunc(ctx, spec, req)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.TransactionType, repository.PaginationRequest) *repository.PaginationResponse[domain.Transaction]); ok {
-		r0 = returnFunc(ctx, txType, req)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.Transaction], repository.PaginationRequest) *repository.PaginationResponse[domain.Transaction]); ok {
+		r0 = returnFunc(ctx, spec, req)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*repository.PaginationResponse[domain.Transaction])
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.TransactionType, repository.PaginationRequest) error); ok {
-		r1 = returnFunc(ctx, txType, req)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.Specification[domain.Transaction], repository.PaginationRequest) error); ok {
+		r1 = returnFunc(ctx, spec, req)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockTransactionRepository_FindByTypePaginated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByTypePaginated'
-type MockTransactionRepository_FindByTypePaginated_Call struct {
+// MockTransactionRepository_FindPaginated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPaginated'
+type MockTransactionRepository_FindPaginated_Call struct {
 	*mock.Call
 }
 
-// FindByTypePaginated is a helper method to define mock.On call
+// FindPaginated is a helper method to define mock.On call
 //   - ctx context.Context
-//   - txType domain.TransactionType
+//   - spec repository.Specification[domain.Transaction]
 //   - req repository.PaginationRequest
-func (_e *MockTransactionRepository_Expecter) FindByTypePaginated(ctx interface{}, txType interface{}, req interface{}) *MockTransactionRepository_FindByTypePaginated_Call {
-	return &MockTransactionRepository_FindByTypePaginated_Call{Call: _e.mock.On("FindByTypePaginated", ctx, txType, req)}
+func (_e *MockTransactionRepository_Expecter) FindPaginated(ctx interface{}, spec interface{}, req interface{}) *MockTransactionRepository_FindPaginated_Call {
+	return &MockTransactionRepository_FindPaginated_Call{Call: _e.mock.On("FindPaginated", ctx, spec, req)}
 }
 
-func (_c *MockTransactionRepository_FindByTypePaginated_Call) Run(run func(ctx context.Context, txType domain.TransactionType, req repository.PaginationRequest)) *MockTransactionRepository_FindByTypePaginated_Call {
+func (_c *MockTransactionRepository_FindPaginated_Call) Run(run func(ctx context.Context, spec repository.Specification[domain.Transaction], req repository.PaginationRequest)) *MockTransactionRepository_FindPaginated_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 domain.TransactionType
+		var arg1 repository.Specification[domain.Transaction]
 		if args[1] != nil {
-			arg1 = args[1].(domain.TransactionType)
+			arg1 = args[1].(repository.Specification[domain.Transaction])
 		}
 		var arg2 repository.PaginationRequest
 		if args[2] != nil {
@@ -793,12 +773,12 @@ func (_c *MockTransactionRepository_FindByTypePaginated_Call) Run(run func(ctx c
 	return _c
 }
 
-func (_c *MockTransactionRepository_FindByTypePaginated_Call) Return(paginationResponse *repository.PaginationResponse[domain.Transaction], err error) *MockTransactionRepository_FindByTypePaginated_Call {
+func (_c *MockTransactionRepository_FindPaginated_Call) Return(paginationResponse *repository.PaginationResponse[domain.Transaction], err error) *MockTransactionRepository_FindPaginated_Call {
 	_c.Call.Return(paginationResponse, err)
 	return _c
 }
 
-func (_c *MockTransactionRepository_FindByTypePaginated_Call) RunAndReturn(run func(ctx context.Context, txType domain.TransactionType, req repository.PaginationRequest) (*repository.PaginationResponse[domain.Transaction], error)) *MockTransactionRepository_FindByTypePaginated_Call {
+func (_c *MockTransactionRepository_FindPaginated_Call) RunAndReturn(run func(ctx context.Context, spec repository.Specification[domain.Transaction], req repository.PaginationRequest) (*repository.PaginationResponse[domain.Transaction], error)) *MockTransactionRepository_FindPaginated_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -933,6 +913,142 @@ func (_c *MockTransactionRepository_GetByID_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// GetByIDForUpdate provides a mock function for the type MockTransactionRepository
+func (_mock *MockTransactionRepository) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*domain.Transaction, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDForUpdate")
+	}
+
+	var r0 *domain.Transaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Transaction, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Transaction); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Transaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTransactionRepository_GetByIDForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDForUpdate'
+type MockTransactionRepository_GetByIDForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetByIDForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockTransactionRepository_Expecter) GetByIDForUpdate(ctx interface{}, id interface{}) *MockTransactionRepository_GetByIDForUpdate_Call {
+	return &MockTransactionRepository_GetByIDForUpdate_Call{Call: _e.mock.On("GetByIDForUpdate", ctx, id)}
+}
+
+func (_c *MockTransactionRepository_GetByIDForUpdate_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockTransactionRepository_GetByIDForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetByIDForUpdate_Call) Return(transaction *domain.Transaction, err error) *MockTransactionRepository_GetByIDForUpdate_Call {
+	_c.Call.Return(transaction, err)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetByIDForUpdate_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.Transaction, error)) *MockTransactionRepository_GetByIDForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDWithDeleted provides a mock function for the type MockTransactionRepository
+func (_mock *MockTransactionRepository) GetByIDWithDeleted(ctx context.Context, id uuid.UUID) (*domain.Transaction, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDWithDeleted")
+	}
+
+	var r0 *domain.Transaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Transaction, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Transaction); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Transaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTransactionRepository_GetByIDWithDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDWithDeleted'
+type MockTransactionRepository_GetByIDWithDeleted_Call struct {
+	*mock.Call
+}
+
+// GetByIDWithDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockTransactionRepository_Expecter) GetByIDWithDeleted(ctx interface{}, id interface{}) *MockTransactionRepository_GetByIDWithDeleted_Call {
+	return &MockTransactionRepository_GetByIDWithDeleted_Call{Call: _e.mock.On("GetByIDWithDeleted", ctx, id)}
+}
+
+func (_c *MockTransactionRepository_GetByIDWithDeleted_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockTransactionRepository_GetByIDWithDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetByIDWithDeleted_Call) Return(transaction *domain.Transaction, err error) *MockTransactionRepository_GetByIDWithDeleted_Call {
+	_c.Call.Return(transaction, err)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetByIDWithDeleted_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.Transaction, error)) *MockTransactionRepository_GetByIDWithDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPaginated provides a mock function for the type MockTransactionRepository
 func (_mock *MockTransactionRepository) GetPaginated(ctx context.Context, req repository.PaginationRequest) (*repository.PaginationResponse[domain.Transaction], error) {
 	ret := _mock.Called(ctx, req)
@@ -1001,6 +1117,131 @@ func (_c *MockTransactionRepository_GetPaginated_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// GetPaginatedWithDeleted provides a mock function for the type MockTransactionRepository
+func (_mock *MockTransactionRepository) GetPaginatedWithDeleted(ctx context.Context, req repository.PaginationRequest) (*repository.PaginationResponse[domain.Transaction], error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaginatedWithDeleted")
+	}
+
+	var r0 *repository.PaginationResponse[domain.Transaction]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.PaginationRequest) (*repository.PaginationResponse[domain.Transaction], error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.PaginationRequest) *repository.PaginationResponse[domain.Transaction]); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.PaginationResponse[domain.Transaction])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.PaginationRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTransactionRepository_GetPaginatedWithDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaginatedWithDeleted'
+type MockTransactionRepository_GetPaginatedWithDeleted_Call struct {
+	*mock.Call
+}
+
+// GetPaginatedWithDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req repository.PaginationRequest
+func (_e *MockTransactionRepository_Expecter) GetPaginatedWithDeleted(ctx interface{}, req interface{}) *MockTransactionRepository_GetPaginatedWithDeleted_Call {
+	return &MockTransactionRepository_GetPaginatedWithDeleted_Call{Call: _e.mock.On("GetPaginatedWithDeleted", ctx, req)}
+}
+
+func (_c *MockTransactionRepository_GetPaginatedWithDeleted_Call) Run(run func(ctx context.Context, req repository.PaginationRequest)) *MockTransactionRepository_GetPaginatedWithDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.PaginationRequest
+		if args[1] != nil {
+			arg1 = args[1].(repository.PaginationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetPaginatedWithDeleted_Call) Return(paginationResponse *repository.PaginationResponse[domain.Transaction], err error) *MockTransactionRepository_GetPaginatedWithDeleted_Call {
+	_c.Call.Return(paginationResponse, err)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetPaginatedWithDeleted_Call) RunAndReturn(run func(ctx context.Context, req repository.PaginationRequest) (*repository.PaginationResponse[domain.Transaction], error)) *MockTransactionRepository_GetPaginatedWithDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockTransactionRepository
+func (_mock *MockTransactionRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTransactionRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockTransactionRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockTransactionRepository_Expecter) Restore(ctx interface{}, id interface{}) *MockTransactionRepository_Restore_Call {
+	return &MockTransactionRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
+}
+
+func (_c *MockTransactionRepository_Restore_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockTransactionRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_Restore_Call) Return(err error) *MockTransactionRepository_Restore_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTransactionRepository_Restore_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockTransactionRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockTransactionRepository
 func (_mock *MockTransactionRepository) Update(ctx context.Context, entity *domain.Transaction) error {
 	ret := _mock.Called(ctx, entity)
@@ -0,0 +1,360 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"arise_tech_assessment/internal/infrastructure/repository"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockUnitOfWork creates a new instance of MockUnitOfWork. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUnitOfWork(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUnitOfWork {
+	mock := &MockUnitOfWork{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUnitOfWork is an autogenerated mock type for the UnitOfWork type
+type MockUnitOfWork struct {
+	mock.Mock
+}
+
+type MockUnitOfWork_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUnitOfWork) EXPECT() *MockUnitOfWork_Expecter {
+	return &MockUnitOfWork_Expecter{mock: &_m.Mock}
+}
+
+// Accounts provides a mock function for the type MockUnitOfWork
+func (_mock *MockUnitOfWork) Accounts() repository.AccountRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Accounts")
+	}
+
+	var r0 repository.AccountRepository
+	if returnFunc, ok := ret.Get(0).(func() repository.AccountRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.AccountRepository)
+		}
+	}
+	return r0
+}
+
+// MockUnitOfWork_Accounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Accounts'
+type MockUnitOfWork_Accounts_Call struct {
+	*mock.Call
+}
+
+// Accounts is a helper method to define mock.On call
+func (_e *MockUnitOfWork_Expecter) Accounts() *MockUnitOfWork_Accounts_Call {
+	return &MockUnitOfWork_Accounts_Call{Call: _e.mock.On("Accounts")}
+}
+
+func (_c *MockUnitOfWork_Accounts_Call) Run(run func()) *MockUnitOfWork_Accounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUnitOfWork_Accounts_Call) Return(accountRepository repository.AccountRepository) *MockUnitOfWork_Accounts_Call {
+	_c.Call.Return(accountRepository)
+	return _c
+}
+
+func (_c *MockUnitOfWork_Accounts_Call) RunAndReturn(run func() repository.AccountRepository) *MockUnitOfWork_Accounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExchangeRates provides a mock function for the type MockUnitOfWork
+func (_mock *MockUnitOfWork) ExchangeRates() repository.ExchangeRateRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExchangeRates")
+	}
+
+	var r0 repository.ExchangeRateRepository
+	if returnFunc, ok := ret.Get(0).(func() repository.ExchangeRateRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.ExchangeRateRepository)
+		}
+	}
+	return r0
+}
+
+// MockUnitOfWork_ExchangeRates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExchangeRates'
+type MockUnitOfWork_ExchangeRates_Call struct {
+	*mock.Call
+}
+
+// ExchangeRates is a helper method to define mock.On call
+func (_e *MockUnitOfWork_Expecter) ExchangeRates() *MockUnitOfWork_ExchangeRates_Call {
+	return &MockUnitOfWork_ExchangeRates_Call{Call: _e.mock.On("ExchangeRates")}
+}
+
+func (_c *MockUnitOfWork_ExchangeRates_Call) Run(run func()) *MockUnitOfWork_ExchangeRates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUnitOfWork_ExchangeRates_Call) Return(exchangeRateRepository repository.ExchangeRateRepository) *MockUnitOfWork_ExchangeRates_Call {
+	_c.Call.Return(exchangeRateRepository)
+	return _c
+}
+
+func (_c *MockUnitOfWork_ExchangeRates_Call) RunAndReturn(run func() repository.ExchangeRateRepository) *MockUnitOfWork_ExchangeRates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Ledger provides a mock function for the type MockUnitOfWork
+func (_mock *MockUnitOfWork) Ledger() repository.LedgerRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ledger")
+	}
+
+	var r0 repository.LedgerRepository
+	if returnFunc, ok := ret.Get(0).(func() repository.LedgerRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.LedgerRepository)
+		}
+	}
+	return r0
+}
+
+// MockUnitOfWork_Ledger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ledger'
+type MockUnitOfWork_Ledger_Call struct {
+	*mock.Call
+}
+
+// Ledger is a helper method to define mock.On call
+func (_e *MockUnitOfWork_Expecter) Ledger() *MockUnitOfWork_Ledger_Call {
+	return &MockUnitOfWork_Ledger_Call{Call: _e.mock.On("Ledger")}
+}
+
+func (_c *MockUnitOfWork_Ledger_Call) Run(run func()) *MockUnitOfWork_Ledger_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUnitOfWork_Ledger_Call) Return(ledgerRepository repository.LedgerRepository) *MockUnitOfWork_Ledger_Call {
+	_c.Call.Return(ledgerRepository)
+	return _c
+}
+
+func (_c *MockUnitOfWork_Ledger_Call) RunAndReturn(run func() repository.LedgerRepository) *MockUnitOfWork_Ledger_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Outbox provides a mock function for the type MockUnitOfWork
+func (_mock *MockUnitOfWork) Outbox() repository.OutboxRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Outbox")
+	}
+
+	var r0 repository.OutboxRepository
+	if returnFunc, ok := ret.Get(0).(func() repository.OutboxRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.OutboxRepository)
+		}
+	}
+	return r0
+}
+
+// MockUnitOfWork_Outbox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Outbox'
+type MockUnitOfWork_Outbox_Call struct {
+	*mock.Call
+}
+
+// Outbox is a helper method to define mock.On call
+func (_e *MockUnitOfWork_Expecter) Outbox() *MockUnitOfWork_Outbox_Call {
+	return &MockUnitOfWork_Outbox_Call{Call: _e.mock.On("Outbox")}
+}
+
+func (_c *MockUnitOfWork_Outbox_Call) Run(run func()) *MockUnitOfWork_Outbox_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUnitOfWork_Outbox_Call) Return(outboxRepository repository.OutboxRepository) *MockUnitOfWork_Outbox_Call {
+	_c.Call.Return(outboxRepository)
+	return _c
+}
+
+func (_c *MockUnitOfWork_Outbox_Call) RunAndReturn(run func() repository.OutboxRepository) *MockUnitOfWork_Outbox_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Transactions provides a mock function for the type MockUnitOfWork
+func (_mock *MockUnitOfWork) Transactions() repository.TransactionRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transactions")
+	}
+
+	var r0 repository.TransactionRepository
+	if returnFunc, ok := ret.Get(0).(func() repository.TransactionRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.TransactionRepository)
+		}
+	}
+	return r0
+}
+
+// MockUnitOfWork_Transactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Transactions'
+type MockUnitOfWork_Transactions_Call struct {
+	*mock.Call
+}
+
+// Transactions is a helper method to define mock.On call
+func (_e *MockUnitOfWork_Expecter) Transactions() *MockUnitOfWork_Transactions_Call {
+	return &MockUnitOfWork_Transactions_Call{Call: _e.mock.On("Transactions")}
+}
+
+func (_c *MockUnitOfWork_Transactions_Call) Run(run func()) *MockUnitOfWork_Transactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUnitOfWork_Transactions_Call) Return(transactionRepository repository.TransactionRepository) *MockUnitOfWork_Transactions_Call {
+	_c.Call.Return(transactionRepository)
+	return _c
+}
+
+func (_c *MockUnitOfWork_Transactions_Call) RunAndReturn(run func() repository.TransactionRepository) *MockUnitOfWork_Transactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WebhookDeliveries provides a mock function for the type MockUnitOfWork
+func (_mock *MockUnitOfWork) WebhookDeliveries() repository.WebhookDeliveryRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WebhookDeliveries")
+	}
+
+	var r0 repository.WebhookDeliveryRepository
+	if returnFunc, ok := ret.Get(0).(func() repository.WebhookDeliveryRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.WebhookDeliveryRepository)
+		}
+	}
+	return r0
+}
+
+// MockUnitOfWork_WebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebhookDeliveries'
+type MockUnitOfWork_WebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// WebhookDeliveries is a helper method to define mock.On call
+func (_e *MockUnitOfWork_Expecter) WebhookDeliveries() *MockUnitOfWork_WebhookDeliveries_Call {
+	return &MockUnitOfWork_WebhookDeliveries_Call{Call: _e.mock.On("WebhookDeliveries")}
+}
+
+func (_c *MockUnitOfWork_WebhookDeliveries_Call) Run(run func()) *MockUnitOfWork_WebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUnitOfWork_WebhookDeliveries_Call) Return(webhookDeliveryRepository repository.WebhookDeliveryRepository) *MockUnitOfWork_WebhookDeliveries_Call {
+	_c.Call.Return(webhookDeliveryRepository)
+	return _c
+}
+
+func (_c *MockUnitOfWork_WebhookDeliveries_Call) RunAndReturn(run func() repository.WebhookDeliveryRepository) *MockUnitOfWork_WebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Webhooks provides a mock function for the type MockUnitOfWork
+func (_mock *MockUnitOfWork) Webhooks() repository.WebhookSubscriptionRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Webhooks")
+	}
+
+	var r0 repository.WebhookSubscriptionRepository
+	if returnFunc, ok := ret.Get(0).(func() repository.WebhookSubscriptionRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.WebhookSubscriptionRepository)
+		}
+	}
+	return r0
+}
+
+// MockUnitOfWork_Webhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Webhooks'
+type MockUnitOfWork_Webhooks_Call struct {
+	*mock.Call
+}
+
+// Webhooks is a helper method to define mock.On call
+func (_e *MockUnitOfWork_Expecter) Webhooks() *MockUnitOfWork_Webhooks_Call {
+	return &MockUnitOfWork_Webhooks_Call{Call: _e.mock.On("Webhooks")}
+}
+
+func (_c *MockUnitOfWork_Webhooks_Call) Run(run func()) *MockUnitOfWork_Webhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUnitOfWork_Webhooks_Call) Return(webhookSubscriptionRepository repository.WebhookSubscriptionRepository) *MockUnitOfWork_Webhooks_Call {
+	_c.Call.Return(webhookSubscriptionRepository)
+	return _c
+}
+
+func (_c *MockUnitOfWork_Webhooks_Call) RunAndReturn(run func() repository.WebhookSubscriptionRepository) *MockUnitOfWork_Webhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
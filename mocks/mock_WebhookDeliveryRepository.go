@@ -0,0 +1,826 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockWebhookDeliveryRepository creates a new instance of MockWebhookDeliveryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookDeliveryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookDeliveryRepository {
+	mock := &MockWebhookDeliveryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebhookDeliveryRepository is an autogenerated mock type for the WebhookDeliveryRepository type
+type MockWebhookDeliveryRepository struct {
+	mock.Mock
+}
+
+type MockWebhookDeliveryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookDeliveryRepository) EXPECT() *MockWebhookDeliveryRepository_Expecter {
+	return &MockWebhookDeliveryRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockWebhookDeliveryRepository
+func (_mock *MockWebhookDeliveryRepository) Create(ctx context.Context, entity *domain.WebhookDelivery) error {
+	ret := _mock.Called(ctx, entity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.WebhookDelivery) error); ok {
+		r0 = returnFunc(ctx, entity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookDeliveryRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockWebhookDeliveryRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entity *domain.WebhookDelivery
+func (_e *MockWebhookDeliveryRepository_Expecter) Create(ctx interface{}, entity interface{}) *MockWebhookDeliveryRepository_Create_Call {
+	return &MockWebhookDeliveryRepository_Create_Call{Call: _e.mock.On("Create", ctx, entity)}
+}
+
+func (_c *MockWebhookDeliveryRepository_Create_Call) Run(run func(ctx context.Context, entity *domain.WebhookDelivery)) *MockWebhookDeliveryRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.WebhookDelivery
+		if args[1] != nil {
+			arg1 = args[1].(*domain.WebhookDelivery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_Create_Call) Return(err error) *MockWebhookDeliveryRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_Create_Call) RunAndReturn(run func(ctx context.Context, entity *domain.WebhookDelivery) error) *MockWebhookDeliveryRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockWebhookDeliveryRepository
+func (_mock *MockWebhookDeliveryRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookDeliveryRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockWebhookDeliveryRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockWebhookDeliveryRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockWebhookDeliveryRepository_Delete_Call {
+	return &MockWebhookDeliveryRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockWebhookDeliveryRepository_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockWebhookDeliveryRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_Delete_Call) Return(err error) *MockWebhookDeliveryRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockWebhookDeliveryRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Enqueue provides a mock function for the type MockWebhookDeliveryRepository
+func (_mock *MockWebhookDeliveryRepository) Enqueue(ctx context.Context, deliveries []domain.WebhookDelivery) error {
+	ret := _mock.Called(ctx, deliveries)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enqueue")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.WebhookDelivery) error); ok {
+		r0 = returnFunc(ctx, deliveries)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookDeliveryRepository_Enqueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enqueue'
+type MockWebhookDeliveryRepository_Enqueue_Call struct {
+	*mock.Call
+}
+
+// Enqueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deliveries []domain.WebhookDelivery
+func (_e *MockWebhookDeliveryRepository_Expecter) Enqueue(ctx interface{}, deliveries interface{}) *MockWebhookDeliveryRepository_Enqueue_Call {
+	return &MockWebhookDeliveryRepository_Enqueue_Call{Call: _e.mock.On("Enqueue", ctx, deliveries)}
+}
+
+func (_c *MockWebhookDeliveryRepository_Enqueue_Call) Run(run func(ctx context.Context, deliveries []domain.WebhookDelivery)) *MockWebhookDeliveryRepository_Enqueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.WebhookDelivery
+		if args[1] != nil {
+			arg1 = args[1].([]domain.WebhookDelivery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_Enqueue_Call) Return(err error) *MockWebhookDeliveryRepository_Enqueue_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_Enqueue_Call) RunAndReturn(run func(ctx context.Context, deliveries []domain.WebhookDelivery) error) *MockWebhookDeliveryRepository_Enqueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function for the type MockWebhookDeliveryRepository
+func (_mock *MockWebhookDeliveryRepository) FindAll(ctx context.Context, spec repository.Specification[domain.WebhookDelivery]) ([]domain.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []domain.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.WebhookDelivery]) ([]domain.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, spec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.WebhookDelivery]) []domain.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, spec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.Specification[domain.WebhookDelivery]) error); ok {
+		r1 = returnFunc(ctx, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookDeliveryRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockWebhookDeliveryRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec repository.Specification[domain.WebhookDelivery]
+func (_e *MockWebhookDeliveryRepository_Expecter) FindAll(ctx interface{}, spec interface{}) *MockWebhookDeliveryRepository_FindAll_Call {
+	return &MockWebhookDeliveryRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx, spec)}
+}
+
+func (_c *MockWebhookDeliveryRepository_FindAll_Call) Run(run func(ctx context.Context, spec repository.Specification[domain.WebhookDelivery])) *MockWebhookDeliveryRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.Specification[domain.WebhookDelivery]
+		if args[1] != nil {
+			arg1 = args[1].(repository.Specification[domain.WebhookDelivery])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_FindAll_Call) Return(webhookDeliverys []domain.WebhookDelivery, err error) *MockWebhookDeliveryRepository_FindAll_Call {
+	_c.Call.Return(webhookDeliverys, err)
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_FindAll_Call) RunAndReturn(run func(ctx context.Context, spec repository.Specification[domain.WebhookDelivery]) ([]domain.WebhookDelivery, error)) *MockWebhookDeliveryRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBySubscriptionIDPaginated provides a mock function for the type MockWebhookDeliveryRepository
+func (_mock *MockWebhookDeliveryRepository) FindBySubscriptionIDPaginated(ctx context.Context, subscriptionID uuid.UUID, req repository.PaginationRequest) (*repository.PaginationResponse[domain.WebhookDelivery], error) {
+	ret := _mock.Called(ctx, subscriptionID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBySubscriptionIDPaginated")
+	}
+
+	var r0 *repository.PaginationResponse[domain.WebhookDelivery]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.PaginationRequest) (*repository.PaginationResponse[domain.WebhookDelivery], error)); ok {
+		return returnFunc(ctx, subscriptionID, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.PaginationRequest) *repository.PaginationResponse[domain.WebhookDelivery]); ok {
+		r0 = returnFunc(ctx, subscriptionID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.PaginationResponse[domain.WebhookDelivery])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, repository.PaginationRequest) error); ok {
+		r1 = returnFunc(ctx, subscriptionID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookDeliveryRepository_FindBySubscriptionIDPaginated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBySubscriptionIDPaginated'
+type MockWebhookDeliveryRepository_FindBySubscriptionIDPaginated_Call struct {
+	*mock.Call
+}
+
+// FindBySubscriptionIDPaginated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscriptionID uuid.UUID
+//   - req repository.PaginationRequest
+func (_e *MockWebhookDeliveryRepository_Expecter) FindBySubscriptionIDPaginated(ctx interface{}, subscriptionID interface{}, req interface{}) *MockWebhookDeliveryRepository_FindBySubscriptionIDPaginated_Call {
+	return &MockWebhookDeliveryRepository_FindBySubscriptionIDPaginated_Call{Call: _e.mock.On("FindBySubscriptionIDPaginated", ctx, subscriptionID, req)}
+}
+
+func (_c *MockWebhookDeliveryRepository_FindBySubscriptionIDPaginated_Call) Run(run func(ctx context.Context, subscriptionID uuid.UUID, req repository.PaginationRequest)) *MockWebhookDeliveryRepository_FindBySubscriptionIDPaginated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 repository.PaginationRequest
+		if args[2] != nil {
+			arg2 = args[2].(repository.PaginationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_FindBySubscriptionIDPaginated_Call) Return(paginationResponse *repository.PaginationResponse[domain.WebhookDelivery], err error) *MockWebhookDeliveryRepository_FindBySubscriptionIDPaginated_Call {
+	_c.Call.Return(paginationResponse, err)
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_FindBySubscriptionIDPaginated_Call) RunAndReturn(run func(ctx context.Context, subscriptionID uuid.UUID, req repository.PaginationRequest) (*repository.PaginationResponse[domain.WebhookDelivery], error)) *MockWebhookDeliveryRepository_FindBySubscriptionIDPaginated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPaginated provides a mock function for the type MockWebhookDeliveryRepository
+func (_mock *MockWebhookDeliveryRepository) FindPaginated(ctx context.Context, spec repository.Specification[domain.WebhookDelivery], req repository.PaginationRequest) (*repository.PaginationResponse[domain.WebhookDelivery], error) {
+	ret := _mock.Called(ctx, spec, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPaginated")
+	}
+
+	var r0 *repository.PaginationResponse[domain.WebhookDelivery]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.WebhookDelivery], repository.PaginationRequest) (*repository.PaginationResponse[domain.WebhookDelivery], error)); ok {
+		return returnFunc(ctx, spec, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.WebhookDelivery], repository.PaginationRequest) *repository.PaginationResponse[domain.WebhookDelivery]); ok {
+		r0 = returnFunc(ctx, spec, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.PaginationResponse[domain.WebhookDelivery])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.Specification[domain.WebhookDelivery], repository.PaginationRequest) error); ok {
+		r1 = returnFunc(ctx, spec, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookDeliveryRepository_FindPaginated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPaginated'
+type MockWebhookDeliveryRepository_FindPaginated_Call struct {
+	*mock.Call
+}
+
+// FindPaginated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec repository.Specification[domain.WebhookDelivery]
+//   - req repository.PaginationRequest
+func (_e *MockWebhookDeliveryRepository_Expecter) FindPaginated(ctx interface{}, spec interface{}, req interface{}) *MockWebhookDeliveryRepository_FindPaginated_Call {
+	return &MockWebhookDeliveryRepository_FindPaginated_Call{Call: _e.mock.On("FindPaginated", ctx, spec, req)}
+}
+
+func (_c *MockWebhookDeliveryRepository_FindPaginated_Call) Run(run func(ctx context.Context, spec repository.Specification[domain.WebhookDelivery], req repository.PaginationRequest)) *MockWebhookDeliveryRepository_FindPaginated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.Specification[domain.WebhookDelivery]
+		if args[1] != nil {
+			arg1 = args[1].(repository.Specification[domain.WebhookDelivery])
+		}
+		var arg2 repository.PaginationRequest
+		if args[2] != nil {
+			arg2 = args[2].(repository.PaginationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_FindPaginated_Call) Return(paginationResponse *repository.PaginationResponse[domain.WebhookDelivery], err error) *MockWebhookDeliveryRepository_FindPaginated_Call {
+	_c.Call.Return(paginationResponse, err)
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_FindPaginated_Call) RunAndReturn(run func(ctx context.Context, spec repository.Specification[domain.WebhookDelivery], req repository.PaginationRequest) (*repository.PaginationResponse[domain.WebhookDelivery], error)) *MockWebhookDeliveryRepository_FindPaginated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function for the type MockWebhookDeliveryRepository
+func (_mock *MockWebhookDeliveryRepository) GetAll(ctx context.Context) ([]domain.WebhookDelivery, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []domain.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.WebhookDelivery, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.WebhookDelivery); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookDeliveryRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type MockWebhookDeliveryRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockWebhookDeliveryRepository_Expecter) GetAll(ctx interface{}) *MockWebhookDeliveryRepository_GetAll_Call {
+	return &MockWebhookDeliveryRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *MockWebhookDeliveryRepository_GetAll_Call) Run(run func(ctx context.Context)) *MockWebhookDeliveryRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_GetAll_Call) Return(webhookDeliverys []domain.WebhookDelivery, err error) *MockWebhookDeliveryRepository_GetAll_Call {
+	_c.Call.Return(webhookDeliverys, err)
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_GetAll_Call) RunAndReturn(run func(ctx context.Context) ([]domain.WebhookDelivery, error)) *MockWebhookDeliveryRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockWebhookDeliveryRepository
+func (_mock *MockWebhookDeliveryRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookDeliveryRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockWebhookDeliveryRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockWebhookDeliveryRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockWebhookDeliveryRepository_GetByID_Call {
+	return &MockWebhookDeliveryRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockWebhookDeliveryRepository_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockWebhookDeliveryRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_GetByID_Call) Return(webhookDelivery *domain.WebhookDelivery, err error) *MockWebhookDeliveryRepository_GetByID_Call {
+	_c.Call.Return(webhookDelivery, err)
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.WebhookDelivery, error)) *MockWebhookDeliveryRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDForUpdate provides a mock function for the type MockWebhookDeliveryRepository
+func (_mock *MockWebhookDeliveryRepository) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*domain.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDForUpdate")
+	}
+
+	var r0 *domain.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookDeliveryRepository_GetByIDForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDForUpdate'
+type MockWebhookDeliveryRepository_GetByIDForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetByIDForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockWebhookDeliveryRepository_Expecter) GetByIDForUpdate(ctx interface{}, id interface{}) *MockWebhookDeliveryRepository_GetByIDForUpdate_Call {
+	return &MockWebhookDeliveryRepository_GetByIDForUpdate_Call{Call: _e.mock.On("GetByIDForUpdate", ctx, id)}
+}
+
+func (_c *MockWebhookDeliveryRepository_GetByIDForUpdate_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockWebhookDeliveryRepository_GetByIDForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_GetByIDForUpdate_Call) Return(webhookDelivery *domain.WebhookDelivery, err error) *MockWebhookDeliveryRepository_GetByIDForUpdate_Call {
+	_c.Call.Return(webhookDelivery, err)
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_GetByIDForUpdate_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.WebhookDelivery, error)) *MockWebhookDeliveryRepository_GetByIDForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPaginated provides a mock function for the type MockWebhookDeliveryRepository
+func (_mock *MockWebhookDeliveryRepository) GetPaginated(ctx context.Context, req repository.PaginationRequest) (*repository.PaginationResponse[domain.WebhookDelivery], error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaginated")
+	}
+
+	var r0 *repository.PaginationResponse[domain.WebhookDelivery]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.PaginationRequest) (*repository.PaginationResponse[domain.WebhookDelivery], error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.PaginationRequest) *repository.PaginationResponse[domain.WebhookDelivery]); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.PaginationResponse[domain.WebhookDelivery])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.PaginationRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookDeliveryRepository_GetPaginated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaginated'
+type MockWebhookDeliveryRepository_GetPaginated_Call struct {
+	*mock.Call
+}
+
+// GetPaginated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req repository.PaginationRequest
+func (_e *MockWebhookDeliveryRepository_Expecter) GetPaginated(ctx interface{}, req interface{}) *MockWebhookDeliveryRepository_GetPaginated_Call {
+	return &MockWebhookDeliveryRepository_GetPaginated_Call{Call: _e.mock.On("GetPaginated", ctx, req)}
+}
+
+func (_c *MockWebhookDeliveryRepository_GetPaginated_Call) Run(run func(ctx context.Context, req repository.PaginationRequest)) *MockWebhookDeliveryRepository_GetPaginated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.PaginationRequest
+		if args[1] != nil {
+			arg1 = args[1].(repository.PaginationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_GetPaginated_Call) Return(paginationResponse *repository.PaginationResponse[domain.WebhookDelivery], err error) *MockWebhookDeliveryRepository_GetPaginated_Call {
+	_c.Call.Return(paginationResponse, err)
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_GetPaginated_Call) RunAndReturn(run func(ctx context.Context, req repository.PaginationRequest) (*repository.PaginationResponse[domain.WebhookDelivery], error)) *MockWebhookDeliveryRepository_GetPaginated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockDue provides a mock function for the type MockWebhookDeliveryRepository
+func (_mock *MockWebhookDeliveryRepository) LockDue(ctx context.Context, now time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockDue")
+	}
+
+	var r0 []domain.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]domain.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, now, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) []domain.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookDeliveryRepository_LockDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockDue'
+type MockWebhookDeliveryRepository_LockDue_Call struct {
+	*mock.Call
+}
+
+// LockDue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit int
+func (_e *MockWebhookDeliveryRepository_Expecter) LockDue(ctx interface{}, now interface{}, limit interface{}) *MockWebhookDeliveryRepository_LockDue_Call {
+	return &MockWebhookDeliveryRepository_LockDue_Call{Call: _e.mock.On("LockDue", ctx, now, limit)}
+}
+
+func (_c *MockWebhookDeliveryRepository_LockDue_Call) Run(run func(ctx context.Context, now time.Time, limit int)) *MockWebhookDeliveryRepository_LockDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_LockDue_Call) Return(webhookDeliverys []domain.WebhookDelivery, err error) *MockWebhookDeliveryRepository_LockDue_Call {
+	_c.Call.Return(webhookDeliverys, err)
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_LockDue_Call) RunAndReturn(run func(ctx context.Context, now time.Time, limit int) ([]domain.WebhookDelivery, error)) *MockWebhookDeliveryRepository_LockDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockWebhookDeliveryRepository
+func (_mock *MockWebhookDeliveryRepository) Update(ctx context.Context, entity *domain.WebhookDelivery) error {
+	ret := _mock.Called(ctx, entity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.WebhookDelivery) error); ok {
+		r0 = returnFunc(ctx, entity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookDeliveryRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockWebhookDeliveryRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entity *domain.WebhookDelivery
+func (_e *MockWebhookDeliveryRepository_Expecter) Update(ctx interface{}, entity interface{}) *MockWebhookDeliveryRepository_Update_Call {
+	return &MockWebhookDeliveryRepository_Update_Call{Call: _e.mock.On("Update", ctx, entity)}
+}
+
+func (_c *MockWebhookDeliveryRepository_Update_Call) Run(run func(ctx context.Context, entity *domain.WebhookDelivery)) *MockWebhookDeliveryRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.WebhookDelivery
+		if args[1] != nil {
+			arg1 = args[1].(*domain.WebhookDelivery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_Update_Call) Return(err error) *MockWebhookDeliveryRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_Update_Call) RunAndReturn(run func(ctx context.Context, entity *domain.WebhookDelivery) error) *MockWebhookDeliveryRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
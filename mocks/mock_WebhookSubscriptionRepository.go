@@ -0,0 +1,688 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"arise_tech_assessment/internal/domain"
+	"arise_tech_assessment/internal/infrastructure/repository"
+	"context"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockWebhookSubscriptionRepository creates a new instance of MockWebhookSubscriptionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookSubscriptionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookSubscriptionRepository {
+	mock := &MockWebhookSubscriptionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebhookSubscriptionRepository is an autogenerated mock type for the WebhookSubscriptionRepository type
+type MockWebhookSubscriptionRepository struct {
+	mock.Mock
+}
+
+type MockWebhookSubscriptionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookSubscriptionRepository) EXPECT() *MockWebhookSubscriptionRepository_Expecter {
+	return &MockWebhookSubscriptionRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockWebhookSubscriptionRepository
+func (_mock *MockWebhookSubscriptionRepository) Create(ctx context.Context, entity *domain.WebhookSubscription) error {
+	ret := _mock.Called(ctx, entity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.WebhookSubscription) error); ok {
+		r0 = returnFunc(ctx, entity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookSubscriptionRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockWebhookSubscriptionRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entity *domain.WebhookSubscription
+func (_e *MockWebhookSubscriptionRepository_Expecter) Create(ctx interface{}, entity interface{}) *MockWebhookSubscriptionRepository_Create_Call {
+	return &MockWebhookSubscriptionRepository_Create_Call{Call: _e.mock.On("Create", ctx, entity)}
+}
+
+func (_c *MockWebhookSubscriptionRepository_Create_Call) Run(run func(ctx context.Context, entity *domain.WebhookSubscription)) *MockWebhookSubscriptionRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.WebhookSubscription
+		if args[1] != nil {
+			arg1 = args[1].(*domain.WebhookSubscription)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookSubscriptionRepository_Create_Call) Return(err error) *MockWebhookSubscriptionRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookSubscriptionRepository_Create_Call) RunAndReturn(run func(ctx context.Context, entity *domain.WebhookSubscription) error) *MockWebhookSubscriptionRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockWebhookSubscriptionRepository
+func (_mock *MockWebhookSubscriptionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookSubscriptionRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockWebhookSubscriptionRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockWebhookSubscriptionRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockWebhookSubscriptionRepository_Delete_Call {
+	return &MockWebhookSubscriptionRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockWebhookSubscriptionRepository_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockWebhookSubscriptionRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookSubscriptionRepository_Delete_Call) Return(err error) *MockWebhookSubscriptionRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookSubscriptionRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockWebhookSubscriptionRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function for the type MockWebhookSubscriptionRepository
+func (_mock *MockWebhookSubscriptionRepository) FindAll(ctx context.Context, spec repository.Specification[domain.WebhookSubscription]) ([]domain.WebhookSubscription, error) {
+	ret := _mock.Called(ctx, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []domain.WebhookSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.WebhookSubscription]) ([]domain.WebhookSubscription, error)); ok {
+		return returnFunc(ctx, spec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.WebhookSubscription]) []domain.WebhookSubscription); ok {
+		r0 = returnFunc(ctx, spec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.WebhookSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.Specification[domain.WebhookSubscription]) error); ok {
+		r1 = returnFunc(ctx, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookSubscriptionRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockWebhookSubscriptionRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec repository.Specification[domain.WebhookSubscription]
+func (_e *MockWebhookSubscriptionRepository_Expecter) FindAll(ctx interface{}, spec interface{}) *MockWebhookSubscriptionRepository_FindAll_Call {
+	return &MockWebhookSubscriptionRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx, spec)}
+}
+
+func (_c *MockWebhookSubscriptionRepository_FindAll_Call) Run(run func(ctx context.Context, spec repository.Specification[domain.WebhookSubscription])) *MockWebhookSubscriptionRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.Specification[domain.WebhookSubscription]
+		if args[1] != nil {
+			arg1 = args[1].(repository.Specification[domain.WebhookSubscription])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookSubscriptionRepository_FindAll_Call) Return(webhookSubscriptions []domain.WebhookSubscription, err error) *MockWebhookSubscriptionRepository_FindAll_Call {
+	_c.Call.Return(webhookSubscriptions, err)
+	return _c
+}
+
+func (_c *MockWebhookSubscriptionRepository_FindAll_Call) RunAndReturn(run func(ctx context.Context, spec repository.Specification[domain.WebhookSubscription]) ([]domain.WebhookSubscription, error)) *MockWebhookSubscriptionRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByEventType provides a mock function for the type MockWebhookSubscriptionRepository
+func (_mock *MockWebhookSubscriptionRepository) FindByEventType(ctx context.Context, eventType string) ([]domain.WebhookSubscription, error) {
+	ret := _mock.Called(ctx, eventType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByEventType")
+	}
+
+	var r0 []domain.WebhookSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.WebhookSubscription, error)); ok {
+		return returnFunc(ctx, eventType)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.WebhookSubscription); ok {
+		r0 = returnFunc(ctx, eventType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.WebhookSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, eventType)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookSubscriptionRepository_FindByEventType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByEventType'
+type MockWebhookSubscriptionRepository_FindByEventType_Call struct {
+	*mock.Call
+}
+
+// FindByEventType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventType string
+func (_e *MockWebhookSubscriptionRepository_Expecter) FindByEventType(ctx interface{}, eventType interface{}) *MockWebhookSubscriptionRepository_FindByEventType_Call {
+	return &MockWebhookSubscriptionRepository_FindByEventType_Call{Call: _e.mock.On("FindByEventType", ctx, eventType)}
+}
+
+func (_c *MockWebhookSubscriptionRepository_FindByEventType_Call) Run(run func(ctx context.Context, eventType string)) *MockWebhookSubscriptionRepository_FindByEventType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookSubscriptionRepository_FindByEventType_Call) Return(webhookSubscriptions []domain.WebhookSubscription, err error) *MockWebhookSubscriptionRepository_FindByEventType_Call {
+	_c.Call.Return(webhookSubscriptions, err)
+	return _c
+}
+
+func (_c *MockWebhookSubscriptionRepository_FindByEventType_Call) RunAndReturn(run func(ctx context.Context, eventType string) ([]domain.WebhookSubscription, error)) *MockWebhookSubscriptionRepository_FindByEventType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPaginated provides a mock function for the type MockWebhookSubscriptionRepository
+func (_mock *MockWebhookSubscriptionRepository) FindPaginated(ctx context.Context, spec repository.Specification[domain.WebhookSubscription], req repository.PaginationRequest) (*repository.PaginationResponse[domain.WebhookSubscription], error) {
+	ret := _mock.Called(ctx, spec, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPaginated")
+	}
+
+	var r0 *repository.PaginationResponse[domain.WebhookSubscription]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.WebhookSubscription], repository.PaginationRequest) (*repository.PaginationResponse[domain.WebhookSubscription], error)); ok {
+		return returnFunc(ctx, spec, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.Specification[domain.WebhookSubscription], repository.PaginationRequest) *repository.PaginationResponse[domain.WebhookSubscription]); ok {
+		r0 = returnFunc(ctx, spec, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.PaginationResponse[domain.WebhookSubscription])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.Specification[domain.WebhookSubscription], repository.PaginationRequest) error); ok {
+		r1 = returnFunc(ctx, spec, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookSubscriptionRepository_FindPaginated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPaginated'
+type MockWebhookSubscriptionRepository_FindPaginated_Call struct {
+	*mock.Call
+}
+
+// FindPaginated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec repository.Specification[domain.WebhookSubscription]
+//   - req repository.PaginationRequest
+func (_e *MockWebhookSubscriptionRepository_Expecter) FindPaginated(ctx interface{}, spec interface{}, req interface{}) *MockWebhookSubscriptionRepository_FindPaginated_Call {
+	return &MockWebhookSubscriptionRepository_FindPaginated_Call{Call: _e.mock.On("FindPaginated", ctx, spec, req)}
+}
+
+func (_c *MockWebhookSubscriptionRepository_FindPaginated_Call) Run(run func(ctx context.Context, spec repository.Specification[domain.WebhookSubscription], req repository.PaginationRequest)) *MockWebhookSubscriptionRepository_FindPaginated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.Specification[domain.WebhookSubscription]
+		if args[1] != nil {
+			arg1 = args[1].(repository.Specification[domain.WebhookSubscription])
+		}
+		var arg2 repository.PaginationRequest
+		if args[2] != nil {
+			arg2 = args[2].(repository.PaginationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookSubscriptionRepository_FindPaginated_Call) Return(paginationResponse *repository.PaginationResponse[domain.WebhookSubscription], err error) *MockWebhookSubscriptionRepository_FindPaginated_Call {
+	_c.Call.Return(paginationResponse, err)
+	return _c
+}
+
+func (_c *MockWebhookSubscriptionRepository_FindPaginated_Call) RunAndReturn(run func(ctx context.Context, spec repository.Specification[domain.WebhookSubscription], req repository.PaginationRequest) (*repository.PaginationResponse[domain.WebhookSubscription], error)) *MockWebhookSubscriptionRepository_FindPaginated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function for the type MockWebhookSubscriptionRepository
+func (_mock *MockWebhookSubscriptionRepository) GetAll(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []domain.WebhookSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.WebhookSubscription, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.WebhookSubscription); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.WebhookSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookSubscriptionRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type MockWebhookSubscriptionRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockWebhookSubscriptionRepository_Expecter) GetAll(ctx interface{}) *MockWebhookSubscriptionRepository_GetAll_Call {
+	return &MockWebhookSubscriptionRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *MockWebhookSubscriptionRepository_GetAll_Call) Run(run func(ctx context.Context)) *MockWebhookSubscriptionRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookSubscriptionRepository_GetAll_Call) Return(webhookSubscriptions []domain.WebhookSubscription, err error) *MockWebhookSubscriptionRepository_GetAll_Call {
+	_c.Call.Return(webhookSubscriptions, err)
+	return _c
+}
+
+func (_c *MockWebhookSubscriptionRepository_GetAll_Call) RunAndReturn(run func(ctx context.Context) ([]domain.WebhookSubscription, error)) *MockWebhookSubscriptionRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockWebhookSubscriptionRepository
+func (_mock *MockWebhookSubscriptionRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.WebhookSubscription, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.WebhookSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.WebhookSubscription, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.WebhookSubscription); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WebhookSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookSubscriptionRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockWebhookSubscriptionRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockWebhookSubscriptionRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockWebhookSubscriptionRepository_GetByID_Call {
+	return &MockWebhookSubscriptionRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockWebhookSubscriptionRepository_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockWebhookSubscriptionRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookSubscriptionRepository_GetByID_Call) Return(webhookSubscription *domain.WebhookSubscription, err error) *MockWebhookSubscriptionRepository_GetByID_Call {
+	_c.Call.Return(webhookSubscription, err)
+	return _c
+}
+
+func (_c *MockWebhookSubscriptionRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.WebhookSubscription, error)) *MockWebhookSubscriptionRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDForUpdate provides a mock function for the type MockWebhookSubscriptionRepository
+func (_mock *MockWebhookSubscriptionRepository) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*domain.WebhookSubscription, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDForUpdate")
+	}
+
+	var r0 *domain.WebhookSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.WebhookSubscription, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.WebhookSubscription); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WebhookSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookSubscriptionRepository_GetByIDForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDForUpdate'
+type MockWebhookSubscriptionRepository_GetByIDForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetByIDForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockWebhookSubscriptionRepository_Expecter) GetByIDForUpdate(ctx interface{}, id interface{}) *MockWebhookSubscriptionRepository_GetByIDForUpdate_Call {
+	return &MockWebhookSubscriptionRepository_GetByIDForUpdate_Call{Call: _e.mock.On("GetByIDForUpdate", ctx, id)}
+}
+
+func (_c *MockWebhookSubscriptionRepository_GetByIDForUpdate_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockWebhookSubscriptionRepository_GetByIDForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookSubscriptionRepository_GetByIDForUpdate_Call) Return(webhookSubscription *domain.WebhookSubscription, err error) *MockWebhookSubscriptionRepository_GetByIDForUpdate_Call {
+	_c.Call.Return(webhookSubscription, err)
+	return _c
+}
+
+func (_c *MockWebhookSubscriptionRepository_GetByIDForUpdate_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.WebhookSubscription, error)) *MockWebhookSubscriptionRepository_GetByIDForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPaginated provides a mock function for the type MockWebhookSubscriptionRepository
+func (_mock *MockWebhookSubscriptionRepository) GetPaginated(ctx context.Context, req repository.PaginationRequest) (*repository.PaginationResponse[domain.WebhookSubscription], error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaginated")
+	}
+
+	var r0 *repository.PaginationResponse[domain.WebhookSubscription]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.PaginationRequest) (*repository.PaginationResponse[domain.WebhookSubscription], error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.PaginationRequest) *repository.PaginationResponse[domain.WebhookSubscription]); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.PaginationResponse[domain.WebhookSubscription])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.PaginationRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookSubscriptionRepository_GetPaginated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaginated'
+type MockWebhookSubscriptionRepository_GetPaginated_Call struct {
+	*mock.Call
+}
+
+// GetPaginated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req repository.PaginationRequest
+func (_e *MockWebhookSubscriptionRepository_Expecter) GetPaginated(ctx interface{}, req interface{}) *MockWebhookSubscriptionRepository_GetPaginated_Call {
+	return &MockWebhookSubscriptionRepository_GetPaginated_Call{Call: _e.mock.On("GetPaginated", ctx, req)}
+}
+
+func (_c *MockWebhookSubscriptionRepository_GetPaginated_Call) Run(run func(ctx context.Context, req repository.PaginationRequest)) *MockWebhookSubscriptionRepository_GetPaginated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.PaginationRequest
+		if args[1] != nil {
+			arg1 = args[1].(repository.PaginationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookSubscriptionRepository_GetPaginated_Call) Return(paginationResponse *repository.PaginationResponse[domain.WebhookSubscription], err error) *MockWebhookSubscriptionRepository_GetPaginated_Call {
+	_c.Call.Return(paginationResponse, err)
+	return _c
+}
+
+func (_c *MockWebhookSubscriptionRepository_GetPaginated_Call) RunAndReturn(run func(ctx context.Context, req repository.PaginationRequest) (*repository.PaginationResponse[domain.WebhookSubscription], error)) *MockWebhookSubscriptionRepository_GetPaginated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockWebhookSubscriptionRepository
+func (_mock *MockWebhookSubscriptionRepository) Update(ctx context.Context, entity *domain.WebhookSubscription) error {
+	ret := _mock.Called(ctx, entity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.WebhookSubscription) error); ok {
+		r0 = returnFunc(ctx, entity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookSubscriptionRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockWebhookSubscriptionRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entity *domain.WebhookSubscription
+func (_e *MockWebhookSubscriptionRepository_Expecter) Update(ctx interface{}, entity interface{}) *MockWebhookSubscriptionRepository_Update_Call {
+	return &MockWebhookSubscriptionRepository_Update_Call{Call: _e.mock.On("Update", ctx, entity)}
+}
+
+func (_c *MockWebhookSubscriptionRepository_Update_Call) Run(run func(ctx context.Context, entity *domain.WebhookSubscription)) *MockWebhookSubscriptionRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.WebhookSubscription
+		if args[1] != nil {
+			arg1 = args[1].(*domain.WebhookSubscription)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookSubscriptionRepository_Update_Call) Return(err error) *MockWebhookSubscriptionRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookSubscriptionRepository_Update_Call) RunAndReturn(run func(ctx context.Context, entity *domain.WebhookSubscription) error) *MockWebhookSubscriptionRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
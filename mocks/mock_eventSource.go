@@ -0,0 +1,117 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"arise_tech_assessment/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockeventSource creates a new instance of MockeventSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockeventSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockeventSource {
+	mock := &MockeventSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockeventSource is an autogenerated mock type for the eventSource type
+type MockeventSource struct {
+	mock.Mock
+}
+
+type MockeventSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockeventSource) EXPECT() *MockeventSource_Expecter {
+	return &MockeventSource_Expecter{mock: &_m.Mock}
+}
+
+// ClearEvents provides a mock function for the type MockeventSource
+func (_mock *MockeventSource) ClearEvents() {
+	_mock.Called()
+	return
+}
+
+// MockeventSource_ClearEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearEvents'
+type MockeventSource_ClearEvents_Call struct {
+	*mock.Call
+}
+
+// ClearEvents is a helper method to define mock.On call
+func (_e *MockeventSource_Expecter) ClearEvents() *MockeventSource_ClearEvents_Call {
+	return &MockeventSource_ClearEvents_Call{Call: _e.mock.On("ClearEvents")}
+}
+
+func (_c *MockeventSource_ClearEvents_Call) Run(run func()) *MockeventSource_ClearEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockeventSource_ClearEvents_Call) Return() *MockeventSource_ClearEvents_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockeventSource_ClearEvents_Call) RunAndReturn(run func()) *MockeventSource_ClearEvents_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PendingEvents provides a mock function for the type MockeventSource
+func (_mock *MockeventSource) PendingEvents() []domain.Event {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PendingEvents")
+	}
+
+	var r0 []domain.Event
+	if returnFunc, ok := ret.Get(0).(func() []domain.Event); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Event)
+		}
+	}
+	return r0
+}
+
+// MockeventSource_PendingEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PendingEvents'
+type MockeventSource_PendingEvents_Call struct {
+	*mock.Call
+}
+
+// PendingEvents is a helper method to define mock.On call
+func (_e *MockeventSource_Expecter) PendingEvents() *MockeventSource_PendingEvents_Call {
+	return &MockeventSource_PendingEvents_Call{Call: _e.mock.On("PendingEvents")}
+}
+
+func (_c *MockeventSource_PendingEvents_Call) Run(run func()) *MockeventSource_PendingEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockeventSource_PendingEvents_Call) Return(events []domain.Event) *MockeventSource_PendingEvents_Call {
+	_c.Call.Return(events)
+	return _c
+}
+
+func (_c *MockeventSource_PendingEvents_Call) RunAndReturn(run func() []domain.Event) *MockeventSource_PendingEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,120 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockversioned creates a new instance of Mockversioned. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockversioned(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Mockversioned {
+	mock := &Mockversioned{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// Mockversioned is an autogenerated mock type for the versioned type
+type Mockversioned struct {
+	mock.Mock
+}
+
+type Mockversioned_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Mockversioned) EXPECT() *Mockversioned_Expecter {
+	return &Mockversioned_Expecter{mock: &_m.Mock}
+}
+
+// CurrentVersion provides a mock function for the type Mockversioned
+func (_mock *Mockversioned) CurrentVersion() int64 {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CurrentVersion")
+	}
+
+	var r0 int64
+	if returnFunc, ok := ret.Get(0).(func() int64); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	return r0
+}
+
+// Mockversioned_CurrentVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CurrentVersion'
+type Mockversioned_CurrentVersion_Call struct {
+	*mock.Call
+}
+
+// CurrentVersion is a helper method to define mock.On call
+func (_e *Mockversioned_Expecter) CurrentVersion() *Mockversioned_CurrentVersion_Call {
+	return &Mockversioned_CurrentVersion_Call{Call: _e.mock.On("CurrentVersion")}
+}
+
+func (_c *Mockversioned_CurrentVersion_Call) Run(run func()) *Mockversioned_CurrentVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Mockversioned_CurrentVersion_Call) Return(n int64) *Mockversioned_CurrentVersion_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *Mockversioned_CurrentVersion_Call) RunAndReturn(run func() int64) *Mockversioned_CurrentVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetVersion provides a mock function for the type Mockversioned
+func (_mock *Mockversioned) SetVersion(version int64) {
+	_mock.Called(version)
+	return
+}
+
+// Mockversioned_SetVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetVersion'
+type Mockversioned_SetVersion_Call struct {
+	*mock.Call
+}
+
+// SetVersion is a helper method to define mock.On call
+//   - version int64
+func (_e *Mockversioned_Expecter) SetVersion(version interface{}) *Mockversioned_SetVersion_Call {
+	return &Mockversioned_SetVersion_Call{Call: _e.mock.On("SetVersion", version)}
+}
+
+func (_c *Mockversioned_SetVersion_Call) Run(run func(version int64)) *Mockversioned_SetVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *Mockversioned_SetVersion_Call) Return() *Mockversioned_SetVersion_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Mockversioned_SetVersion_Call) RunAndReturn(run func(version int64)) *Mockversioned_SetVersion_Call {
+	_c.Run(run)
+	return _c
+}